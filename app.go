@@ -6,17 +6,32 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/elC0mpa/aws-doctor/model"
 	awsconfig "github.com/elC0mpa/aws-doctor/service/aws_config"
+	awsbackup "github.com/elC0mpa/aws-doctor/service/backup"
+	awscloudtrail "github.com/elC0mpa/aws-doctor/service/cloudtrail"
+	"github.com/elC0mpa/aws-doctor/service/computeoptimizer"
 	awscostexplorer "github.com/elC0mpa/aws-doctor/service/costexplorer"
 	awsec2 "github.com/elC0mpa/aws-doctor/service/ec2"
+	awsecs "github.com/elC0mpa/aws-doctor/service/ecs"
+	awsefs "github.com/elC0mpa/aws-doctor/service/efs"
+	awseks "github.com/elC0mpa/aws-doctor/service/eks"
 	"github.com/elC0mpa/aws-doctor/service/elb"
 	"github.com/elC0mpa/aws-doctor/service/flag"
+	awskafka "github.com/elC0mpa/aws-doctor/service/kafka"
+	awskinesis "github.com/elC0mpa/aws-doctor/service/kinesis"
+	awskms "github.com/elC0mpa/aws-doctor/service/kms"
+	"github.com/elC0mpa/aws-doctor/service/metrics"
 	"github.com/elC0mpa/aws-doctor/service/orchestrator"
 	"github.com/elC0mpa/aws-doctor/service/output"
+	awsredshift "github.com/elC0mpa/aws-doctor/service/redshift"
+	awsroute53 "github.com/elC0mpa/aws-doctor/service/route53"
+	awssagemaker "github.com/elC0mpa/aws-doctor/service/sagemaker"
+	awssecretsmanager "github.com/elC0mpa/aws-doctor/service/secretsmanager"
 	awssts "github.com/elC0mpa/aws-doctor/service/sts"
+	"github.com/elC0mpa/aws-doctor/service/tui"
 	"github.com/elC0mpa/aws-doctor/service/update"
 	"github.com/elC0mpa/aws-doctor/utils"
-	"github.com/elC0mpa/aws-doctor/model"
 )
 
 var (
@@ -46,18 +61,27 @@ func run() error {
 		Date:    date,
 	}
 
-	if flags.Version || flags.Update {
-		outputService := output.NewService(flags.Output)
+	if flags.Version || flags.Update || flags.Schema {
+		outputService := output.NewService(output.Options{
+			Targets:      flags.Outputs,
+			TemplatePath: flags.Template,
+		})
 		updateService := update.NewService()
-		orchestratorService := orchestrator.NewService(nil, nil, nil, nil, outputService, updateService, versionInfo)
+		orchestratorService := orchestrator.NewService(orchestrator.Dependencies{
+			Output: outputService,
+			Update: updateService,
+		}, versionInfo)
 
 		return orchestratorService.Orchestrate(flags)
 	}
 
-	utils.DrawBanner()
-	utils.StartSpinner()
+	// The banner and the spinner would end up in data piped from standard output
+	if !writesDataToStdout(flags.Outputs) {
+		utils.DrawBanner()
+		utils.StartSpinner()
 
-	defer utils.StopSpinner()
+		defer utils.StopSpinner()
+	}
 
 	cfgService := awsconfig.NewService()
 
@@ -70,10 +94,51 @@ func run() error {
 	stsService := awssts.NewService(awsCfg)
 	ec2Service := awsec2.NewService(awsCfg)
 	elbService := elb.NewService(awsCfg)
-	outputService := output.NewService(flags.Output)
+	computeOptimizerService := computeoptimizer.NewService(awsCfg)
+	eksService := awseks.NewService(awsCfg)
+	ecsService := awsecs.NewService(awsCfg)
+	sageMakerService := awssagemaker.NewService(awsCfg)
+	redshiftService := awsredshift.NewService(awsCfg)
+	efsService := awsefs.NewService(awsCfg)
+	backupService := awsbackup.NewService(awsCfg)
+	secretsManagerService := awssecretsmanager.NewService(awsCfg)
+	kmsService := awskms.NewService(awsCfg)
+	route53Service := awsroute53.NewService(awsCfg, !flags.NoIPRanges)
+	kinesisService := awskinesis.NewService(awsCfg)
+	mskService := awskafka.NewService(awsCfg)
+	cloudTrailService := awscloudtrail.NewService(awsCfg)
+	outputService := output.NewService(output.Options{
+		Targets:      flags.Outputs,
+		TemplatePath: flags.Template,
+		Region:       awsCfg.Region,
+	})
+	metricsService := metrics.NewService(awsCfg.Region)
+	tuiService := tui.NewService()
 	updateService := update.NewService()
 
-	orchestratorService := orchestrator.NewService(stsService, costService, ec2Service, elbService, outputService, updateService, versionInfo)
+	orchestratorService := orchestrator.NewService(orchestrator.Dependencies{
+		STS:              stsService,
+		Cost:             costService,
+		EC2:              ec2Service,
+		ELB:              elbService,
+		ComputeOptimizer: computeOptimizerService,
+		EKS:              eksService,
+		ECS:              ecsService,
+		SageMaker:        sageMakerService,
+		Redshift:         redshiftService,
+		EFS:              efsService,
+		Backup:           backupService,
+		SecretsManager:   secretsManagerService,
+		KMS:              kmsService,
+		Route53:          route53Service,
+		Kinesis:          kinesisService,
+		MSK:              mskService,
+		CloudTrail:       cloudTrailService,
+		Output:           outputService,
+		Metrics:          metricsService,
+		TUI:              tuiService,
+		Update:           updateService,
+	}, versionInfo)
 
 	if err := orchestratorService.Orchestrate(flags); err != nil {
 		return fmt.Errorf("orchestration failed: %w", err)
@@ -81,3 +146,15 @@ func run() error {
 
 	return nil
 }
+
+// writesDataToStdout reports whether a report is written to standard output in a format other than
+// the table, such as JSON, CSV or OpenMetrics
+func writesDataToStdout(targets []model.OutputTarget) bool {
+	for _, target := range targets {
+		if target.Path == "" && target.Format != "table" {
+			return true
+		}
+	}
+
+	return false
+}
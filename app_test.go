@@ -7,6 +7,8 @@ import (
 	"os/exec"
 	"strings"
 	"testing"
+
+	"github.com/elC0mpa/aws-doctor/model"
 )
 
 func TestVersionVariablesHaveDefaults(t *testing.T) {
@@ -24,6 +26,28 @@ func TestVersionVariablesHaveDefaults(t *testing.T) {
 	}
 }
 
+func TestWritesDataToStdout(t *testing.T) {
+	tests := []struct {
+		name    string
+		targets []model.OutputTarget
+		want    bool
+	}{
+		{"table", []model.OutputTarget{{Format: "table"}}, false},
+		{"json to stdout", []model.OutputTarget{{Format: "json"}}, true},
+		{"openmetrics to stdout", []model.OutputTarget{{Format: "openmetrics"}}, true},
+		{"table with files", []model.OutputTarget{{Format: "table"}, {Format: "csv", Path: "report.csv"}}, false},
+		{"csv to stdout with files", []model.OutputTarget{{Format: "pdf", Path: "report.pdf"}, {Format: "csv"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := writesDataToStdout(tt.targets); got != tt.want {
+				t.Errorf("writesDataToStdout(%v) = %v, want %v", tt.targets, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestVersionOutput(t *testing.T) {
 	// Build the binary
 	tmpBinary := t.TempDir() + "/aws-doctor-test"
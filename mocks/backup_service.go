@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBackupService is a mock implementation of the AWS Backup service interface.
+type MockBackupService struct {
+	mock.Mock
+}
+
+// GetRecoveryPointWaste mocks the GetRecoveryPointWaste method.
+func (m *MockBackupService) GetRecoveryPointWaste(ctx context.Context) ([]model.BackupRecoveryPointWasteInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.BackupRecoveryPointWasteInfo), args.Error(1)
+}
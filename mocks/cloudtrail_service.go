@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCloudTrailService is a mock implementation of the CloudTrail service interface.
+type MockCloudTrailService struct {
+	mock.Mock
+}
+
+// GetTrailWaste mocks the GetTrailWaste method.
+func (m *MockCloudTrailService) GetTrailWaste(ctx context.Context) ([]model.CloudTrailWasteInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.CloudTrailWasteInfo), args.Error(1)
+}
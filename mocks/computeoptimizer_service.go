@@ -0,0 +1,49 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockComputeOptimizerService is a mock implementation of the Compute Optimizer service interface.
+type MockComputeOptimizerService struct {
+	mock.Mock
+}
+
+// IsOptedIn mocks the IsOptedIn method.
+func (m *MockComputeOptimizerService) IsOptedIn(ctx context.Context) (bool, error) {
+	args := m.Called(ctx)
+	return args.Bool(0), args.Error(1)
+}
+
+// GetEC2InstanceRecommendations mocks the GetEC2InstanceRecommendations method.
+func (m *MockComputeOptimizerService) GetEC2InstanceRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.RightsizingRecommendationInfo), args.Error(1)
+}
+
+// GetEBSVolumeRecommendations mocks the GetEBSVolumeRecommendations method.
+func (m *MockComputeOptimizerService) GetEBSVolumeRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.RightsizingRecommendationInfo), args.Error(1)
+}
+
+// GetLambdaFunctionRecommendations mocks the GetLambdaFunctionRecommendations method.
+func (m *MockComputeOptimizerService) GetLambdaFunctionRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.RightsizingRecommendationInfo), args.Error(1)
+}
@@ -54,6 +54,16 @@ func (m *MockCostService) GetCurrentMonthTotalCosts(ctx context.Context) (*strin
 	return args.Get(0).(*string), args.Error(1)
 }
 
+// GetCurrentMonthForecast mocks the GetCurrentMonthForecast method.
+func (m *MockCostService) GetCurrentMonthForecast(ctx context.Context) (*model.CostForecast, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CostForecast), args.Error(1)
+}
+
 // GetLastMonthTotalCosts mocks the GetLastMonthTotalCosts method.
 func (m *MockCostService) GetLastMonthTotalCosts(ctx context.Context) (*string, error) {
 	args := m.Called(ctx)
@@ -73,3 +83,80 @@ func (m *MockCostService) GetLastSixMonthsCosts(ctx context.Context) ([]model.Co
 
 	return args.Get(0).([]model.CostInfo), args.Error(1)
 }
+
+// GetReservationSummary mocks the GetReservationSummary method.
+func (m *MockCostService) GetReservationSummary(ctx context.Context, lookbackDays int) (*model.CommitmentSummaryInfo, error) {
+	args := m.Called(ctx, lookbackDays)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CommitmentSummaryInfo), args.Error(1)
+}
+
+// GetSavingsPlansSummary mocks the GetSavingsPlansSummary method.
+func (m *MockCostService) GetSavingsPlansSummary(ctx context.Context, lookbackDays int) (*model.CommitmentSummaryInfo, error) {
+	args := m.Called(ctx, lookbackDays)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CommitmentSummaryInfo), args.Error(1)
+}
+
+// GetUnderutilizedReservations mocks the GetUnderutilizedReservations method.
+func (m *MockCostService) GetUnderutilizedReservations(ctx context.Context, lookbackDays int, utilizationThreshold float64) ([]model.CommitmentUtilizationInfo, error) {
+	args := m.Called(ctx, lookbackDays, utilizationThreshold)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.CommitmentUtilizationInfo), args.Error(1)
+}
+
+// GetSavingsPlansCommitments mocks the GetSavingsPlansCommitments method.
+func (m *MockCostService) GetSavingsPlansCommitments(ctx context.Context, lookbackDays int, utilizationThreshold float64, expiryDays int) ([]model.CommitmentUtilizationInfo, []model.CommitmentUtilizationInfo, error) {
+	args := m.Called(ctx, lookbackDays, utilizationThreshold, expiryDays)
+
+	var underutilized, expiring []model.CommitmentUtilizationInfo
+
+	if args.Get(0) != nil {
+		underutilized = args.Get(0).([]model.CommitmentUtilizationInfo)
+	}
+
+	if args.Get(1) != nil {
+		expiring = args.Get(1).([]model.CommitmentUtilizationInfo)
+	}
+
+	return underutilized, expiring, args.Error(2)
+}
+
+// GetSavingsPlansPurchaseRecommendations mocks the GetSavingsPlansPurchaseRecommendations method.
+func (m *MockCostService) GetSavingsPlansPurchaseRecommendations(ctx context.Context, options model.RecommendationOptions) ([]model.PurchaseRecommendationInfo, error) {
+	args := m.Called(ctx, options)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.PurchaseRecommendationInfo), args.Error(1)
+}
+
+// GetReservationPurchaseRecommendations mocks the GetReservationPurchaseRecommendations method.
+func (m *MockCostService) GetReservationPurchaseRecommendations(ctx context.Context, options model.RecommendationOptions) ([]model.PurchaseRecommendationInfo, error) {
+	args := m.Called(ctx, options)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.PurchaseRecommendationInfo), args.Error(1)
+}
+
+// GetRightsizingRecommendations mocks the GetRightsizingRecommendations method.
+func (m *MockCostService) GetRightsizingRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.RightsizingRecommendationInfo), args.Error(1)
+}
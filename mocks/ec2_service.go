@@ -73,14 +73,44 @@ func (m *MockEC2Service) GetReservedInstanceExpiringOrExpired30DaysWaste(ctx con
 	return args.Get(0).([]model.RiExpirationInfo), args.Error(1)
 }
 
-// GetUnusedAMIs mocks the GetUnusedAMIs method.
-func (m *MockEC2Service) GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMIWasteInfo, error) {
-	args := m.Called(ctx, staleDays)
+// GetUnusedCapacityReservations mocks the GetUnusedCapacityReservations method.
+func (m *MockEC2Service) GetUnusedCapacityReservations(ctx context.Context, utilizationThreshold float64) ([]model.CapacityReservationWasteInfo, error) {
+	args := m.Called(ctx, utilizationThreshold)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.CapacityReservationWasteInfo), args.Error(1)
+}
+
+// GetIdleDedicatedHosts mocks the GetIdleDedicatedHosts method.
+func (m *MockEC2Service) GetIdleDedicatedHosts(ctx context.Context) ([]model.DedicatedHostWasteInfo, error) {
+	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 
-	return args.Get(0).([]model.AMIWasteInfo), args.Error(1)
+	return args.Get(0).([]model.DedicatedHostWasteInfo), args.Error(1)
+}
+
+// GetUnusedAMIs mocks the GetUnusedAMIs method.
+func (m *MockEC2Service) GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMIWasteInfo, []model.SkippedCheck, error) {
+	args := m.Called(ctx, staleDays)
+
+	var (
+		amis    []model.AMIWasteInfo
+		skipped []model.SkippedCheck
+	)
+
+	if args.Get(0) != nil {
+		amis = args.Get(0).([]model.AMIWasteInfo)
+	}
+
+	if args.Get(1) != nil {
+		skipped = args.Get(1).([]model.SkippedCheck)
+	}
+
+	return amis, skipped, args.Error(2)
 }
 
 // GetOrphanedSnapshots mocks the GetOrphanedSnapshots method.
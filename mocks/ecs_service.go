@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockECSService is a mock implementation of the ECS service interface.
+type MockECSService struct {
+	mock.Mock
+}
+
+// GetIdleServices mocks the GetIdleServices method.
+func (m *MockECSService) GetIdleServices(ctx context.Context) ([]model.ECSServiceWasteInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.ECSServiceWasteInfo), args.Error(1)
+}
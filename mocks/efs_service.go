@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEFSService is a mock implementation of the EFS service interface.
+type MockEFSService struct {
+	mock.Mock
+}
+
+// GetFileSystemWaste mocks the GetFileSystemWaste method.
+func (m *MockEFSService) GetFileSystemWaste(ctx context.Context, idleDays int, standardSizeThresholdGB float64) ([]model.EFSFileSystemWasteInfo, error) {
+	args := m.Called(ctx, idleDays, standardSizeThresholdGB)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.EFSFileSystemWasteInfo), args.Error(1)
+}
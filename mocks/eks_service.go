@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEKSService is a mock implementation of the EKS service interface.
+type MockEKSService struct {
+	mock.Mock
+}
+
+// GetIdleClusters mocks the GetIdleClusters method.
+func (m *MockEKSService) GetIdleClusters(ctx context.Context) ([]model.EKSClusterWasteInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.EKSClusterWasteInfo), args.Error(1)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/elC0mpa/aws-doctor/model"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -21,3 +22,13 @@ func (m *MockELBService) GetUnusedLoadBalancers(ctx context.Context) ([]elbtypes
 
 	return args.Get(0).([]elbtypes.LoadBalancer), args.Error(1)
 }
+
+// GetUnusedClassicLoadBalancers mocks the GetUnusedClassicLoadBalancers method.
+func (m *MockELBService) GetUnusedClassicLoadBalancers(ctx context.Context) ([]model.ClassicLoadBalancerWasteInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.ClassicLoadBalancerWasteInfo), args.Error(1)
+}
@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockKinesisService is a mock implementation of the Kinesis service interface.
+type MockKinesisService struct {
+	mock.Mock
+}
+
+// GetStreamWaste mocks the GetStreamWaste method.
+func (m *MockKinesisService) GetStreamWaste(ctx context.Context, observationDays int) ([]model.KinesisStreamWasteInfo, error) {
+	args := m.Called(ctx, observationDays)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.KinesisStreamWasteInfo), args.Error(1)
+}
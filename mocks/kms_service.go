@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockKMSService is a mock implementation of the KMS service interface.
+type MockKMSService struct {
+	mock.Mock
+}
+
+// GetUnusedKeys mocks the GetUnusedKeys method.
+func (m *MockKMSService) GetUnusedKeys(ctx context.Context, unusedDays int) ([]model.KMSKeyWasteInfo, error) {
+	args := m.Called(ctx, unusedDays)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.KMSKeyWasteInfo), args.Error(1)
+}
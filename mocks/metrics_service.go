@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMetricsService is a mock implementation of the metrics Service interface.
+type MockMetricsService struct {
+	mock.Mock
+}
+
+// RecordCostComparison mocks the RecordCostComparison method.
+func (m *MockMetricsService) RecordCostComparison(accountID, lastTotalCost, currentTotalCost string, lastMonth, currentMonth *model.CostInfo, forecast *model.CostForecast) {
+	m.Called(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth, forecast)
+}
+
+// RecordWaste mocks the RecordWaste method.
+func (m *MockMetricsService) RecordWaste(accountID string, report model.WasteReport) {
+	m.Called(accountID, report)
+}
+
+// RecordFailure mocks the RecordFailure method.
+func (m *MockMetricsService) RecordFailure(workflow string) {
+	m.Called(workflow)
+}
+
+// Handler mocks the Handler method.
+func (m *MockMetricsService) Handler() http.Handler {
+	args := m.Called()
+	return args.Get(0).(http.Handler)
+}
+
+// Serve mocks the Serve method.
+func (m *MockMetricsService) Serve(ctx context.Context, addr string) error {
+	args := m.Called(ctx, addr)
+	return args.Error(0)
+}
@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMSKService is a mock implementation of the MSK service interface.
+type MockMSKService struct {
+	mock.Mock
+}
+
+// GetIdleClusters mocks the GetIdleClusters method.
+func (m *MockMSKService) GetIdleClusters(ctx context.Context, observationDays int, bytesInThreshold float64) ([]model.MSKClusterWasteInfo, error) {
+	args := m.Called(ctx, observationDays, bytesInThreshold)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.MSKClusterWasteInfo), args.Error(1)
+}
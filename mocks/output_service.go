@@ -1,8 +1,6 @@
 package mocks
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/elC0mpa/aws-doctor/model"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,8 +23,32 @@ func (m *MockOutputService) RenderTrend(accountID string, costInfo []model.CostI
 }
 
 // RenderWaste mocks the RenderWaste method.
-func (m *MockOutputService) RenderWaste(accountID string, elasticIPs []types.Address, unusedVolumes []types.Volume, stoppedVolumes []types.Volume, ris []model.RiExpirationInfo, stoppedInstances []types.Instance, loadBalancers []elbtypes.LoadBalancer, unusedAMIs []model.AMIWasteInfo, orphanedSnapshots []model.SnapshotWasteInfo) error {
-	args := m.Called(accountID, elasticIPs, unusedVolumes, stoppedVolumes, ris, stoppedInstances, loadBalancers, unusedAMIs, orphanedSnapshots)
+func (m *MockOutputService) RenderWaste(accountID string, report model.WasteReport) error {
+	args := m.Called(accountID, report)
+	return args.Error(0)
+}
+
+// RenderCommitments mocks the RenderCommitments method.
+func (m *MockOutputService) RenderCommitments(accountID string, report model.CommitmentReport) error {
+	args := m.Called(accountID, report)
+	return args.Error(0)
+}
+
+// RenderRecommendations mocks the RenderRecommendations method.
+func (m *MockOutputService) RenderRecommendations(accountID string, report model.RecommendationReport) error {
+	args := m.Called(accountID, report)
+	return args.Error(0)
+}
+
+// RenderRightsizing mocks the RenderRightsizing method.
+func (m *MockOutputService) RenderRightsizing(accountID string, report model.RightsizingReport) error {
+	args := m.Called(accountID, report)
+	return args.Error(0)
+}
+
+// RenderSchema mocks the RenderSchema method.
+func (m *MockOutputService) RenderSchema() error {
+	args := m.Called()
 	return args.Error(0)
 }
 
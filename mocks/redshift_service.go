@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRedshiftService is a mock implementation of the Redshift service interface.
+type MockRedshiftService struct {
+	mock.Mock
+}
+
+// GetIdleClusters mocks the GetIdleClusters method.
+func (m *MockRedshiftService) GetIdleClusters(ctx context.Context, observationDays int, cpuThreshold float64) ([]model.RedshiftClusterWasteInfo, error) {
+	args := m.Called(ctx, observationDays, cpuThreshold)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.RedshiftClusterWasteInfo), args.Error(1)
+}
+
+// GetStaleManualSnapshots mocks the GetStaleManualSnapshots method.
+func (m *MockRedshiftService) GetStaleManualSnapshots(ctx context.Context, staleDays int) ([]model.RedshiftSnapshotWasteInfo, error) {
+	args := m.Called(ctx, staleDays)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.RedshiftSnapshotWasteInfo), args.Error(1)
+}
@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRoute53Service is a mock implementation of the Route 53 service interface.
+type MockRoute53Service struct {
+	mock.Mock
+}
+
+// GetUnusedResources mocks the GetUnusedResources method.
+func (m *MockRoute53Service) GetUnusedResources(ctx context.Context) ([]model.Route53HostedZoneWasteInfo, []model.Route53HealthCheckWasteInfo, []model.SkippedCheck, error) {
+	args := m.Called(ctx)
+
+	var (
+		zones        []model.Route53HostedZoneWasteInfo
+		healthChecks []model.Route53HealthCheckWasteInfo
+		skipped      []model.SkippedCheck
+	)
+
+	if args.Get(0) != nil {
+		zones = args.Get(0).([]model.Route53HostedZoneWasteInfo)
+	}
+
+	if args.Get(1) != nil {
+		healthChecks = args.Get(1).([]model.Route53HealthCheckWasteInfo)
+	}
+
+	if args.Get(2) != nil {
+		skipped = args.Get(2).([]model.SkippedCheck)
+	}
+
+	return zones, healthChecks, skipped, args.Error(3)
+}
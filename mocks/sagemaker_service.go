@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSageMakerService is a mock implementation of the SageMaker service interface.
+type MockSageMakerService struct {
+	mock.Mock
+}
+
+// GetIdleEndpoints mocks the GetIdleEndpoints method.
+func (m *MockSageMakerService) GetIdleEndpoints(ctx context.Context, idleDays int) ([]model.SageMakerEndpointWasteInfo, error) {
+	args := m.Called(ctx, idleDays)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.SageMakerEndpointWasteInfo), args.Error(1)
+}
+
+// GetLongRunningNotebookInstances mocks the GetLongRunningNotebookInstances method.
+func (m *MockSageMakerService) GetLongRunningNotebookInstances(ctx context.Context, days int) ([]model.SageMakerNotebookWasteInfo, error) {
+	args := m.Called(ctx, days)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.SageMakerNotebookWasteInfo), args.Error(1)
+}
@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSecretsManagerService is a mock implementation of the Secrets Manager service interface.
+type MockSecretsManagerService struct {
+	mock.Mock
+}
+
+// GetUnusedSecrets mocks the GetUnusedSecrets method.
+func (m *MockSecretsManagerService) GetUnusedSecrets(ctx context.Context, unusedDays int) ([]model.SecretWasteInfo, error) {
+	args := m.Called(ctx, unusedDays)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]model.SecretWasteInfo), args.Error(1)
+}
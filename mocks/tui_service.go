@@ -0,0 +1,19 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/elC0mpa/aws-doctor/service/tui"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTUIService is a mock implementation of the tui Service interface.
+type MockTUIService struct {
+	mock.Mock
+}
+
+// Run mocks the Run method.
+func (m *MockTUIService) Run(ctx context.Context, load tui.LoadFunc) error {
+	args := m.Called(ctx, load)
+	return args.Error(0)
+}
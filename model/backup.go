@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// AWS Backup recovery point waste statuses
+const (
+	BackupRecoveryPointSourceDeleted = "SOURCE_DELETED"
+	BackupRecoveryPointNoLifecycle   = "NO_LIFECYCLE"
+	BackupRecoveryPointSourceUnknown = "SOURCE_UNKNOWN"
+)
+
+// BackupRecoveryPointWasteInfo contains information about an AWS Backup recovery point whose source
+// resource no longer exists, whose source is in another account or region and cannot be checked,
+// or which is kept indefinitely because no deletion lifecycle is configured
+type BackupRecoveryPointWasteInfo struct {
+	RecoveryPointArn     string
+	BackupVaultName      string
+	ResourceArn          string
+	ResourceType         string
+	ResourceName         string
+	CreationDate         time.Time
+	DaysSinceCreate      int
+	SizeGB               float64
+	Status               string // "SOURCE_DELETED", "SOURCE_UNKNOWN" or "NO_LIFECYCLE"; the source statuses take precedence
+	HasDeleteLifecycle   bool
+	EstimatedMonthlyCost float64 // Zero when the source is unknown
+}
@@ -0,0 +1,23 @@
+package model
+
+// CloudTrail trail waste statuses
+const (
+	CloudTrailDuplicateManagementEvents = "DUPLICATE_MANAGEMENT_EVENTS"
+	CloudTrailAllS3DataEvents           = "ALL_S3_DATA_EVENTS"
+	CloudTrailAllLambdaDataEvents       = "ALL_LAMBDA_DATA_EVENTS"
+)
+
+// CloudTrailWasteInfo contains information about a trail that records an additional, charged copy
+// of management events in the region, or that logs S3 or Lambda data events for all resources.
+// A trail can appear once per status.
+type CloudTrailWasteInfo struct {
+	TrailName              string
+	TrailARN               string
+	HomeRegion             string
+	IsMultiRegionTrail     bool
+	IsOrganizationTrail    bool
+	Status                 string
+	DuplicateOf            string  // Trail kept as the free copy of management events, only set for duplicates
+	EstimatedMonthlyEvents float64 // 0 when the event volume cannot be estimated
+	EstimatedMonthlyCost   float64 // 0 when the event volume cannot be estimated
+}
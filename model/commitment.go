@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// CommitmentType identifies the kind of compute commitment
+type CommitmentType string
+
+const (
+	// CommitmentTypeReservedInstance - Reserved Instance purchased for a specific instance type
+	CommitmentTypeReservedInstance CommitmentType = "reserved_instance"
+	// CommitmentTypeSavingsPlan - Savings Plan with an hourly spend commitment
+	CommitmentTypeSavingsPlan CommitmentType = "savings_plan"
+)
+
+// CommitmentSummaryInfo holds account-wide utilization and coverage for one commitment type
+type CommitmentSummaryInfo struct {
+	CommitmentType     CommitmentType
+	UtilizationPercent float64
+	UnusedHours        float64 // Reserved Instances only
+	UnusedCommitment   float64 // Amount paid for commitment that was not used
+	CoveragePercent    float64
+	OnDemandCost       float64 // Eligible spend not covered by the commitment type
+}
+
+// CommitmentUtilizationInfo holds utilization information for a single Reserved Instance or Savings Plan
+type CommitmentUtilizationInfo struct {
+	CommitmentType     CommitmentType
+	ID                 string // Reserved Instance subscription ID or Savings Plan ARN
+	Description        string // Instance type and region, or Savings Plan type
+	UtilizationPercent float64
+	UnusedHours        float64 // Reserved Instances only
+	UnusedCommitment   float64
+	EndDate            time.Time
+	DaysUntilExpiry    int
+}
+
+// CommitmentReport aggregates the findings of the commitments workflow
+type CommitmentReport struct {
+	LookbackDays              int
+	Reservations              *CommitmentSummaryInfo
+	SavingsPlans              *CommitmentSummaryInfo
+	UnderutilizedReservations []CommitmentUtilizationInfo
+	UnderutilizedSavingsPlans []CommitmentUtilizationInfo
+	ExpiringSavingsPlans      []CommitmentUtilizationInfo
+}
@@ -0,0 +1,28 @@
+package model
+
+// EKS cluster idle statuses
+const (
+	EKSClusterNoNodeGroups = "NO_NODE_GROUPS"
+	EKSClusterZeroNodes    = "ZERO_NODES"
+)
+
+// EKSClusterWasteInfo contains information about an EKS cluster whose control plane is billed
+// without running workloads, or which runs a Kubernetes version in extended support
+type EKSClusterWasteInfo struct {
+	ClusterName          string
+	KubernetesVersion    string
+	NodeGroupCount       int
+	Status               string // "NO_NODE_GROUPS", "ZERO_NODES" or empty when the cluster has nodes
+	ExtendedSupport      bool
+	EstimatedMonthlyCost float64 // Full control plane cost for idle clusters, extended support fee otherwise
+}
+
+// ECSServiceWasteInfo contains information about an ECS service scaled to zero tasks
+// whose target groups still exist
+type ECSServiceWasteInfo struct {
+	ClusterName          string
+	ServiceName          string
+	TargetGroupArns      []string
+	LoadBalancerArns     []string
+	EstimatedMonthlyCost float64 // Base cost of the attached load balancers that serve no running service, each counted once
+}
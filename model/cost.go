@@ -21,3 +21,9 @@ type ServiceCost struct {
 	Amount float64
 	Unit   string
 }
+
+// CostForecast holds the Cost Explorer forecast for the rest of the current month.
+type CostForecast struct {
+	Amount float64
+	Unit   string
+}
@@ -37,7 +37,7 @@ type AMIWasteInfo struct {
 	SnapshotSizeGB     int64    // Total size of associated snapshots
 	UsedByInstances    int      // Number of instances using this AMI
 	MaxPotentialSaving float64  // Max potential monthly savings (snapshot storage cost)
-	SafetyWarning      string   // Warning about potential ASG/Launch Template usage
+	SafetyWarning      string   // Launch template versions that still reference the AMI, empty when none do
 }
 
 // SnapshotCategory indicates whether a snapshot is orphaned or stale
@@ -50,6 +50,12 @@ const (
 	SnapshotCategoryStale SnapshotCategory = "stale"
 )
 
+// Tools that create and manage EBS snapshots on the user's behalf
+const (
+	SnapshotManagedByBackup = "AWS Backup"
+	SnapshotManagedByDLM    = "Data Lifecycle Manager"
+)
+
 // SnapshotWasteInfo contains information about potentially orphaned EBS snapshots
 type SnapshotWasteInfo struct {
 	SnapshotID          string
@@ -64,4 +70,29 @@ type SnapshotWasteInfo struct {
 	Category            SnapshotCategory // "orphaned" or "stale"
 	Reason              string           // Human-readable reason (e.g., "Volume Deleted", "Old Backup")
 	MaxPotentialSavings float64          // Max monthly savings (actual may be lower due to incremental storage)
+	ManagedBy           string           // "Data Lifecycle Manager" or empty for manual snapshots; AWS Backup snapshots are not reported
+}
+
+// CapacityReservationWasteInfo contains information about an underutilized On-Demand Capacity Reservation
+type CapacityReservationWasteInfo struct {
+	CapacityReservationID  string
+	InstanceType           string
+	InstancePlatform       string
+	AvailabilityZone       string
+	TotalInstanceCount     int32
+	AvailableInstanceCount int32   // Reserved capacity not used by any running instance
+	UtilizationPercent     float64 // Share of the reserved capacity in use
+	State                  string
+	Status                 string // "UNUSED" or "UNDERUTILIZED"
+}
+
+// DedicatedHostWasteInfo contains information about an allocated Dedicated Host with no instances
+type DedicatedHostWasteInfo struct {
+	HostID              string
+	InstanceFamily      string
+	InstanceType        string // Empty when the host supports multiple instance types
+	AvailabilityZone    string
+	State               string
+	AllocationTime      time.Time
+	DaysSinceAllocation int
 }
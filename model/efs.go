@@ -0,0 +1,23 @@
+package model
+
+// EFS file system waste statuses
+const (
+	EFSFileSystemNoMountTargets = "NO_MOUNT_TARGETS"
+	EFSFileSystemNoConnections  = "NO_CONNECTIONS"
+	EFSFileSystemNoIALifecycle  = "NO_IA_LIFECYCLE"
+)
+
+// EFSFileSystemWasteInfo contains information about an EFS file system that is not mounted,
+// had no client connections during the idle period, or stores significant Standard-class data
+// without a lifecycle policy transitioning it to Infrequent Access
+type EFSFileSystemWasteInfo struct {
+	FileSystemID         string
+	Name                 string
+	MountTargetCount     int32
+	StandardSizeGB       float64
+	TotalSizeGB          float64
+	Status               string // "NO_MOUNT_TARGETS", "NO_CONNECTIONS" or "NO_IA_LIFECYCLE"
+	HasIALifecycle       bool
+	EstimatedMonthlyCost float64 // Storage cost of every storage class
+	PotentialIASavings   float64 // Monthly savings if all Standard data moved to Infrequent Access, 0 with a lifecycle policy
+}
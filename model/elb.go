@@ -0,0 +1,15 @@
+package model
+
+// Classic Load Balancer waste statuses
+const (
+	ClassicLoadBalancerNoInstances     = "NO_INSTANCES"
+	ClassicLoadBalancerAllOutOfService = "ALL_OUT_OF_SERVICE"
+)
+
+// ClassicLoadBalancerWasteInfo contains information about a Classic Load Balancer that serves no traffic
+type ClassicLoadBalancerWasteInfo struct {
+	Name          string
+	DNSName       string
+	InstanceCount int
+	Status        string // "NO_INSTANCES" or "ALL_OUT_OF_SERVICE"
+}
@@ -1,12 +1,45 @@
 package model
 
+import "time"
+
 // Flags represents the command-line flags for the application.
 type Flags struct {
-	Region  string
-	Profile string
-	Trend   bool
-	Waste   bool
-	Version bool
-	Update  bool
-	Output  string // Output format: "table" (default) or "json"
+	Region      string
+	Profile     string
+	Trend       bool
+	Waste       bool
+	Commitments bool
+	Rightsizing bool
+	// Recommendations enables the Savings Plans and Reserved Instance purchase recommendations report,
+	// configured by Term, PaymentOption and LookbackDays.
+	Recommendations bool
+	Term            int    // Commitment term in years: 1 or 3
+	PaymentOption   string // "no-upfront", "partial-upfront" or "all-upfront"
+	LookbackDays    int    // 7, 30 or 60
+	Version         bool
+	Schema          bool // Set by the "schema" command, which prints the JSON Schema of the JSON reports
+	Update          bool
+	Output          string // Raw --output value: a comma-separated list of format[:path] entries
+	OutFile         string // File the report is written to when a single format is requested
+	Template        string // text/template file executed by the "template" format
+	// Outputs lists every format the report is rendered in, parsed from Output and OutFile.
+	Outputs []OutputTarget
+	// Serve is set by the "serve" command, which runs the cost and waste workflows every Interval and,
+	// with Metrics, exposes their results as Prometheus gauges on Listen.
+	Serve    bool
+	Metrics  bool
+	Listen   string
+	Interval time.Duration
+	// NoIPRanges skips downloading the AWS IP address ranges, which disables the check of the
+	// addresses of Route 53 A records.
+	NoIPRanges bool
+	// TUI is set by the "tui" command, which shows the cost, trend and waste reports in an interactive dashboard.
+	TUI bool
+}
+
+// OutputTarget is a single report destination: a format and the file it is written to.
+// An empty Path means standard output.
+type OutputTarget struct {
+	Format string // "table", "json", "csv", "markdown", "pdf", "html", "template" or "openmetrics"
+	Path   string
 }
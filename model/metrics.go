@@ -0,0 +1,20 @@
+package model
+
+// MetricFamily is a named gauge and its samples, as exposed to Prometheus.
+type MetricFamily struct {
+	Name    string
+	Help    string
+	Samples []MetricSample
+}
+
+// MetricSample is a single gauge value identified by its labels.
+type MetricSample struct {
+	Labels []MetricLabel // Written in order, so every sample of a family uses the same order
+	Value  float64
+}
+
+// MetricLabel is a label name and value pair.
+type MetricLabel struct {
+	Name  string
+	Value string
+}
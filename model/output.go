@@ -1,7 +1,12 @@
 package model
 
+// JSONSchemaVersion is the version of the JSON report format, reported in the schema_version field
+// of every report. It changes whenever a field is renamed, removed or changes type.
+const JSONSchemaVersion = "2.0"
+
 // CostComparisonJSON represents the JSON output for cost comparison
 type CostComparisonJSON struct {
+	SchemaVersion    string                   `json:"schema_version"`
 	AccountID        string                   `json:"account_id"`
 	GeneratedAt      string                   `json:"generated_at"`
 	CurrentMonth     CostPeriodJSON           `json:"current_month"`
@@ -28,9 +33,10 @@ type ServiceCostCompareJSON struct {
 
 // TrendJSON represents the JSON output for trend analysis
 type TrendJSON struct {
-	AccountID   string          `json:"account_id"`
-	GeneratedAt string          `json:"generated_at"`
-	Months      []MonthCostJSON `json:"months"`
+	SchemaVersion string          `json:"schema_version"`
+	AccountID     string          `json:"account_id"`
+	GeneratedAt   string          `json:"generated_at"`
+	Months        []MonthCostJSON `json:"months"`
 }
 
 // MonthCostJSON represents cost data for a single month
@@ -43,18 +49,47 @@ type MonthCostJSON struct {
 
 // WasteReportJSON represents the JSON output for waste detection
 type WasteReportJSON struct {
-	AccountID           string                 `json:"account_id"`
-	GeneratedAt         string                 `json:"generated_at"`
-	HasWaste            bool                   `json:"has_waste"`
-	UnusedElasticIPs    []ElasticIPJSON        `json:"unused_elastic_ips"`
-	UnusedEBSVolumes    []EBSVolumeJSON        `json:"unused_ebs_volumes"`
-	StoppedVolumes      []EBSVolumeJSON        `json:"stopped_instance_volumes"`
-	StoppedInstances    []StoppedInstanceJSON  `json:"stopped_instances"`
-	ReservedInstances   []ReservedInstanceJSON `json:"reserved_instances"`
-	UnusedLoadBalancers []LoadBalancerJSON     `json:"unused_load_balancers"`
-	UnusedAMIs          []AMIJSON              `json:"unused_amis"`
-	OrphanedSnapshots   []SnapshotJSON         `json:"orphaned_snapshots"`
-	StaleSnapshots      []SnapshotJSON         `json:"stale_snapshots"`
+	SchemaVersion                 string                    `json:"schema_version"`
+	AccountID                     string                    `json:"account_id"`
+	GeneratedAt                   string                    `json:"generated_at"`
+	HasWaste                      bool                      `json:"has_waste"`
+	UnusedElasticIPs              []ElasticIPJSON           `json:"unused_elastic_ips"`
+	UnusedEBSVolumes              []EBSVolumeJSON           `json:"unused_ebs_volumes"`
+	StoppedVolumes                []EBSVolumeJSON           `json:"stopped_instance_volumes"`
+	StoppedInstances              []StoppedInstanceJSON     `json:"stopped_instances"`
+	ReservedInstances             []ReservedInstanceJSON    `json:"reserved_instances"`
+	CapacityReservations          []CapacityReservationJSON `json:"unused_capacity_reservations"`
+	DedicatedHosts                []DedicatedHostJSON       `json:"idle_dedicated_hosts"`
+	UnusedLoadBalancers           []LoadBalancerJSON        `json:"unused_load_balancers"`
+	UnusedClassicLBs              []ClassicLoadBalancerJSON `json:"unused_classic_load_balancers"`
+	IdleEKSClusters               []EKSClusterJSON          `json:"idle_eks_clusters"`
+	IdleECSServices               []ECSServiceJSON          `json:"idle_ecs_services"`
+	IdleSageMakerEndpoints        []SageMakerEndpointJSON   `json:"idle_sagemaker_endpoints"`
+	LongRunningSageMakerNotebooks []SageMakerNotebookJSON   `json:"long_running_sagemaker_notebooks"`
+	IdleRedshiftClusters          []RedshiftClusterJSON     `json:"idle_redshift_clusters"`
+	StaleRedshiftSnapshots        []RedshiftSnapshotJSON    `json:"stale_redshift_snapshots"`
+	EFSFileSystems                []EFSFileSystemJSON       `json:"efs_file_systems"`
+	BackupRecoveryPoints          []BackupRecoveryPointJSON `json:"backup_recovery_points"`
+	UnusedSecrets                 []SecretJSON              `json:"unused_secrets"`
+	UnusedKMSKeys                 []KMSKeyJSON              `json:"unused_kms_keys"`
+	Route53HostedZones            []Route53HostedZoneJSON   `json:"route53_hosted_zones"`
+	UnusedRoute53Checks           []Route53HealthCheckJSON  `json:"unused_route53_health_checks"`
+	KinesisStreams                []KinesisStreamJSON       `json:"kinesis_streams"`
+	IdleMSKClusters               []MSKClusterJSON          `json:"idle_msk_clusters"`
+	CloudTrailTrails              []CloudTrailJSON          `json:"cloudtrail_trails"`
+	UnusedAMIs                    []AMIJSON                 `json:"unused_amis"`
+	OrphanedSnapshots             []SnapshotJSON            `json:"orphaned_snapshots"`
+	StaleSnapshots                []SnapshotJSON            `json:"stale_snapshots"`
+	SkippedChecks                 []SkippedCheckJSON        `json:"skipped_checks"`
+}
+
+// SkippedCheckJSON represents a waste check that could not run; its categories are empty because
+// they were not checked, not because no waste was found. A check with no categories was only
+// partly skipped, and its categories hold the findings of the rest of the check.
+type SkippedCheckJSON struct {
+	Name       string   `json:"name"`
+	Reason     string   `json:"reason"`
+	Categories []string `json:"categories"`
 }
 
 // ElasticIPJSON represents an unused Elastic IP
@@ -87,6 +122,30 @@ type ReservedInstanceJSON struct {
 	Status             string `json:"status"`
 }
 
+// CapacityReservationJSON represents an unused or underutilized capacity reservation
+type CapacityReservationJSON struct {
+	CapacityReservationID  string  `json:"capacity_reservation_id"`
+	InstanceType           string  `json:"instance_type"`
+	InstancePlatform       string  `json:"instance_platform"`
+	AvailabilityZone       string  `json:"availability_zone"`
+	TotalInstanceCount     int32   `json:"total_instance_count"`
+	AvailableInstanceCount int32   `json:"available_instance_count"`
+	UtilizationPercent     float64 `json:"utilization_percent"`
+	State                  string  `json:"state"`
+	Status                 string  `json:"status"` // "UNUSED" or "UNDERUTILIZED"
+}
+
+// DedicatedHostJSON represents a dedicated host with no running instances
+type DedicatedHostJSON struct {
+	HostID              string `json:"host_id"`
+	InstanceFamily      string `json:"instance_family,omitempty"`
+	InstanceType        string `json:"instance_type,omitempty"`
+	AvailabilityZone    string `json:"availability_zone"`
+	State               string `json:"state"`
+	AllocationTime      string `json:"allocation_time,omitempty"`
+	DaysSinceAllocation int    `json:"days_since_allocation"`
+}
+
 // LoadBalancerJSON represents an unused load balancer
 type LoadBalancerJSON struct {
 	Name string `json:"name"`
@@ -94,6 +153,192 @@ type LoadBalancerJSON struct {
 	Type string `json:"type"`
 }
 
+// ClassicLoadBalancerJSON represents a Classic Load Balancer with no in-service instances
+type ClassicLoadBalancerJSON struct {
+	Name          string `json:"name"`
+	DNSName       string `json:"dns_name"`
+	InstanceCount int    `json:"instance_count"`
+	Status        string `json:"status"`
+}
+
+// EKSClusterJSON represents an idle or extended-support EKS cluster
+type EKSClusterJSON struct {
+	ClusterName          string  `json:"cluster_name"`
+	KubernetesVersion    string  `json:"kubernetes_version"`
+	NodeGroupCount       int     `json:"node_group_count"`
+	Status               string  `json:"status,omitempty"`
+	ExtendedSupport      bool    `json:"extended_support"`
+	EstimatedMonthlyCost float64 `json:"estimated_monthly_cost"`
+}
+
+// ECSServiceJSON represents an ECS service scaled to zero with target groups still attached
+type ECSServiceJSON struct {
+	ClusterName          string   `json:"cluster_name"`
+	ServiceName          string   `json:"service_name"`
+	TargetGroupArns      []string `json:"target_group_arns"`
+	LoadBalancerArns     []string `json:"load_balancer_arns"`
+	EstimatedMonthlyCost float64  `json:"estimated_monthly_cost"`
+}
+
+// SageMakerEndpointJSON represents a SageMaker endpoint variant without invocations
+type SageMakerEndpointJSON struct {
+	EndpointName  string  `json:"endpoint_name"`
+	VariantName   string  `json:"variant_name"`
+	InstanceType  string  `json:"instance_type"`
+	InstanceCount int32   `json:"instance_count"`
+	HourlyCost    float64 `json:"estimated_hourly_cost"`
+	IdleDays      int     `json:"idle_days"`
+}
+
+// SageMakerNotebookJSON represents a SageMaker notebook instance running for a long time without restart
+type SageMakerNotebookJSON struct {
+	NotebookInstanceName string  `json:"notebook_instance_name"`
+	InstanceType         string  `json:"instance_type"`
+	LastModifiedTime     string  `json:"last_modified_time"`
+	DaysSinceModified    int     `json:"days_since_modified"`
+	HourlyCost           float64 `json:"estimated_hourly_cost"`
+}
+
+// RedshiftClusterJSON represents a provisioned Redshift cluster with no connections or low CPU
+type RedshiftClusterJSON struct {
+	ClusterIdentifier    string  `json:"cluster_identifier"`
+	NodeType             string  `json:"node_type"`
+	NodeCount            int32   `json:"node_count"`
+	Status               string  `json:"status"` // "NO_CONNECTIONS" or "LOW_CPU"
+	MaxConnections       float64 `json:"max_connections"`
+	AverageCPUPercent    float64 `json:"average_cpu_percent"`
+	ObservationDays      int     `json:"observation_days"`
+	Recommendation       string  `json:"recommendation"`
+	EstimatedMonthlyCost float64 `json:"estimated_monthly_cost"`
+}
+
+// RedshiftSnapshotJSON represents a stale manual Redshift snapshot
+type RedshiftSnapshotJSON struct {
+	SnapshotIdentifier   string  `json:"snapshot_identifier"`
+	ClusterIdentifier    string  `json:"cluster_identifier"`
+	NodeType             string  `json:"node_type"`
+	NodeCount            int32   `json:"node_count"`
+	SizeGB               float64 `json:"size_gb"`
+	CreateTime           string  `json:"create_time"`
+	DaysSinceCreate      int     `json:"days_since_create"`
+	EstimatedMonthlyCost float64 `json:"estimated_monthly_cost"`
+}
+
+// EFSFileSystemJSON represents an unused EFS file system or one without an Infrequent Access lifecycle
+type EFSFileSystemJSON struct {
+	FileSystemID         string  `json:"file_system_id"`
+	Name                 string  `json:"name,omitempty"`
+	MountTargetCount     int32   `json:"mount_target_count"`
+	StandardSizeGB       float64 `json:"standard_size_gb"`
+	TotalSizeGB          float64 `json:"total_size_gb"`
+	Status               string  `json:"status"` // "NO_MOUNT_TARGETS", "NO_CONNECTIONS" or "NO_IA_LIFECYCLE"
+	HasIALifecycle       bool    `json:"has_ia_lifecycle"`
+	EstimatedMonthlyCost float64 `json:"estimated_monthly_cost"`
+	PotentialIASavings   float64 `json:"potential_ia_savings"`
+}
+
+// BackupRecoveryPointJSON represents an AWS Backup recovery point for a deleted resource or without a deletion lifecycle
+type BackupRecoveryPointJSON struct {
+	RecoveryPointArn     string  `json:"recovery_point_arn"`
+	BackupVaultName      string  `json:"backup_vault_name"`
+	ResourceArn          string  `json:"resource_arn"`
+	ResourceType         string  `json:"resource_type"`
+	ResourceName         string  `json:"resource_name,omitempty"`
+	CreationDate         string  `json:"creation_date"`
+	DaysSinceCreate      int     `json:"days_since_create"`
+	SizeGB               float64 `json:"size_gb"`
+	Status               string  `json:"status"` // "SOURCE_DELETED", "SOURCE_UNKNOWN" or "NO_LIFECYCLE"
+	HasDeleteLifecycle   bool    `json:"has_delete_lifecycle"`
+	EstimatedMonthlyCost float64 `json:"estimated_monthly_cost"`
+}
+
+// SecretJSON represents a Secrets Manager secret that has not been accessed recently
+type SecretJSON struct {
+	Name             string  `json:"name"`
+	ARN              string  `json:"arn"`
+	LastAccessedDate string  `json:"last_accessed_date,omitempty"`
+	DaysSinceAccess  int     `json:"days_since_access"`
+	OwningService    string  `json:"owning_service,omitempty"`
+	MonthlyCost      float64 `json:"monthly_cost"`
+}
+
+// KMSKeyJSON represents a disabled or unused customer managed KMS key
+type KMSKeyJSON struct {
+	KeyID        string  `json:"key_id"`
+	ARN          string  `json:"arn"`
+	Description  string  `json:"description,omitempty"`
+	KeyState     string  `json:"key_state"`
+	Status       string  `json:"status"` // "DISABLED" or "UNUSED"
+	CreationDate string  `json:"creation_date"`
+	MonthlyCost  float64 `json:"monthly_cost"`
+}
+
+// Route53HostedZoneJSON represents a hosted zone that is empty or contains dangling or unverifiable records
+type Route53HostedZoneJSON struct {
+	ZoneID          string                      `json:"zone_id"`
+	Name            string                      `json:"name"`
+	PrivateZone     bool                        `json:"private_zone"`
+	RecordCount     int64                       `json:"record_count"`
+	Status          string                      `json:"status"` // "EMPTY", "DANGLING_RECORDS" or "UNVERIFIABLE_RECORDS"
+	DanglingRecords []Route53DanglingRecordJSON `json:"dangling_records,omitempty"`
+	MonthlyCost     float64                     `json:"monthly_cost"`
+}
+
+// Route53DanglingRecordJSON represents a record set pointing at a resource that no longer exists,
+// or that the account does not own
+type Route53DanglingRecordJSON struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Target string `json:"target"`
+	Status string `json:"status"` // "DANGLING" or "UNVERIFIABLE"
+}
+
+// Route53HealthCheckJSON represents a health check not referenced by any record set
+type Route53HealthCheckJSON struct {
+	HealthCheckID string  `json:"health_check_id"`
+	Type          string  `json:"type"`
+	Endpoint      string  `json:"endpoint,omitempty"`
+	MonthlyCost   float64 `json:"monthly_cost"`
+}
+
+// KinesisStreamJSON represents a Kinesis data stream without consumers or with over-provisioned shards
+type KinesisStreamJSON struct {
+	StreamName                string  `json:"stream_name"`
+	StreamMode                string  `json:"stream_mode"`
+	ShardCount                int32   `json:"shard_count"`
+	Status                    string  `json:"status"` // "NO_CONSUMERS" or "OVER_PROVISIONED"
+	PeakIncomingBytesPerSec   float64 `json:"peak_incoming_bytes_per_sec"`
+	PeakIncomingRecordsPerSec float64 `json:"peak_incoming_records_per_sec"`
+	SuggestedShardCount       int32   `json:"suggested_shard_count,omitempty"`
+	Recommendation            string  `json:"recommendation"`
+	ObservationDays           int     `json:"observation_days"`
+	HourlyCost                float64 `json:"hourly_cost"`
+}
+
+// MSKClusterJSON represents a provisioned MSK cluster with negligible incoming traffic
+type MSKClusterJSON struct {
+	ClusterName          string  `json:"cluster_name"`
+	ClusterArn           string  `json:"cluster_arn"`
+	InstanceType         string  `json:"instance_type"`
+	BrokerCount          int32   `json:"broker_count"`
+	AverageBytesInPerSec float64 `json:"average_bytes_in_per_sec"`
+	ObservationDays      int     `json:"observation_days"`
+	HourlyCost           float64 `json:"hourly_cost"`
+}
+
+// CloudTrailJSON represents a trail recording duplicate management events or all S3/Lambda data events
+type CloudTrailJSON struct {
+	TrailName              string  `json:"trail_name"`
+	TrailARN               string  `json:"trail_arn"`
+	HomeRegion             string  `json:"home_region"`
+	IsMultiRegionTrail     bool    `json:"is_multi_region_trail"`
+	IsOrganizationTrail    bool    `json:"is_organization_trail"`
+	Status                 string  `json:"status"` // "DUPLICATE_MANAGEMENT_EVENTS", "ALL_S3_DATA_EVENTS" or "ALL_LAMBDA_DATA_EVENTS"
+	DuplicateOf            string  `json:"duplicate_of,omitempty"`
+	EstimatedMonthlyEvents float64 `json:"estimated_monthly_events"`
+	EstimatedMonthlyCost   float64 `json:"estimated_monthly_cost"`
+}
+
 // AMIJSON represents an unused AMI
 type AMIJSON struct {
 	ImageID            string   `json:"image_id"`
@@ -122,4 +367,90 @@ type SnapshotJSON struct {
 	Category            string  `json:"category"`              // "orphaned" or "stale"
 	Reason              string  `json:"reason"`                // Human-readable reason
 	MaxPotentialSavings float64 `json:"max_potential_savings"` // Actual savings may be lower due to incremental storage
+	ManagedBy           string  `json:"managed_by,omitempty"`  // "Data Lifecycle Manager"
+}
+
+// CommitmentReportJSON represents the JSON output for the commitments report
+type CommitmentReportJSON struct {
+	SchemaVersion             string                 `json:"schema_version"`
+	AccountID                 string                 `json:"account_id"`
+	GeneratedAt               string                 `json:"generated_at"`
+	LookbackDays              int                    `json:"lookback_days"`
+	ReservedInstances         *CommitmentSummaryJSON `json:"reserved_instances"` // null when the account has no Reserved Instances
+	SavingsPlans              *CommitmentSummaryJSON `json:"savings_plans"`      // null when the account has no Savings Plans
+	UnderutilizedReservations []CommitmentJSON       `json:"underutilized_reserved_instances"`
+	UnderutilizedSavingsPlans []CommitmentJSON       `json:"underutilized_savings_plans"`
+	ExpiringSavingsPlans      []CommitmentJSON       `json:"expiring_savings_plans"`
+}
+
+// CommitmentSummaryJSON represents account-wide utilization and coverage for one commitment type
+type CommitmentSummaryJSON struct {
+	UtilizationPercent    float64 `json:"utilization_percent"`
+	UnusedHours           float64 `json:"unused_hours,omitempty"`
+	UnusedCommitment      float64 `json:"unused_commitment"`
+	CoveragePercent       float64 `json:"coverage_percent"`
+	UncoveredOnDemandCost float64 `json:"uncovered_on_demand_cost"`
+}
+
+// CommitmentJSON represents a single Reserved Instance or Savings Plan
+type CommitmentJSON struct {
+	Type               string  `json:"type"`
+	ID                 string  `json:"id"`
+	Description        string  `json:"description,omitempty"`
+	UtilizationPercent float64 `json:"utilization_percent"`
+	UnusedHours        float64 `json:"unused_hours,omitempty"`
+	UnusedCommitment   float64 `json:"unused_commitment"`
+	EndDate            string  `json:"end_date,omitempty"`
+	DaysUntilExpiry    int     `json:"days_until_expiry,omitempty"`
+}
+
+// RecommendationReportJSON represents the JSON output for the purchase recommendations report
+type RecommendationReportJSON struct {
+	SchemaVersion       string                       `json:"schema_version"`
+	AccountID           string                       `json:"account_id"`
+	GeneratedAt         string                       `json:"generated_at"`
+	TermInYears         int                          `json:"term_in_years"`
+	PaymentOption       string                       `json:"payment_option"`
+	LookbackDays        int                          `json:"lookback_days"`
+	TotalMonthlySavings float64                      `json:"total_estimated_monthly_savings"`
+	SavingsPlans        []PurchaseRecommendationJSON `json:"savings_plans"`
+	ReservedInstances   []PurchaseRecommendationJSON `json:"reserved_instances"`
+}
+
+// PurchaseRecommendationJSON represents a single Savings Plans or Reserved Instance purchase recommendation
+type PurchaseRecommendationJSON struct {
+	Type                    string  `json:"type"`
+	Service                 string  `json:"service"`
+	Description             string  `json:"description,omitempty"`
+	HourlyCommitment        float64 `json:"hourly_commitment,omitempty"`
+	InstanceCount           int     `json:"instance_count,omitempty"`
+	UpfrontCost             float64 `json:"upfront_cost"`
+	EstimatedMonthlySavings float64 `json:"estimated_monthly_savings"`
+	EstimatedSavingsPercent float64 `json:"estimated_savings_percent"`
+	BreakEvenMonths         float64 `json:"break_even_months"` // -1 when the upfront cost is never recovered
+	Currency                string  `json:"currency,omitempty"`
+}
+
+// RightsizingReportJSON represents the JSON output for the rightsizing report
+type RightsizingReportJSON struct {
+	SchemaVersion           string                          `json:"schema_version"`
+	AccountID               string                          `json:"account_id"`
+	GeneratedAt             string                          `json:"generated_at"`
+	ComputeOptimizerEnabled bool                            `json:"compute_optimizer_enabled"`
+	TotalMonthlySavings     float64                         `json:"total_estimated_monthly_savings"`
+	Recommendations         []RightsizingRecommendationJSON `json:"recommendations"`
+}
+
+// RightsizingRecommendationJSON represents a single rightsizing recommendation
+type RightsizingRecommendationJSON struct {
+	Source                     string  `json:"source"`
+	ResourceType               string  `json:"resource_type"`
+	ResourceID                 string  `json:"resource_id"`
+	Finding                    string  `json:"finding"`
+	CurrentConfiguration       string  `json:"current_configuration"`
+	RecommendedConfiguration   string  `json:"recommended_configuration,omitempty"`
+	ProjectedCPUUtilization    float64 `json:"projected_cpu_utilization_percent,omitempty"`
+	ProjectedMemoryUtilization float64 `json:"projected_memory_utilization_percent,omitempty"`
+	EstimatedMonthlySavings    float64 `json:"estimated_monthly_savings"`
+	Currency                   string  `json:"currency,omitempty"`
 }
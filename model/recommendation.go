@@ -0,0 +1,58 @@
+package model
+
+// Payment options accepted for commitment purchase recommendations
+const (
+	PaymentOptionNoUpfront      = "no-upfront"
+	PaymentOptionPartialUpfront = "partial-upfront"
+	PaymentOptionAllUpfront     = "all-upfront"
+)
+
+// BreakEvenNever is the break-even period of a recommendation whose upfront cost is never
+// recovered because it brings no monthly savings
+const BreakEvenNever = -1
+
+// RecommendationOptions configures the commitment purchase recommendations
+type RecommendationOptions struct {
+	TermInYears   int    // 1 or 3
+	PaymentOption string // "no-upfront", "partial-upfront" or "all-upfront"
+	LookbackDays  int    // 7, 30 or 60
+}
+
+// PurchaseRecommendationInfo holds a single Savings Plans or Reserved Instance purchase recommendation
+type PurchaseRecommendationInfo struct {
+	CommitmentType          CommitmentType
+	Service                 string  // Savings Plans type or service the Reserved Instance applies to
+	Description             string  // Instance family, type and region when applicable
+	HourlyCommitment        float64 // Savings Plans only
+	InstanceCount           int     // Reserved Instances only
+	UpfrontCost             float64
+	EstimatedMonthlySavings float64
+	EstimatedSavingsPercent float64
+	BreakEvenMonths         float64 // 0 when no upfront payment is required, BreakEvenNever when never recovered
+	Currency                string
+}
+
+// RecommendationReport aggregates the findings of the recommendations workflow
+type RecommendationReport struct {
+	Options           RecommendationOptions
+	SavingsPlans      []PurchaseRecommendationInfo
+	ReservedInstances []PurchaseRecommendationInfo
+}
+
+// TotalMonthlySavings returns the combined estimated monthly savings of all recommendations.
+//
+// Savings Plans and Reserved Instance recommendations can overlap on the same usage,
+// so the total is an upper bound rather than an amount achievable by purchasing everything.
+func (r RecommendationReport) TotalMonthlySavings() float64 {
+	var total float64
+
+	for _, recommendation := range r.SavingsPlans {
+		total += recommendation.EstimatedMonthlySavings
+	}
+
+	for _, recommendation := range r.ReservedInstances {
+		total += recommendation.EstimatedMonthlySavings
+	}
+
+	return total
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Redshift cluster idle statuses
+const (
+	RedshiftClusterNoConnections = "NO_CONNECTIONS"
+	RedshiftClusterLowCPU        = "LOW_CPU"
+)
+
+// RedshiftClusterWasteInfo contains information about a provisioned Redshift cluster that had no
+// database connections or low CPU utilization during the observation window
+type RedshiftClusterWasteInfo struct {
+	ClusterIdentifier    string
+	NodeType             string
+	NodeCount            int32
+	Status               string // "NO_CONNECTIONS" or "LOW_CPU"
+	MaxConnections       float64
+	AverageCPUPercent    float64
+	ObservationDays      int
+	Recommendation       string
+	EstimatedMonthlyCost float64 // On-demand compute cost of all nodes, 0 when the node type is not priced
+}
+
+// RedshiftSnapshotWasteInfo contains information about a manual Redshift snapshot older than the stale threshold
+type RedshiftSnapshotWasteInfo struct {
+	SnapshotIdentifier   string
+	ClusterIdentifier    string
+	NodeType             string
+	NodeCount            int32
+	SizeGB               float64
+	CreateTime           time.Time
+	DaysSinceCreate      int
+	EstimatedMonthlyCost float64
+}
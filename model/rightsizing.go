@@ -0,0 +1,51 @@
+package model
+
+// RightsizingSource identifies which AWS service produced a rightsizing recommendation
+type RightsizingSource string
+
+// Rightsizing recommendation sources
+const (
+	RightsizingSourceCostExplorer     RightsizingSource = "cost_explorer"
+	RightsizingSourceComputeOptimizer RightsizingSource = "compute_optimizer"
+)
+
+// Resource types covered by rightsizing recommendations
+const (
+	RightsizingResourceEC2Instance    = "EC2 Instance"
+	RightsizingResourceEBSVolume      = "EBS Volume"
+	RightsizingResourceLambdaFunction = "Lambda Function"
+)
+
+// RightsizingRecommendationInfo holds a single rightsizing recommendation for an EC2 instance,
+// EBS volume or Lambda function
+type RightsizingRecommendationInfo struct {
+	Source                     RightsizingSource
+	ResourceType               string
+	ResourceID                 string
+	Finding                    string // e.g. "Overprovisioned", "Terminate", "NotOptimized"
+	CurrentConfiguration       string // Instance type, volume type and size or memory size
+	RecommendedConfiguration   string // Empty when the recommendation is to terminate the resource
+	ProjectedCPUUtilization    float64
+	ProjectedMemoryUtilization float64
+	EstimatedMonthlySavings    float64
+	Currency                   string
+}
+
+// RightsizingReport aggregates the findings of the rightsizing workflow
+type RightsizingReport struct {
+	Recommendations []RightsizingRecommendationInfo
+	// ComputeOptimizerEnabled is false when the account is not opted in to Compute Optimizer,
+	// in which case only Cost Explorer EC2 recommendations are reported.
+	ComputeOptimizerEnabled bool
+}
+
+// TotalMonthlySavings returns the combined estimated monthly savings of all recommendations.
+func (r RightsizingReport) TotalMonthlySavings() float64 {
+	var total float64
+
+	for _, recommendation := range r.Recommendations {
+		total += recommendation.EstimatedMonthlySavings
+	}
+
+	return total
+}
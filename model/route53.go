@@ -0,0 +1,44 @@
+package model
+
+// Route 53 hosted zone waste statuses
+const (
+	Route53ZoneEmpty               = "EMPTY"
+	Route53ZoneDanglingRecords     = "DANGLING_RECORDS"
+	Route53ZoneUnverifiableRecords = "UNVERIFIABLE_RECORDS"
+)
+
+// Route 53 record statuses
+const (
+	Route53RecordDangling     = "DANGLING"
+	Route53RecordUnverifiable = "UNVERIFIABLE"
+)
+
+// Route53DanglingRecord is a record set that points at a load balancer that no longer exists, or
+// at a load balancer or EC2 public address the account does not own and which may have been
+// deleted or belong to another account
+type Route53DanglingRecord struct {
+	Name   string
+	Type   string
+	Target string
+	Status string // "DANGLING" or "UNVERIFIABLE"
+}
+
+// Route53HostedZoneWasteInfo contains information about a hosted zone that only holds its SOA and
+// NS records, or that contains records pointing at deleted or unverifiable targets
+type Route53HostedZoneWasteInfo struct {
+	ZoneID          string
+	Name            string
+	PrivateZone     bool
+	RecordCount     int64
+	Status          string // "EMPTY", "DANGLING_RECORDS" or "UNVERIFIABLE_RECORDS"; DANGLING_RECORDS when any record is dangling
+	DanglingRecords []Route53DanglingRecord
+	MonthlyCost     float64 // Hosted zone fee for empty zones, 0 for zones with dangling or unverifiable records
+}
+
+// Route53HealthCheckWasteInfo contains information about a health check that is not referenced by any record set
+type Route53HealthCheckWasteInfo struct {
+	HealthCheckID string
+	Type          string
+	Endpoint      string // Domain name or IP address checked, empty for calculated and CloudWatch alarm health checks
+	MonthlyCost   float64
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// SageMakerEndpointWasteInfo contains information about a SageMaker endpoint production variant
+// that received no invocations during the idle period
+type SageMakerEndpointWasteInfo struct {
+	EndpointName  string
+	VariantName   string
+	InstanceType  string
+	InstanceCount int32
+	HourlyCost    float64 // Estimated cost for all instances of the variant, 0 when the instance type is not priced
+	IdleDays      int
+}
+
+// SageMakerNotebookWasteInfo contains information about an InService SageMaker notebook instance
+// that has not been started, stopped or updated during the reporting period. The last modification
+// only shows how long the instance has been running, not whether anyone is using it.
+type SageMakerNotebookWasteInfo struct {
+	NotebookInstanceName string
+	InstanceType         string
+	LastModifiedTime     time.Time
+	DaysSinceModified    int
+	HourlyCost           float64 // 0 when the instance type is not priced
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// KMS key waste statuses
+const (
+	KMSKeyDisabled     = "DISABLED"
+	KMSKeyUnused       = "UNUSED"
+	KMSKeyUsageUnknown = "USAGE_UNKNOWN"
+)
+
+// SecretWasteInfo contains information about a Secrets Manager secret that has not been
+// accessed within the observation window
+type SecretWasteInfo struct {
+	Name             string
+	ARN              string
+	LastAccessedDate time.Time // Zero when the secret has never been accessed
+	DaysSinceAccess  int       // Days since last access, or since creation when never accessed
+	OwningService    string    // Set when the secret is managed by another AWS service, such as RDS
+	MonthlyCost      float64
+}
+
+// KMSKeyWasteInfo contains information about a customer managed KMS key that is disabled
+// without being scheduled for deletion, has no CloudTrail activity within the observation window,
+// or whose CloudTrail activity could not be looked up
+type KMSKeyWasteInfo struct {
+	KeyID        string
+	ARN          string
+	Description  string
+	KeyState     string
+	Status       string // "DISABLED", "UNUSED" or "USAGE_UNKNOWN"
+	CreationDate time.Time
+	MonthlyCost  float64 // Zero when the usage is unknown
+}
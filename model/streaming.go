@@ -0,0 +1,34 @@
+package model
+
+// Kinesis stream waste statuses
+const (
+	KinesisStreamNoConsumers     = "NO_CONSUMERS"
+	KinesisStreamOverProvisioned = "OVER_PROVISIONED"
+)
+
+// KinesisStreamWasteInfo contains information about a Kinesis data stream that nobody reads from,
+// or whose provisioned shards far exceed the observed write throughput
+type KinesisStreamWasteInfo struct {
+	StreamName                string
+	StreamMode                string // "PROVISIONED" or "ON_DEMAND"
+	ShardCount                int32
+	Status                    string // "NO_CONSUMERS" or "OVER_PROVISIONED"
+	PeakIncomingBytesPerSec   float64
+	PeakIncomingRecordsPerSec float64
+	SuggestedShardCount       int32 // 0 when not over-provisioned
+	Recommendation            string
+	ObservationDays           int
+	HourlyCost                float64 // Shard hours for provisioned streams, stream hours for on-demand streams
+}
+
+// MSKClusterWasteInfo contains information about a provisioned Amazon MSK cluster that received
+// a negligible amount of data during the observation period
+type MSKClusterWasteInfo struct {
+	ClusterName          string
+	ClusterArn           string
+	InstanceType         string
+	BrokerCount          int32
+	AverageBytesInPerSec float64 // Summed across brokers
+	ObservationDays      int
+	HourlyCost           float64 // Estimated cost for all brokers, 0 when the instance type is not priced
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// WasteReport aggregates every finding collected by the waste workflow
+type WasteReport struct {
+	ElasticIPs           []types.Address
+	UnusedVolumes        []types.Volume
+	StoppedVolumes       []types.Volume
+	StoppedInstances     []types.Instance
+	ReservedInstances    []RiExpirationInfo
+	CapacityReservations []CapacityReservationWasteInfo
+	DedicatedHosts       []DedicatedHostWasteInfo
+	LoadBalancers        []elbtypes.LoadBalancer // Application, Network and Gateway Load Balancers with no target groups
+	ClassicLoadBalancers []ClassicLoadBalancerWasteInfo
+	UnusedAMIs           []AMIWasteInfo
+	EKSClusters          []EKSClusterWasteInfo
+	ECSServices          []ECSServiceWasteInfo
+	SageMakerEndpoints   []SageMakerEndpointWasteInfo
+	SageMakerNotebooks   []SageMakerNotebookWasteInfo
+	RedshiftClusters     []RedshiftClusterWasteInfo
+	RedshiftSnapshots    []RedshiftSnapshotWasteInfo
+	EFSFileSystems       []EFSFileSystemWasteInfo
+	BackupRecoveryPoints []BackupRecoveryPointWasteInfo
+	Secrets              []SecretWasteInfo
+	KMSKeys              []KMSKeyWasteInfo
+	Route53HostedZones   []Route53HostedZoneWasteInfo
+	Route53HealthChecks  []Route53HealthCheckWasteInfo
+	KinesisStreams       []KinesisStreamWasteInfo
+	MSKClusters          []MSKClusterWasteInfo
+	CloudTrailTrails     []CloudTrailWasteInfo
+	Snapshots            []SnapshotWasteInfo // Orphaned and stale snapshots, see SnapshotWasteInfo.Category
+	SkippedChecks        []SkippedCheck      // Checks, or parts of checks, that could not run
+}
+
+// SkippedCheck is a waste check that was not run because the credentials are not allowed to call
+// the service, the service is not available in the region or data it depends on is unavailable
+type SkippedCheck struct {
+	Name       string
+	Reason     string
+	Categories []string // JSON field names of the waste categories left empty, none when only part of a check was skipped
+}
+
+// HasWaste reports whether the report contains at least one finding
+func (r WasteReport) HasWaste() bool {
+	counts := []int{
+		len(r.ElasticIPs),
+		len(r.UnusedVolumes),
+		len(r.StoppedVolumes),
+		len(r.StoppedInstances),
+		len(r.ReservedInstances),
+		len(r.CapacityReservations),
+		len(r.DedicatedHosts),
+		len(r.LoadBalancers),
+		len(r.ClassicLoadBalancers),
+		len(r.UnusedAMIs),
+		len(r.EKSClusters),
+		len(r.ECSServices),
+		len(r.SageMakerEndpoints),
+		len(r.SageMakerNotebooks),
+		len(r.RedshiftClusters),
+		len(r.RedshiftSnapshots),
+		len(r.EFSFileSystems),
+		len(r.BackupRecoveryPoints),
+		len(r.Secrets),
+		len(r.KMSKeys),
+		len(r.Route53HostedZones),
+		len(r.Route53HealthChecks),
+		len(r.KinesisStreams),
+		len(r.MSKClusters),
+		len(r.CloudTrailTrails),
+		len(r.Snapshots),
+	}
+
+	for _, count := range counts {
+		if count > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WasteFinding is a single wasted resource of any category, flattened for listing and drill-down
+type WasteFinding struct {
+	Category      string // JSON field name of the waste category, such as "unused_ebs_volumes"
+	CategoryTitle string
+	ResourceID    string
+	ResourceName  string
+	Status        string
+	Details       string
+	MonthlyCost   float64           // 0 when no estimate is available
+	CreatedAt     time.Time         // Zero when the creation date is unknown
+	Tags          map[string]string // Nil when the tags of the resource type are not collected
+}
@@ -0,0 +1,294 @@
+// Package backup provides a service for interacting with AWS Backup.
+package backup
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// AWS Backup warm storage pricing: ~$0.05 per GB-month for EBS, EC2 and EFS backups
+const (
+	backupCostPerGBMonth = 0.05
+	bytesPerGB           = 1024 * 1024 * 1024
+)
+
+// Backup resource types whose source resources can be checked for existence
+const (
+	resourceTypeEBS = "EBS"
+	resourceTypeEC2 = "EC2"
+	resourceTypeEFS = "EFS"
+)
+
+// sourceState is what the existing resources of the account tell about the source of a
+// recovery point.
+type sourceState int
+
+const (
+	sourceExists  sourceState = iota // The source exists or its resource type cannot be checked
+	sourceDeleted                    // The source is missing from the account and region of the vault
+	sourceUnknown                    // The source is in another account or region, so it cannot be checked
+)
+
+// NewService creates a new AWS Backup service.
+func NewService(awsconfig aws.Config) Service {
+	client := backup.NewFromConfig(awsconfig)
+	ec2Client := ec2.NewFromConfig(awsconfig)
+	efsClient := efs.NewFromConfig(awsconfig)
+
+	return &service{
+		client:    client,
+		ec2Client: ec2Client,
+		efsClient: efsClient,
+	}
+}
+
+// GetRecoveryPointWaste returns recovery points whose source EBS volume, EC2 instance or EFS file
+// system no longer exists, and recovery points of any resource type without a deletion lifecycle.
+// Copies of resources from another account or region are reported with an unknown source.
+func (s *service) GetRecoveryPointWaste(ctx context.Context) ([]model.BackupRecoveryPointWasteInfo, error) {
+	recoveryPoints, err := s.listRecoveryPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existingResources, err := s.getExistingResources(ctx, recoveryPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []model.BackupRecoveryPointWasteInfo
+
+	now := time.Now()
+
+	for _, recoveryPoint := range recoveryPoints {
+		source := sourceExists
+		resourceArn := aws.ToString(recoveryPoint.ResourceArn)
+
+		if existing, checked := existingResources[aws.ToString(recoveryPoint.ResourceType)]; checked && !existing[resourceIDFromArn(resourceArn)] {
+			source = sourceDeleted
+
+			if !isVaultLocal(resourceArn, aws.ToString(recoveryPoint.BackupVaultArn)) {
+				source = sourceUnknown
+			}
+		}
+
+		if info, isWaste := classifyRecoveryPoint(recoveryPoint, source, now); isWaste {
+			results = append(results, info)
+		}
+	}
+
+	return results, nil
+}
+
+// listRecoveryPoints returns the recovery points of every backup vault, skipping those being
+// created, deleted or already expired.
+func (s *service) listRecoveryPoints(ctx context.Context) ([]types.RecoveryPointByBackupVault, error) {
+	var recoveryPoints []types.RecoveryPointByBackupVault
+
+	vaultPaginator := backup.NewListBackupVaultsPaginator(s.client, &backup.ListBackupVaultsInput{})
+
+	for vaultPaginator.HasMorePages() {
+		vaultOutput, err := vaultPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vault := range vaultOutput.BackupVaultList {
+			paginator := backup.NewListRecoveryPointsByBackupVaultPaginator(s.client, &backup.ListRecoveryPointsByBackupVaultInput{
+				BackupVaultName: vault.BackupVaultName,
+			})
+
+			for paginator.HasMorePages() {
+				output, err := paginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, recoveryPoint := range output.RecoveryPoints {
+					switch recoveryPoint.Status {
+					case types.RecoveryPointStatusCreating, types.RecoveryPointStatusDeleting, types.RecoveryPointStatusExpired:
+						continue
+					}
+
+					recoveryPoints = append(recoveryPoints, recoveryPoint)
+				}
+			}
+		}
+	}
+
+	return recoveryPoints, nil
+}
+
+// getExistingResources returns the IDs of existing resources for each checkable resource type
+// that appears among the recovery points.
+func (s *service) getExistingResources(ctx context.Context, recoveryPoints []types.RecoveryPointByBackupVault) (map[string]map[string]bool, error) {
+	existingResources := make(map[string]map[string]bool)
+
+	for _, recoveryPoint := range recoveryPoints {
+		resourceType := aws.ToString(recoveryPoint.ResourceType)
+		if _, fetched := existingResources[resourceType]; fetched {
+			continue
+		}
+
+		var (
+			ids map[string]bool
+			err error
+		)
+
+		switch resourceType {
+		case resourceTypeEBS:
+			ids, err = s.getVolumeIDs(ctx)
+		case resourceTypeEC2:
+			ids, err = s.getInstanceIDs(ctx)
+		case resourceTypeEFS:
+			ids, err = s.getFileSystemIDs(ctx)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		existingResources[resourceType] = ids
+	}
+
+	return existingResources, nil
+}
+
+func (s *service) getVolumeIDs(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+
+	paginator := ec2.NewDescribeVolumesPaginator(s.ec2Client, &ec2.DescribeVolumesInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, volume := range output.Volumes {
+			ids[aws.ToString(volume.VolumeId)] = true
+		}
+	}
+
+	return ids, nil
+}
+
+func (s *service) getInstanceIDs(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+
+	paginator := ec2.NewDescribeInstancesPaginator(s.ec2Client, &ec2.DescribeInstancesInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				ids[aws.ToString(instance.InstanceId)] = true
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+func (s *service) getFileSystemIDs(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+
+	paginator := efs.NewDescribeFileSystemsPaginator(s.efsClient, &efs.DescribeFileSystemsInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fileSystem := range output.FileSystems {
+			ids[aws.ToString(fileSystem.FileSystemId)] = true
+		}
+	}
+
+	return ids, nil
+}
+
+// classifyRecoveryPoint decides whether a recovery point is waste. Recovery points whose source
+// is unknown are reported without a savings estimate.
+func classifyRecoveryPoint(recoveryPoint types.RecoveryPointByBackupVault, source sourceState, now time.Time) (model.BackupRecoveryPointWasteInfo, bool) {
+	creationDate := aws.ToTime(recoveryPoint.CreationDate)
+	sizeGB := float64(aws.ToInt64(recoveryPoint.BackupSizeInBytes)) / bytesPerGB
+
+	info := model.BackupRecoveryPointWasteInfo{
+		RecoveryPointArn:     aws.ToString(recoveryPoint.RecoveryPointArn),
+		BackupVaultName:      aws.ToString(recoveryPoint.BackupVaultName),
+		ResourceArn:          aws.ToString(recoveryPoint.ResourceArn),
+		ResourceType:         aws.ToString(recoveryPoint.ResourceType),
+		ResourceName:         aws.ToString(recoveryPoint.ResourceName),
+		CreationDate:         creationDate,
+		DaysSinceCreate:      int(now.Sub(creationDate).Hours() / 24),
+		SizeGB:               sizeGB,
+		HasDeleteLifecycle:   hasDeleteLifecycle(recoveryPoint.Lifecycle),
+		EstimatedMonthlyCost: sizeGB * backupCostPerGBMonth,
+	}
+
+	switch {
+	case source == sourceDeleted:
+		info.Status = model.BackupRecoveryPointSourceDeleted
+	case source == sourceUnknown:
+		info.Status = model.BackupRecoveryPointSourceUnknown
+		info.EstimatedMonthlyCost = 0
+	case !info.HasDeleteLifecycle:
+		info.Status = model.BackupRecoveryPointNoLifecycle
+	default:
+		return info, false
+	}
+
+	return info, true
+}
+
+func hasDeleteLifecycle(lifecycle *types.Lifecycle) bool {
+	if lifecycle == nil {
+		return false
+	}
+
+	return aws.ToInt64(lifecycle.DeleteAfterDays) > 0 || lifecycle.DeleteAfterEvent != ""
+}
+
+// resourceIDFromArn returns the last path segment of an ARN, such as "vol-0abc" from
+// "arn:aws:ec2:us-east-1:123456789012:volume/vol-0abc".
+func resourceIDFromArn(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// isVaultLocal reports whether a resource ARN belongs to the account and region of the backup
+// vault, whose resources the service lists. ARNs that cannot be parsed, or that omit the
+// account or region, are assumed to be local.
+func isVaultLocal(resourceArn, vaultArn string) bool {
+	resource, err := arn.Parse(resourceArn)
+	if err != nil {
+		return true
+	}
+
+	vault, err := arn.Parse(vaultArn)
+	if err != nil {
+		return true
+	}
+
+	if resource.Region != "" && resource.Region != vault.Region {
+		return false
+	}
+
+	return resource.AccountID == "" || resource.AccountID == vault.AccountID
+}
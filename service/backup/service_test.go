@@ -0,0 +1,143 @@
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestClassifyRecoveryPoint(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	withLifecycle := &types.Lifecycle{DeleteAfterDays: aws.Int64(35)}
+
+	tests := []struct {
+		name       string
+		lifecycle  *types.Lifecycle
+		source     sourceState
+		wantWaste  bool
+		wantStatus string
+	}{
+		{
+			name:       "source_deleted_with_lifecycle",
+			lifecycle:  withLifecycle,
+			source:     sourceDeleted,
+			wantWaste:  true,
+			wantStatus: model.BackupRecoveryPointSourceDeleted,
+		},
+		{
+			name:       "source_deleted_without_lifecycle",
+			source:     sourceDeleted,
+			wantWaste:  true,
+			wantStatus: model.BackupRecoveryPointSourceDeleted,
+		},
+		{
+			name:       "source_unknown",
+			lifecycle:  withLifecycle,
+			source:     sourceUnknown,
+			wantWaste:  true,
+			wantStatus: model.BackupRecoveryPointSourceUnknown,
+		},
+		{
+			name:       "no_lifecycle",
+			wantWaste:  true,
+			wantStatus: model.BackupRecoveryPointNoLifecycle,
+		},
+		{
+			name:       "cold_storage_only",
+			lifecycle:  &types.Lifecycle{MoveToColdStorageAfterDays: aws.Int64(30)},
+			wantWaste:  true,
+			wantStatus: model.BackupRecoveryPointNoLifecycle,
+		},
+		{
+			name:      "delete_after_copy",
+			lifecycle: &types.Lifecycle{DeleteAfterEvent: types.LifecycleDeleteAfterEventDeleteAfterCopy},
+			wantWaste: false,
+		},
+		{
+			name:      "healthy",
+			lifecycle: withLifecycle,
+			wantWaste: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recoveryPoint := types.RecoveryPointByBackupVault{
+				RecoveryPointArn:  aws.String("arn:aws:ec2:us-east-1::snapshot/snap-123"),
+				BackupVaultName:   aws.String("Default"),
+				ResourceArn:       aws.String("arn:aws:ec2:us-east-1:123456789012:volume/vol-123"),
+				ResourceType:      aws.String("EBS"),
+				CreationDate:      aws.Time(now.AddDate(0, 0, -45)),
+				BackupSizeInBytes: aws.Int64(200 * bytesPerGB),
+				Lifecycle:         tt.lifecycle,
+			}
+
+			info, isWaste := classifyRecoveryPoint(recoveryPoint, tt.source, now)
+
+			if isWaste != tt.wantWaste {
+				t.Fatalf("classifyRecoveryPoint() waste = %v, want %v", isWaste, tt.wantWaste)
+			}
+
+			if !tt.wantWaste {
+				return
+			}
+
+			if info.Status != tt.wantStatus {
+				t.Errorf("classifyRecoveryPoint() status = %q, want %q", info.Status, tt.wantStatus)
+			}
+
+			wantCost := 10.0
+			if tt.source == sourceUnknown {
+				wantCost = 0
+			}
+
+			if info.SizeGB != 200 || info.EstimatedMonthlyCost != wantCost || info.DaysSinceCreate != 45 {
+				t.Errorf("classifyRecoveryPoint() = %+v, want 200 GB costing $%.2f created 45 days ago", info, wantCost)
+			}
+		})
+	}
+}
+
+func TestResourceIDFromArn(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:ec2:us-east-1:123456789012:volume/vol-0abc":                   "vol-0abc",
+		"arn:aws:ec2:us-east-1:123456789012:instance/i-0abc":                   "i-0abc",
+		"arn:aws:elasticfilesystem:us-east-1:123456789012:file-system/fs-0abc": "fs-0abc",
+		"arn:aws:dynamodb:us-east-1:123456789012:table/orders":                 "orders",
+		"no-slash": "no-slash",
+	}
+
+	for arn, want := range tests {
+		if got := resourceIDFromArn(arn); got != want {
+			t.Errorf("resourceIDFromArn(%q) = %q, want %q", arn, got, want)
+		}
+	}
+}
+
+func TestIsVaultLocal(t *testing.T) {
+	vaultArn := "arn:aws:backup:us-east-1:123456789012:backup-vault:Default"
+
+	tests := []struct {
+		name        string
+		resourceArn string
+		want        bool
+	}{
+		{name: "same_account_and_region", resourceArn: "arn:aws:ec2:us-east-1:123456789012:volume/vol-123", want: true},
+		{name: "other_region", resourceArn: "arn:aws:ec2:eu-west-1:123456789012:volume/vol-123", want: false},
+		{name: "other_account", resourceArn: "arn:aws:elasticfilesystem:us-east-1:210987654321:file-system/fs-123", want: false},
+		{name: "no_account", resourceArn: "arn:aws:ec2:us-east-1::volume/vol-123", want: true},
+		{name: "not_an_arn", resourceArn: "vol-123", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isVaultLocal(tt.resourceArn, vaultArn); got != tt.want {
+				t.Errorf("isVaultLocal(%q) = %v, want %v", tt.resourceArn, got, tt.want)
+			}
+		})
+	}
+}
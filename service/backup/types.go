@@ -0,0 +1,21 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client    *backup.Client
+	ec2Client *ec2.Client
+	efsClient *efs.Client
+}
+
+// Service defines the interface for AWS Backup service.
+type Service interface {
+	GetRecoveryPointWaste(ctx context.Context) ([]model.BackupRecoveryPointWasteInfo, error)
+}
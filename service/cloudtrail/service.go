@@ -0,0 +1,318 @@
+// Package cloudtrail provides a service for interacting with AWS CloudTrail.
+package cloudtrail
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// CloudTrail pricing: the first copy of management events in each region is free, additional
+// copies cost $2.00 per 100,000 events, and data events cost $0.10 per 100,000 events
+const (
+	managementEventCostPer100K = 2.00
+	dataEventCostPer100K       = 0.10
+	hoursPerMonth              = 730
+	daysPerMonth               = 30
+	secondsPerDay              = 24 * 60 * 60
+)
+
+// Management event volume is estimated from the events of the last hour, reading at most
+// maxSamplePages pages so large accounts do not hit the LookupEvents rate limit. Estimates
+// from a truncated sample are lower bounds.
+const maxSamplePages = 10
+
+// Data event resource types whose all-resources selectors are reported
+const (
+	s3ObjectResourceType       = "AWS::S3::Object"
+	lambdaFunctionResourceType = "AWS::Lambda::Function"
+)
+
+// NewService creates a new CloudTrail service.
+func NewService(awsconfig aws.Config) Service {
+	client := cloudtrail.NewFromConfig(awsconfig)
+	cloudwatchClient := cloudwatch.NewFromConfig(awsconfig)
+
+	return &service{
+		client:           client,
+		cloudwatchClient: cloudwatchClient,
+	}
+}
+
+// trailSelectors summarizes what a logging trail records.
+type trailSelectors struct {
+	trail            types.Trail
+	managementEvents bool
+	allS3DataEvents  bool
+	allLambdaEvents  bool
+}
+
+// GetTrailWaste returns logging trails that record an additional copy of management events in
+// the current region, and trails that log S3 object or Lambda invocation data events for all
+// resources. Multi-region and organization trails are included through their shadow trails.
+func (s *service) GetTrailWaste(ctx context.Context) ([]model.CloudTrailWasteInfo, error) {
+	output, err := s.client.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{
+		IncludeShadowTrails: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var trails []trailSelectors
+
+	for _, trail := range output.TrailList {
+		status, err := s.client.GetTrailStatus(ctx, &cloudtrail.GetTrailStatusInput{Name: trail.TrailARN})
+		if err != nil {
+			return nil, err
+		}
+
+		if !aws.ToBool(status.IsLogging) {
+			continue
+		}
+
+		selectors, err := s.client.GetEventSelectors(ctx, &cloudtrail.GetEventSelectorsInput{TrailName: trail.TrailARN})
+		if err != nil {
+			return nil, err
+		}
+
+		trails = append(trails, summarizeSelectors(trail, selectors))
+	}
+
+	var managementTrails, lambdaTrails int
+
+	for _, trail := range trails {
+		if trail.managementEvents {
+			managementTrails++
+		}
+
+		if trail.allLambdaEvents {
+			lambdaTrails++
+		}
+	}
+
+	var monthlyManagementEvents, monthlyLambdaInvocations float64
+
+	if managementTrails > 1 {
+		monthlyManagementEvents, err = s.estimateMonthlyManagementEvents(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if lambdaTrails > 0 {
+		monthlyLambdaInvocations, err = s.getMonthlyLambdaInvocations(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return classifyTrails(trails, monthlyManagementEvents, monthlyLambdaInvocations), nil
+}
+
+// estimateMonthlyManagementEvents extrapolates the management events recorded in the region
+// during the last hour to a month.
+func (s *service) estimateMonthlyManagementEvents(ctx context.Context) (float64, error) {
+	endTime := time.Now()
+
+	paginator := cloudtrail.NewLookupEventsPaginator(s.client, &cloudtrail.LookupEventsInput{
+		StartTime:  aws.Time(endTime.Add(-time.Hour)),
+		EndTime:    aws.Time(endTime),
+		MaxResults: aws.Int32(50),
+	})
+
+	var events int
+
+	for page := 0; page < maxSamplePages && paginator.HasMorePages(); page++ {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		events += len(output.Events)
+	}
+
+	return float64(events) * hoursPerMonth, nil
+}
+
+// getMonthlyLambdaInvocations returns the account-wide Lambda invocations in the region over
+// the last 30 days, each of which produces one data event.
+func (s *service) getMonthlyLambdaInvocations(ctx context.Context) (float64, error) {
+	endTime := time.Now()
+
+	output, err := s.cloudwatchClient.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/Lambda"),
+		MetricName: aws.String("Invocations"),
+		StartTime:  aws.Time(endTime.AddDate(0, 0, -daysPerMonth)),
+		EndTime:    aws.Time(endTime),
+		Period:     aws.Int32(secondsPerDay),
+		Statistics: []cwtypes.Statistic{cwtypes.StatisticSum},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total float64
+
+	for _, datapoint := range output.Datapoints {
+		total += aws.ToFloat64(datapoint.Sum)
+	}
+
+	return total, nil
+}
+
+// summarizeSelectors reads basic or advanced event selectors. Basic selectors record management
+// events unless IncludeManagementEvents is false, and select all S3 objects or Lambda functions
+// with the bare "arn:aws:s3" / "arn:aws:lambda" prefixes. Advanced selectors select all
+// resources of a type when they do not filter on resources.ARN.
+func summarizeSelectors(trail types.Trail, output *cloudtrail.GetEventSelectorsOutput) trailSelectors {
+	summary := trailSelectors{trail: trail}
+
+	summary.addBasicSelectors(output.EventSelectors)
+	summary.addAdvancedSelectors(output.AdvancedEventSelectors)
+
+	return summary
+}
+
+// addBasicSelectors records what basic event selectors select.
+func (summary *trailSelectors) addBasicSelectors(selectors []types.EventSelector) {
+	for _, selector := range selectors {
+		if selector.IncludeManagementEvents == nil || aws.ToBool(selector.IncludeManagementEvents) {
+			summary.managementEvents = true
+		}
+
+		for _, resource := range selector.DataResources {
+			for _, value := range resource.Values {
+				switch {
+				case aws.ToString(resource.Type) == s3ObjectResourceType && (value == "arn:aws:s3" || value == "arn:aws:s3:::"):
+					summary.allS3DataEvents = true
+				case aws.ToString(resource.Type) == lambdaFunctionResourceType && value == "arn:aws:lambda":
+					summary.allLambdaEvents = true
+				}
+			}
+		}
+	}
+}
+
+// addAdvancedSelectors records what advanced event selectors select.
+func (summary *trailSelectors) addAdvancedSelectors(selectors []types.AdvancedEventSelector) {
+	for _, selector := range selectors {
+		var (
+			categories    []string
+			resourceTypes []string
+			filtersARN    bool
+		)
+
+		for _, field := range selector.FieldSelectors {
+			switch aws.ToString(field.Field) {
+			case "eventCategory":
+				categories = field.Equals
+			case "resources.type":
+				resourceTypes = field.Equals
+			case "resources.ARN":
+				filtersARN = true
+			}
+		}
+
+		if slices.Contains(categories, "Management") {
+			summary.managementEvents = true
+		}
+
+		if !slices.Contains(categories, "Data") || filtersARN {
+			continue
+		}
+
+		if slices.Contains(resourceTypes, s3ObjectResourceType) {
+			summary.allS3DataEvents = true
+		}
+
+		if slices.Contains(resourceTypes, lambdaFunctionResourceType) {
+			summary.allLambdaEvents = true
+		}
+	}
+}
+
+// classifyTrails reports every trail recording management events except one, which is kept as
+// the free copy: organization trails are preferred, then multi-region trails, then trails by
+// name. Trails logging S3 or Lambda data events for all resources are reported separately; S3
+// data event volume is not published by default, so those findings carry no estimate.
+func classifyTrails(trails []trailSelectors, monthlyManagementEvents, monthlyLambdaInvocations float64) []model.CloudTrailWasteInfo {
+	var managementTrails []trailSelectors
+
+	for _, trail := range trails {
+		if trail.managementEvents {
+			managementTrails = append(managementTrails, trail)
+		}
+	}
+
+	slices.SortStableFunc(managementTrails, func(a, b trailSelectors) int {
+		if byOrganization := compareBool(aws.ToBool(b.trail.IsOrganizationTrail), aws.ToBool(a.trail.IsOrganizationTrail)); byOrganization != 0 {
+			return byOrganization
+		}
+
+		if byMultiRegion := compareBool(aws.ToBool(b.trail.IsMultiRegionTrail), aws.ToBool(a.trail.IsMultiRegionTrail)); byMultiRegion != 0 {
+			return byMultiRegion
+		}
+
+		return cmp.Compare(aws.ToString(a.trail.Name), aws.ToString(b.trail.Name))
+	})
+
+	var results []model.CloudTrailWasteInfo
+
+	for i, trail := range managementTrails {
+		if i == 0 {
+			continue
+		}
+
+		info := trailToInfo(trail.trail, model.CloudTrailDuplicateManagementEvents)
+		info.DuplicateOf = aws.ToString(managementTrails[0].trail.Name)
+		info.EstimatedMonthlyEvents = monthlyManagementEvents
+		info.EstimatedMonthlyCost = monthlyManagementEvents / 100000 * managementEventCostPer100K
+		results = append(results, info)
+	}
+
+	for _, trail := range trails {
+		if trail.allS3DataEvents {
+			results = append(results, trailToInfo(trail.trail, model.CloudTrailAllS3DataEvents))
+		}
+
+		if trail.allLambdaEvents {
+			info := trailToInfo(trail.trail, model.CloudTrailAllLambdaDataEvents)
+			info.EstimatedMonthlyEvents = monthlyLambdaInvocations
+			info.EstimatedMonthlyCost = monthlyLambdaInvocations / 100000 * dataEventCostPer100K
+			results = append(results, info)
+		}
+	}
+
+	return results
+}
+
+func trailToInfo(trail types.Trail, status string) model.CloudTrailWasteInfo {
+	return model.CloudTrailWasteInfo{
+		TrailName:           aws.ToString(trail.Name),
+		TrailARN:            aws.ToString(trail.TrailARN),
+		HomeRegion:          aws.ToString(trail.HomeRegion),
+		IsMultiRegionTrail:  aws.ToBool(trail.IsMultiRegionTrail),
+		IsOrganizationTrail: aws.ToBool(trail.IsOrganizationTrail),
+		Status:              status,
+	}
+}
+
+// compareBool orders false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,138 @@
+package cloudtrail
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestSummarizeSelectors(t *testing.T) {
+	tests := []struct {
+		name           string
+		output         cloudtrail.GetEventSelectorsOutput
+		wantManagement bool
+		wantAllS3      bool
+		wantAllLambda  bool
+	}{
+		{
+			name:           "default_basic_selector",
+			output:         cloudtrail.GetEventSelectorsOutput{EventSelectors: []types.EventSelector{{ReadWriteType: types.ReadWriteTypeAll}}},
+			wantManagement: true,
+		},
+		{
+			name: "basic_all_data_events",
+			output: cloudtrail.GetEventSelectorsOutput{EventSelectors: []types.EventSelector{{
+				IncludeManagementEvents: aws.Bool(false),
+				DataResources: []types.DataResource{
+					{Type: aws.String("AWS::S3::Object"), Values: []string{"arn:aws:s3"}},
+					{Type: aws.String("AWS::Lambda::Function"), Values: []string{"arn:aws:lambda"}},
+				},
+			}}},
+			wantAllS3:     true,
+			wantAllLambda: true,
+		},
+		{
+			name: "basic_single_bucket",
+			output: cloudtrail.GetEventSelectorsOutput{EventSelectors: []types.EventSelector{{
+				IncludeManagementEvents: aws.Bool(true),
+				DataResources: []types.DataResource{
+					{Type: aws.String("AWS::S3::Object"), Values: []string{"arn:aws:s3:::audit-bucket/"}},
+				},
+			}}},
+			wantManagement: true,
+		},
+		{
+			name: "advanced_management_and_all_s3",
+			output: cloudtrail.GetEventSelectorsOutput{AdvancedEventSelectors: []types.AdvancedEventSelector{
+				{FieldSelectors: []types.AdvancedFieldSelector{{Field: aws.String("eventCategory"), Equals: []string{"Management"}}}},
+				{FieldSelectors: []types.AdvancedFieldSelector{
+					{Field: aws.String("eventCategory"), Equals: []string{"Data"}},
+					{Field: aws.String("resources.type"), Equals: []string{"AWS::S3::Object"}},
+				}},
+			}},
+			wantManagement: true,
+			wantAllS3:      true,
+		},
+		{
+			name: "advanced_filtered_lambda",
+			output: cloudtrail.GetEventSelectorsOutput{AdvancedEventSelectors: []types.AdvancedEventSelector{
+				{FieldSelectors: []types.AdvancedFieldSelector{
+					{Field: aws.String("eventCategory"), Equals: []string{"Data"}},
+					{Field: aws.String("resources.type"), Equals: []string{"AWS::Lambda::Function"}},
+					{Field: aws.String("resources.ARN"), StartsWith: []string{"arn:aws:lambda:us-east-1:123456789012:function:payments"}},
+				}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarizeSelectors(types.Trail{Name: aws.String("trail")}, &tt.output)
+
+			if got.managementEvents != tt.wantManagement || got.allS3DataEvents != tt.wantAllS3 || got.allLambdaEvents != tt.wantAllLambda {
+				t.Errorf("summarizeSelectors() = management %v, s3 %v, lambda %v; want %v, %v, %v",
+					got.managementEvents, got.allS3DataEvents, got.allLambdaEvents, tt.wantManagement, tt.wantAllS3, tt.wantAllLambda)
+			}
+		})
+	}
+}
+
+func TestClassifyTrails(t *testing.T) {
+	trails := []trailSelectors{
+		{trail: types.Trail{Name: aws.String("b-regional")}, managementEvents: true},
+		{trail: types.Trail{Name: aws.String("a-regional")}, managementEvents: true, allLambdaEvents: true},
+		{trail: types.Trail{Name: aws.String("org"), IsOrganizationTrail: aws.Bool(true), IsMultiRegionTrail: aws.Bool(true)}, managementEvents: true},
+		{trail: types.Trail{Name: aws.String("data-only")}, allS3DataEvents: true},
+	}
+
+	results := classifyTrails(trails, 1000000, 500000)
+
+	var duplicates []string
+
+	statuses := make(map[string]int)
+
+	for _, result := range results {
+		statuses[result.Status]++
+
+		if result.Status == model.CloudTrailDuplicateManagementEvents {
+			duplicates = append(duplicates, result.TrailName)
+
+			if result.DuplicateOf != "org" {
+				t.Errorf("DuplicateOf = %q, want %q", result.DuplicateOf, "org")
+			}
+
+			if diff := result.EstimatedMonthlyCost - 20; diff > 0.001 || diff < -0.001 {
+				t.Errorf("duplicate EstimatedMonthlyCost = %v, want 20", result.EstimatedMonthlyCost)
+			}
+		}
+
+		if result.Status == model.CloudTrailAllLambdaDataEvents {
+			if diff := result.EstimatedMonthlyCost - 0.5; diff > 0.001 || diff < -0.001 {
+				t.Errorf("lambda EstimatedMonthlyCost = %v, want 0.5", result.EstimatedMonthlyCost)
+			}
+		}
+
+		if result.Status == model.CloudTrailAllS3DataEvents && result.EstimatedMonthlyCost != 0 {
+			t.Errorf("S3 data events should not be estimated, got %v", result.EstimatedMonthlyCost)
+		}
+	}
+
+	if len(duplicates) != 2 || duplicates[0] != "a-regional" || duplicates[1] != "b-regional" {
+		t.Errorf("duplicates = %v, want [a-regional b-regional]", duplicates)
+	}
+
+	if statuses[model.CloudTrailAllS3DataEvents] != 1 || statuses[model.CloudTrailAllLambdaDataEvents] != 1 {
+		t.Errorf("unexpected statuses: %v", statuses)
+	}
+}
+
+func TestClassifyTrails_SingleManagementTrail(t *testing.T) {
+	trails := []trailSelectors{{trail: types.Trail{Name: aws.String("main")}, managementEvents: true}}
+
+	if results := classifyTrails(trails, 0, 0); len(results) != 0 {
+		t.Errorf("expected no findings for a single trail, got %+v", results)
+	}
+}
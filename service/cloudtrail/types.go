@@ -0,0 +1,19 @@
+package cloudtrail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client           *cloudtrail.Client
+	cloudwatchClient *cloudwatch.Client
+}
+
+// Service defines the interface for AWS CloudTrail service.
+type Service interface {
+	GetTrailWaste(ctx context.Context) ([]model.CloudTrailWasteInfo, error)
+}
@@ -0,0 +1,278 @@
+// Package computeoptimizer provides a service for interacting with AWS Compute Optimizer.
+package computeoptimizer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// NewService creates a new Compute Optimizer service.
+func NewService(awsconfig aws.Config) Service {
+	client := computeoptimizer.NewFromConfig(awsconfig)
+
+	return &service{
+		client: client,
+	}
+}
+
+// IsOptedIn reports whether the account is enrolled in Compute Optimizer.
+func (s *service) IsOptedIn(ctx context.Context) (bool, error) {
+	output, err := s.client.GetEnrollmentStatus(ctx, &computeoptimizer.GetEnrollmentStatusInput{})
+	if err != nil {
+		if isOptInRequired(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get compute optimizer enrollment status: %w", err)
+	}
+
+	return output.Status == types.StatusActive, nil
+}
+
+// GetEC2InstanceRecommendations returns the best ranked recommendation for every
+// EC2 instance Compute Optimizer does not consider optimized.
+func (s *service) GetEC2InstanceRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error) {
+	var results []model.RightsizingRecommendationInfo
+
+	input := &computeoptimizer.GetEC2InstanceRecommendationsInput{}
+
+	for {
+		output, err := s.client.GetEC2InstanceRecommendations(ctx, input)
+		if err != nil {
+			if isOptInRequired(err) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("failed to get EC2 instance recommendations: %w", err)
+		}
+
+		for _, recommendation := range output.InstanceRecommendations {
+			if recommendation.Finding == types.FindingOptimized {
+				continue
+			}
+
+			results = append(results, instanceRecommendationToInfo(recommendation))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return results, nil
+}
+
+// GetEBSVolumeRecommendations returns the best ranked recommendation for every
+// EBS volume Compute Optimizer does not consider optimized.
+func (s *service) GetEBSVolumeRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error) {
+	var results []model.RightsizingRecommendationInfo
+
+	input := &computeoptimizer.GetEBSVolumeRecommendationsInput{}
+
+	for {
+		output, err := s.client.GetEBSVolumeRecommendations(ctx, input)
+		if err != nil {
+			if isOptInRequired(err) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("failed to get EBS volume recommendations: %w", err)
+		}
+
+		for _, recommendation := range output.VolumeRecommendations {
+			if recommendation.Finding == types.EBSFindingOptimized {
+				continue
+			}
+
+			results = append(results, volumeRecommendationToInfo(recommendation))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return results, nil
+}
+
+// GetLambdaFunctionRecommendations returns the best ranked memory recommendation for every
+// Lambda function Compute Optimizer reports as not optimized.
+func (s *service) GetLambdaFunctionRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error) {
+	var results []model.RightsizingRecommendationInfo
+
+	paginator := computeoptimizer.NewGetLambdaFunctionRecommendationsPaginator(s.client, &computeoptimizer.GetLambdaFunctionRecommendationsInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			if isOptInRequired(err) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("failed to get Lambda function recommendations: %w", err)
+		}
+
+		for _, recommendation := range output.LambdaFunctionRecommendations {
+			if recommendation.Finding != types.LambdaFunctionRecommendationFindingNotOptimized {
+				continue
+			}
+
+			results = append(results, lambdaRecommendationToInfo(recommendation))
+		}
+	}
+
+	return results, nil
+}
+
+func instanceRecommendationToInfo(recommendation types.InstanceRecommendation) model.RightsizingRecommendationInfo {
+	info := model.RightsizingRecommendationInfo{
+		Source:               model.RightsizingSourceComputeOptimizer,
+		ResourceType:         model.RightsizingResourceEC2Instance,
+		ResourceID:           resourceIDFromArn(aws.ToString(recommendation.InstanceArn)),
+		Finding:              string(recommendation.Finding),
+		CurrentConfiguration: aws.ToString(recommendation.CurrentInstanceType),
+	}
+
+	var best *types.InstanceRecommendationOption
+
+	for i := range recommendation.RecommendationOptions {
+		option := &recommendation.RecommendationOptions[i]
+		if best == nil || option.Rank < best.Rank {
+			best = option
+		}
+	}
+
+	if best == nil {
+		return info
+	}
+
+	info.RecommendedConfiguration = aws.ToString(best.InstanceType)
+	info.EstimatedMonthlySavings, info.Currency = savingsOpportunity(best.SavingsOpportunity)
+
+	for _, metric := range best.ProjectedUtilizationMetrics {
+		if metric.Statistic != types.MetricStatisticMaximum {
+			continue
+		}
+
+		switch metric.Name {
+		case types.MetricNameCpu:
+			info.ProjectedCPUUtilization = metric.Value
+		case types.MetricNameMemory:
+			info.ProjectedMemoryUtilization = metric.Value
+		}
+	}
+
+	return info
+}
+
+func volumeRecommendationToInfo(recommendation types.VolumeRecommendation) model.RightsizingRecommendationInfo {
+	info := model.RightsizingRecommendationInfo{
+		Source:               model.RightsizingSourceComputeOptimizer,
+		ResourceType:         model.RightsizingResourceEBSVolume,
+		ResourceID:           resourceIDFromArn(aws.ToString(recommendation.VolumeArn)),
+		Finding:              string(recommendation.Finding),
+		CurrentConfiguration: describeVolumeConfiguration(recommendation.CurrentConfiguration),
+	}
+
+	var best *types.VolumeRecommendationOption
+
+	for i := range recommendation.VolumeRecommendationOptions {
+		option := &recommendation.VolumeRecommendationOptions[i]
+		if best == nil || option.Rank < best.Rank {
+			best = option
+		}
+	}
+
+	if best == nil {
+		return info
+	}
+
+	info.RecommendedConfiguration = describeVolumeConfiguration(best.Configuration)
+	info.EstimatedMonthlySavings, info.Currency = savingsOpportunity(best.SavingsOpportunity)
+
+	return info
+}
+
+func lambdaRecommendationToInfo(recommendation types.LambdaFunctionRecommendation) model.RightsizingRecommendationInfo {
+	info := model.RightsizingRecommendationInfo{
+		Source:               model.RightsizingSourceComputeOptimizer,
+		ResourceType:         model.RightsizingResourceLambdaFunction,
+		ResourceID:           lambdaFunctionName(aws.ToString(recommendation.FunctionArn)),
+		Finding:              string(recommendation.Finding),
+		CurrentConfiguration: fmt.Sprintf("%d MB", recommendation.CurrentMemorySize),
+	}
+
+	var best *types.LambdaFunctionMemoryRecommendationOption
+
+	for i := range recommendation.MemorySizeRecommendationOptions {
+		option := &recommendation.MemorySizeRecommendationOptions[i]
+		if best == nil || option.Rank < best.Rank {
+			best = option
+		}
+	}
+
+	if best == nil {
+		return info
+	}
+
+	info.RecommendedConfiguration = fmt.Sprintf("%d MB", best.MemorySize)
+	info.EstimatedMonthlySavings, info.Currency = savingsOpportunity(best.SavingsOpportunity)
+
+	return info
+}
+
+func describeVolumeConfiguration(configuration *types.VolumeConfiguration) string {
+	if configuration == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s %d GiB", aws.ToString(configuration.VolumeType), configuration.VolumeSize)
+}
+
+func savingsOpportunity(opportunity *types.SavingsOpportunity) (float64, string) {
+	if opportunity == nil || opportunity.EstimatedMonthlySavings == nil {
+		return 0, ""
+	}
+
+	return opportunity.EstimatedMonthlySavings.Value, string(opportunity.EstimatedMonthlySavings.Currency)
+}
+
+// resourceIDFromArn extracts the resource ID from ARNs such as
+// arn:aws:ec2:us-east-1:123456789012:instance/i-0abc.
+func resourceIDFromArn(arn string) string {
+	if idx := strings.LastIndex(arn, "/"); idx >= 0 {
+		return arn[idx+1:]
+	}
+
+	return arn
+}
+
+// lambdaFunctionName extracts the function name from ARNs such as
+// arn:aws:lambda:us-east-1:123456789012:function:my-function:$LATEST.
+func lambdaFunctionName(arn string) string {
+	_, name, found := strings.Cut(arn, ":function:")
+	if !found {
+		return arn
+	}
+
+	name, _, _ = strings.Cut(name, ":")
+
+	return name
+}
+
+func isOptInRequired(err error) bool {
+	var optInErr *types.OptInRequiredException
+
+	return errors.As(err, &optInErr)
+}
@@ -0,0 +1,153 @@
+package computeoptimizer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestResourceIDFromArn(t *testing.T) {
+	tests := []struct {
+		name string
+		arn  string
+		want string
+	}{
+		{name: "instance", arn: "arn:aws:ec2:us-east-1:123456789012:instance/i-0abc", want: "i-0abc"},
+		{name: "volume", arn: "arn:aws:ec2:us-east-1:123456789012:volume/vol-0abc", want: "vol-0abc"},
+		{name: "plain_id", arn: "i-0abc", want: "i-0abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceIDFromArn(tt.arn); got != tt.want {
+				t.Errorf("resourceIDFromArn() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLambdaFunctionName(t *testing.T) {
+	tests := []struct {
+		name string
+		arn  string
+		want string
+	}{
+		{name: "versioned", arn: "arn:aws:lambda:us-east-1:123456789012:function:my-function:$LATEST", want: "my-function"},
+		{name: "unversioned", arn: "arn:aws:lambda:us-east-1:123456789012:function:my-function", want: "my-function"},
+		{name: "not_an_arn", arn: "my-function", want: "my-function"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lambdaFunctionName(tt.arn); got != tt.want {
+				t.Errorf("lambdaFunctionName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstanceRecommendationToInfo(t *testing.T) {
+	recommendation := types.InstanceRecommendation{
+		InstanceArn:         aws.String("arn:aws:ec2:us-east-1:123456789012:instance/i-0abc"),
+		CurrentInstanceType: aws.String("m5.2xlarge"),
+		Finding:             types.FindingOverProvisioned,
+		RecommendationOptions: []types.InstanceRecommendationOption{
+			{
+				InstanceType: aws.String("m5.xlarge"),
+				Rank:         2,
+			},
+			{
+				InstanceType: aws.String("m5.large"),
+				Rank:         1,
+				ProjectedUtilizationMetrics: []types.UtilizationMetric{
+					{Name: types.MetricNameCpu, Statistic: types.MetricStatisticMaximum, Value: 62.5},
+					{Name: types.MetricNameMemory, Statistic: types.MetricStatisticMaximum, Value: 48},
+					{Name: types.MetricNameCpu, Statistic: types.MetricStatisticAverage, Value: 20},
+				},
+				SavingsOpportunity: &types.SavingsOpportunity{
+					EstimatedMonthlySavings: &types.EstimatedMonthlySavings{Currency: types.CurrencyUsd, Value: 70},
+				},
+			},
+		},
+	}
+
+	info := instanceRecommendationToInfo(recommendation)
+
+	if info.Source != model.RightsizingSourceComputeOptimizer || info.ResourceType != model.RightsizingResourceEC2Instance {
+		t.Errorf("Source/ResourceType = %s/%s, want compute_optimizer/EC2 Instance", info.Source, info.ResourceType)
+	}
+
+	if info.ResourceID != "i-0abc" {
+		t.Errorf("ResourceID = %q, want 'i-0abc'", info.ResourceID)
+	}
+
+	if info.CurrentConfiguration != "m5.2xlarge" || info.RecommendedConfiguration != "m5.large" {
+		t.Errorf("Configuration = %s -> %s, want m5.2xlarge -> m5.large", info.CurrentConfiguration, info.RecommendedConfiguration)
+	}
+
+	if info.ProjectedCPUUtilization != 62.5 || info.ProjectedMemoryUtilization != 48 {
+		t.Errorf("Projected utilization = %v/%v, want 62.5/48", info.ProjectedCPUUtilization, info.ProjectedMemoryUtilization)
+	}
+
+	if info.EstimatedMonthlySavings != 70 || info.Currency != "USD" {
+		t.Errorf("Savings = %v %s, want 70 USD", info.EstimatedMonthlySavings, info.Currency)
+	}
+}
+
+func TestVolumeRecommendationToInfo(t *testing.T) {
+	recommendation := types.VolumeRecommendation{
+		VolumeArn:            aws.String("arn:aws:ec2:us-east-1:123456789012:volume/vol-0abc"),
+		Finding:              types.EBSFindingNotOptimized,
+		CurrentConfiguration: &types.VolumeConfiguration{VolumeType: aws.String("io1"), VolumeSize: 500},
+		VolumeRecommendationOptions: []types.VolumeRecommendationOption{
+			{
+				Configuration: &types.VolumeConfiguration{VolumeType: aws.String("gp3"), VolumeSize: 500},
+				Rank:          1,
+				SavingsOpportunity: &types.SavingsOpportunity{
+					EstimatedMonthlySavings: &types.EstimatedMonthlySavings{Currency: types.CurrencyUsd, Value: 25.5},
+				},
+			},
+		},
+	}
+
+	info := volumeRecommendationToInfo(recommendation)
+
+	if info.ResourceID != "vol-0abc" {
+		t.Errorf("ResourceID = %q, want 'vol-0abc'", info.ResourceID)
+	}
+
+	if info.CurrentConfiguration != "io1 500 GiB" || info.RecommendedConfiguration != "gp3 500 GiB" {
+		t.Errorf("Configuration = %s -> %s, want io1 500 GiB -> gp3 500 GiB", info.CurrentConfiguration, info.RecommendedConfiguration)
+	}
+
+	if info.EstimatedMonthlySavings != 25.5 {
+		t.Errorf("EstimatedMonthlySavings = %v, want 25.5", info.EstimatedMonthlySavings)
+	}
+}
+
+func TestLambdaRecommendationToInfo(t *testing.T) {
+	recommendation := types.LambdaFunctionRecommendation{
+		FunctionArn:       aws.String("arn:aws:lambda:us-east-1:123456789012:function:worker:$LATEST"),
+		Finding:           types.LambdaFunctionRecommendationFindingNotOptimized,
+		CurrentMemorySize: 3008,
+		MemorySizeRecommendationOptions: []types.LambdaFunctionMemoryRecommendationOption{
+			{MemorySize: 1024, Rank: 1},
+		},
+	}
+
+	info := lambdaRecommendationToInfo(recommendation)
+
+	if info.ResourceID != "worker" {
+		t.Errorf("ResourceID = %q, want 'worker'", info.ResourceID)
+	}
+
+	if info.CurrentConfiguration != "3008 MB" || info.RecommendedConfiguration != "1024 MB" {
+		t.Errorf("Configuration = %s -> %s, want 3008 MB -> 1024 MB", info.CurrentConfiguration, info.RecommendedConfiguration)
+	}
+
+	if info.EstimatedMonthlySavings != 0 {
+		t.Errorf("EstimatedMonthlySavings = %v, want 0 without a savings opportunity", info.EstimatedMonthlySavings)
+	}
+}
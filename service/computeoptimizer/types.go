@@ -0,0 +1,20 @@
+package computeoptimizer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client *computeoptimizer.Client
+}
+
+// Service defines the interface for AWS Compute Optimizer service.
+type Service interface {
+	IsOptedIn(ctx context.Context) (bool, error)
+	GetEC2InstanceRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error)
+	GetEBSVolumeRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error)
+	GetLambdaFunctionRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error)
+}
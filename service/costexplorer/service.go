@@ -3,8 +3,11 @@ package awscostexplorer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -155,6 +158,55 @@ func (s *service) GetMonthTotalCosts(ctx context.Context, endDate time.Time) (*s
 	return &total, nil
 }
 
+// GetCurrentMonthForecast returns the forecasted unblended cost from today to the end of the
+// current month. It returns nil on the last day of the month and when Cost Explorer does not
+// have enough history to forecast.
+func (s *service) GetCurrentMonthForecast(ctx context.Context) (*model.CostForecast, error) {
+	timePeriod, ok := s.getForecastTimePeriod(time.Now().UTC())
+	if !ok {
+		return nil, nil
+	}
+
+	output, err := s.client.GetCostForecast(ctx, &costexplorer.GetCostForecastInput{
+		Granularity: types.GranularityMonthly,
+		Metric:      types.MetricUnblendedCost,
+		TimePeriod:  timePeriod,
+	})
+	if err != nil {
+		if isDataUnavailable(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get cost forecast: %w", err)
+	}
+
+	if output.Total == nil {
+		return nil, nil
+	}
+
+	return &model.CostForecast{
+		Amount: parseAmount(output.Total.Amount),
+		Unit:   aws.ToString(output.Total.Unit),
+	}, nil
+}
+
+// getForecastTimePeriod returns the period from today to the first day of next month. Cost
+// Explorer requires the end of a forecast to be after its start, so there is no period on the
+// last day of the month.
+func (s *service) getForecastTimePeriod(now time.Time) (*types.DateInterval, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	nextMonth := s.getFirstDayOfMonth(today).AddDate(0, 1, 0)
+
+	if !today.AddDate(0, 0, 1).Before(nextMonth) {
+		return nil, false
+	}
+
+	return &types.DateInterval{
+		Start: aws.String(today.Format("2006-01-02")),
+		End:   aws.String(nextMonth.Format("2006-01-02")),
+	}, true
+}
+
 func (s *service) getFirstDayOfMonth(month time.Time) time.Time {
 	return time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
 }
@@ -198,3 +250,642 @@ func (s *service) filterGroups(results []types.Group, costsAggregation string) m
 
 	return costGroups
 }
+
+// GetReservationSummary returns the account-wide Reserved Instance utilization and coverage
+// for the last lookbackDays days. It returns nil when the account has no Reserved Instances.
+func (s *service) GetReservationSummary(ctx context.Context, lookbackDays int) (*model.CommitmentSummaryInfo, error) {
+	timePeriod := s.getLookbackTimePeriod(time.Now(), lookbackDays)
+
+	utilization, err := s.client.GetReservationUtilization(ctx, &costexplorer.GetReservationUtilizationInput{
+		TimePeriod: timePeriod,
+	})
+	if err != nil {
+		if isDataUnavailable(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get reservation utilization: %w", err)
+	}
+
+	if utilization.Total == nil {
+		return nil, nil
+	}
+
+	summary := &model.CommitmentSummaryInfo{
+		CommitmentType:     model.CommitmentTypeReservedInstance,
+		UtilizationPercent: parseAmount(utilization.Total.UtilizationPercentage),
+		UnusedHours:        parseAmount(utilization.Total.UnusedHours),
+		UnusedCommitment:   parseAmount(utilization.Total.RICostForUnusedHours),
+	}
+
+	coverage, err := s.client.GetReservationCoverage(ctx, &costexplorer.GetReservationCoverageInput{
+		TimePeriod: timePeriod,
+	})
+	if err != nil {
+		if isDataUnavailable(err) {
+			return summary, nil
+		}
+
+		return nil, fmt.Errorf("failed to get reservation coverage: %w", err)
+	}
+
+	if coverage.Total != nil {
+		if coverage.Total.CoverageHours != nil {
+			summary.CoveragePercent = parseAmount(coverage.Total.CoverageHours.CoverageHoursPercentage)
+		}
+
+		if coverage.Total.CoverageCost != nil {
+			summary.OnDemandCost = parseAmount(coverage.Total.CoverageCost.OnDemandCost)
+		}
+	}
+
+	return summary, nil
+}
+
+// GetSavingsPlansSummary returns the account-wide Savings Plans utilization and coverage
+// for the last lookbackDays days. It returns nil when the account has no Savings Plans.
+func (s *service) GetSavingsPlansSummary(ctx context.Context, lookbackDays int) (*model.CommitmentSummaryInfo, error) {
+	timePeriod := s.getLookbackTimePeriod(time.Now(), lookbackDays)
+
+	utilization, err := s.client.GetSavingsPlansUtilization(ctx, &costexplorer.GetSavingsPlansUtilizationInput{
+		TimePeriod: timePeriod,
+	})
+	if err != nil {
+		if isDataUnavailable(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get savings plans utilization: %w", err)
+	}
+
+	if utilization.Total == nil || utilization.Total.Utilization == nil {
+		return nil, nil
+	}
+
+	summary := &model.CommitmentSummaryInfo{
+		CommitmentType:     model.CommitmentTypeSavingsPlan,
+		UtilizationPercent: parseAmount(utilization.Total.Utilization.UtilizationPercentage),
+		UnusedCommitment:   parseAmount(utilization.Total.Utilization.UnusedCommitment),
+	}
+
+	var coveredSpend, totalSpend float64
+
+	paginator := costexplorer.NewGetSavingsPlansCoveragePaginator(s.client, &costexplorer.GetSavingsPlansCoverageInput{
+		TimePeriod:  timePeriod,
+		Granularity: types.GranularityMonthly,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			if isDataUnavailable(err) {
+				return summary, nil
+			}
+
+			return nil, fmt.Errorf("failed to get savings plans coverage: %w", err)
+		}
+
+		for _, coverage := range page.SavingsPlansCoverages {
+			if coverage.Coverage == nil {
+				continue
+			}
+
+			summary.OnDemandCost += parseAmount(coverage.Coverage.OnDemandCost)
+			coveredSpend += parseAmount(coverage.Coverage.SpendCoveredBySavingsPlans)
+			totalSpend += parseAmount(coverage.Coverage.TotalCost)
+		}
+	}
+
+	if totalSpend > 0 {
+		summary.CoveragePercent = coveredSpend / totalSpend * 100
+	}
+
+	return summary, nil
+}
+
+// GetUnderutilizedReservations returns the Reserved Instances whose utilization over the
+// last lookbackDays days is below utilizationThreshold percent.
+func (s *service) GetUnderutilizedReservations(ctx context.Context, lookbackDays int, utilizationThreshold float64) ([]model.CommitmentUtilizationInfo, error) {
+	type reservationUsage struct {
+		attributes     map[string]string
+		purchasedHours float64
+		unusedHours    float64
+		unusedCost     float64
+	}
+
+	usageBySubscription := make(map[string]*reservationUsage)
+
+	var subscriptionIDs []string
+
+	input := &costexplorer.GetReservationUtilizationInput{
+		TimePeriod: s.getLookbackTimePeriod(time.Now(), lookbackDays),
+		GroupBy: []types.GroupDefinition{
+			{
+				Key:  aws.String("SUBSCRIPTION_ID"),
+				Type: types.GroupDefinitionTypeDimension,
+			},
+		},
+	}
+
+	for {
+		output, err := s.client.GetReservationUtilization(ctx, input)
+		if err != nil {
+			if isDataUnavailable(err) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("failed to get reservation utilization: %w", err)
+		}
+
+		for _, byTime := range output.UtilizationsByTime {
+			for _, group := range byTime.Groups {
+				if group.Utilization == nil {
+					continue
+				}
+
+				id := aws.ToString(group.Value)
+
+				usage, ok := usageBySubscription[id]
+				if !ok {
+					usage = &reservationUsage{attributes: group.Attributes}
+					usageBySubscription[id] = usage
+					subscriptionIDs = append(subscriptionIDs, id)
+				}
+
+				usage.purchasedHours += parseAmount(group.Utilization.PurchasedHours)
+				usage.unusedHours += parseAmount(group.Utilization.UnusedHours)
+				usage.unusedCost += parseAmount(group.Utilization.RICostForUnusedHours)
+			}
+		}
+
+		if output.NextPageToken == nil {
+			break
+		}
+
+		input.NextPageToken = output.NextPageToken
+	}
+
+	var results []model.CommitmentUtilizationInfo
+
+	now := time.Now()
+
+	for _, id := range subscriptionIDs {
+		usage := usageBySubscription[id]
+		if usage.purchasedHours == 0 {
+			continue
+		}
+
+		utilization := (usage.purchasedHours - usage.unusedHours) / usage.purchasedHours * 100
+		if utilization >= utilizationThreshold {
+			continue
+		}
+
+		info := model.CommitmentUtilizationInfo{
+			CommitmentType:     model.CommitmentTypeReservedInstance,
+			ID:                 id,
+			Description:        joinAttributes(usage.attributes, "instanceType", "region"),
+			UtilizationPercent: utilization,
+			UnusedHours:        usage.unusedHours,
+			UnusedCommitment:   usage.unusedCost,
+		}
+		setEndDate(&info, getAttribute(usage.attributes, "endDateTime"), now)
+
+		results = append(results, info)
+	}
+
+	return results, nil
+}
+
+// GetSavingsPlansCommitments returns the Savings Plans whose utilization over the last
+// lookbackDays days is below utilizationThreshold percent, and the Savings Plans that
+// expire within the next expiryDays days.
+func (s *service) GetSavingsPlansCommitments(ctx context.Context, lookbackDays int, utilizationThreshold float64, expiryDays int) ([]model.CommitmentUtilizationInfo, []model.CommitmentUtilizationInfo, error) {
+	var plans []model.CommitmentUtilizationInfo
+
+	now := time.Now()
+
+	paginator := costexplorer.NewGetSavingsPlansUtilizationDetailsPaginator(s.client, &costexplorer.GetSavingsPlansUtilizationDetailsInput{
+		TimePeriod: s.getLookbackTimePeriod(now, lookbackDays),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			if isDataUnavailable(err) {
+				return nil, nil, nil
+			}
+
+			return nil, nil, fmt.Errorf("failed to get savings plans utilization details: %w", err)
+		}
+
+		for _, detail := range page.SavingsPlansUtilizationDetails {
+			info := model.CommitmentUtilizationInfo{
+				CommitmentType: model.CommitmentTypeSavingsPlan,
+				ID:             aws.ToString(detail.SavingsPlanArn),
+				Description:    joinAttributes(detail.Attributes, "SavingsPlansType", "InstanceFamily", "Region"),
+			}
+
+			if detail.Utilization != nil {
+				info.UtilizationPercent = parseAmount(detail.Utilization.UtilizationPercentage)
+				info.UnusedCommitment = parseAmount(detail.Utilization.UnusedCommitment)
+			}
+
+			setEndDate(&info, getAttribute(detail.Attributes, "EndDateTime"), now)
+
+			plans = append(plans, info)
+		}
+	}
+
+	underutilized, expiring := classifySavingsPlans(plans, utilizationThreshold, expiryDays)
+
+	return underutilized, expiring, nil
+}
+
+// GetSavingsPlansPurchaseRecommendations returns Compute and EC2 Instance Savings Plans
+// purchase recommendations for the given term, payment option and lookback period,
+// sorted by estimated monthly savings.
+func (s *service) GetSavingsPlansPurchaseRecommendations(ctx context.Context, options model.RecommendationOptions) ([]model.PurchaseRecommendationInfo, error) {
+	var results []model.PurchaseRecommendationInfo
+
+	planTypes := map[types.SupportedSavingsPlansType]string{
+		types.SupportedSavingsPlansTypeComputeSp:     "Compute Savings Plan",
+		types.SupportedSavingsPlansTypeEc2InstanceSp: "EC2 Instance Savings Plan",
+	}
+
+	for _, planType := range []types.SupportedSavingsPlansType{types.SupportedSavingsPlansTypeComputeSp, types.SupportedSavingsPlansTypeEc2InstanceSp} {
+		input := &costexplorer.GetSavingsPlansPurchaseRecommendationInput{
+			SavingsPlansType:     planType,
+			TermInYears:          toTermInYears(options.TermInYears),
+			PaymentOption:        toPaymentOption(options.PaymentOption),
+			LookbackPeriodInDays: toLookbackPeriod(options.LookbackDays),
+		}
+
+		for {
+			output, err := s.client.GetSavingsPlansPurchaseRecommendation(ctx, input)
+			if err != nil {
+				if isDataUnavailable(err) {
+					break
+				}
+
+				return nil, fmt.Errorf("failed to get savings plans purchase recommendation: %w", err)
+			}
+
+			if output.SavingsPlansPurchaseRecommendation != nil {
+				for _, detail := range output.SavingsPlansPurchaseRecommendation.SavingsPlansPurchaseRecommendationDetails {
+					upfront := parseAmount(detail.UpfrontCost)
+					monthlySavings := parseAmount(detail.EstimatedMonthlySavingsAmount)
+
+					recommendation := model.PurchaseRecommendationInfo{
+						CommitmentType:          model.CommitmentTypeSavingsPlan,
+						Service:                 planTypes[planType],
+						HourlyCommitment:        parseAmount(detail.HourlyCommitmentToPurchase),
+						UpfrontCost:             upfront,
+						EstimatedMonthlySavings: monthlySavings,
+						EstimatedSavingsPercent: parseAmount(detail.EstimatedSavingsPercentage),
+						BreakEvenMonths:         breakEvenMonths(upfront, monthlySavings),
+						Currency:                aws.ToString(detail.CurrencyCode),
+					}
+
+					if detail.SavingsPlansDetails != nil {
+						recommendation.Description = strings.Join(nonEmpty(
+							aws.ToString(detail.SavingsPlansDetails.InstanceFamily),
+							aws.ToString(detail.SavingsPlansDetails.Region),
+						), " / ")
+					}
+
+					results = append(results, recommendation)
+				}
+			}
+
+			if output.NextPageToken == nil {
+				break
+			}
+
+			input.NextPageToken = output.NextPageToken
+		}
+	}
+
+	sortRecommendationsBySavings(results)
+
+	return results, nil
+}
+
+// GetReservationPurchaseRecommendations returns Reserved Instance purchase recommendations
+// for EC2, RDS and ElastiCache for the given term, payment option and lookback period,
+// sorted by estimated monthly savings.
+func (s *service) GetReservationPurchaseRecommendations(ctx context.Context, options model.RecommendationOptions) ([]model.PurchaseRecommendationInfo, error) {
+	var results []model.PurchaseRecommendationInfo
+
+	services := []string{
+		"Amazon Elastic Compute Cloud - Compute",
+		"Amazon Relational Database Service",
+		"Amazon ElastiCache",
+	}
+
+	for _, serviceName := range services {
+		input := &costexplorer.GetReservationPurchaseRecommendationInput{
+			Service:              aws.String(serviceName),
+			TermInYears:          toTermInYears(options.TermInYears),
+			PaymentOption:        toPaymentOption(options.PaymentOption),
+			LookbackPeriodInDays: toLookbackPeriod(options.LookbackDays),
+		}
+
+		for {
+			output, err := s.client.GetReservationPurchaseRecommendation(ctx, input)
+			if err != nil {
+				if isDataUnavailable(err) {
+					break
+				}
+
+				return nil, fmt.Errorf("failed to get reservation purchase recommendation for %s: %w", serviceName, err)
+			}
+
+			for _, recommendation := range output.Recommendations {
+				for _, detail := range recommendation.RecommendationDetails {
+					count, _ := strconv.Atoi(aws.ToString(detail.RecommendedNumberOfInstancesToPurchase))
+
+					results = append(results, model.PurchaseRecommendationInfo{
+						CommitmentType:          model.CommitmentTypeReservedInstance,
+						Service:                 serviceName,
+						Description:             describeInstanceDetails(detail.InstanceDetails),
+						InstanceCount:           count,
+						UpfrontCost:             parseAmount(detail.UpfrontCost),
+						EstimatedMonthlySavings: parseAmount(detail.EstimatedMonthlySavingsAmount),
+						EstimatedSavingsPercent: parseAmount(detail.EstimatedMonthlySavingsPercentage),
+						BreakEvenMonths:         parseAmount(detail.EstimatedBreakEvenInMonths),
+						Currency:                aws.ToString(detail.CurrencyCode),
+					})
+				}
+			}
+
+			if output.NextPageToken == nil {
+				break
+			}
+
+			input.NextPageToken = output.NextPageToken
+		}
+	}
+
+	sortRecommendationsBySavings(results)
+
+	return results, nil
+}
+
+// GetRightsizingRecommendations returns Cost Explorer EC2 rightsizing recommendations,
+// both for instances that should be downsized and instances that should be terminated.
+func (s *service) GetRightsizingRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error) {
+	var results []model.RightsizingRecommendationInfo
+
+	input := &costexplorer.GetRightsizingRecommendationInput{
+		Service: aws.String("AmazonEC2"),
+		Configuration: &types.RightsizingRecommendationConfiguration{
+			BenefitsConsidered:   true,
+			RecommendationTarget: types.RecommendationTargetSameInstanceFamily,
+		},
+	}
+
+	for {
+		output, err := s.client.GetRightsizingRecommendation(ctx, input)
+		if err != nil {
+			if isDataUnavailable(err) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("failed to get rightsizing recommendation: %w", err)
+		}
+
+		for _, recommendation := range output.RightsizingRecommendations {
+			results = append(results, rightsizingRecommendationToInfo(recommendation))
+		}
+
+		if output.NextPageToken == nil {
+			break
+		}
+
+		input.NextPageToken = output.NextPageToken
+	}
+
+	return results, nil
+}
+
+func (s *service) getLookbackTimePeriod(now time.Time, lookbackDays int) *types.DateInterval {
+	return &types.DateInterval{
+		Start: aws.String(now.AddDate(0, 0, -lookbackDays).Format("2006-01-02")),
+		End:   aws.String(now.Format("2006-01-02")),
+	}
+}
+
+func classifySavingsPlans(plans []model.CommitmentUtilizationInfo, utilizationThreshold float64, expiryDays int) ([]model.CommitmentUtilizationInfo, []model.CommitmentUtilizationInfo) {
+	var underutilized, expiring []model.CommitmentUtilizationInfo
+
+	for _, plan := range plans {
+		if plan.UtilizationPercent < utilizationThreshold {
+			underutilized = append(underutilized, plan)
+		}
+
+		if !plan.EndDate.IsZero() && plan.DaysUntilExpiry >= 0 && plan.DaysUntilExpiry <= expiryDays {
+			expiring = append(expiring, plan)
+		}
+	}
+
+	return underutilized, expiring
+}
+
+func toTermInYears(years int) types.TermInYears {
+	if years == 3 {
+		return types.TermInYearsThreeYears
+	}
+
+	return types.TermInYearsOneYear
+}
+
+func toPaymentOption(option string) types.PaymentOption {
+	switch option {
+	case model.PaymentOptionPartialUpfront:
+		return types.PaymentOptionPartialUpfront
+	case model.PaymentOptionAllUpfront:
+		return types.PaymentOptionAllUpfront
+	default:
+		return types.PaymentOptionNoUpfront
+	}
+}
+
+func toLookbackPeriod(days int) types.LookbackPeriodInDays {
+	switch days {
+	case 7:
+		return types.LookbackPeriodInDaysSevenDays
+	case 60:
+		return types.LookbackPeriodInDaysSixtyDays
+	default:
+		return types.LookbackPeriodInDaysThirtyDays
+	}
+}
+
+// breakEvenMonths returns the number of months of savings needed to recover the upfront cost,
+// or model.BreakEvenNever when there is an upfront cost but no savings to recover it.
+func breakEvenMonths(upfrontCost, monthlySavings float64) float64 {
+	if upfrontCost <= 0 {
+		return 0
+	}
+
+	if monthlySavings <= 0 {
+		return model.BreakEvenNever
+	}
+
+	return upfrontCost / monthlySavings
+}
+
+func describeInstanceDetails(details *types.InstanceDetails) string {
+	if details == nil {
+		return ""
+	}
+
+	var values []string
+
+	switch {
+	case details.EC2InstanceDetails != nil:
+		values = nonEmpty(
+			aws.ToString(details.EC2InstanceDetails.InstanceType),
+			aws.ToString(details.EC2InstanceDetails.Platform),
+			aws.ToString(details.EC2InstanceDetails.Region),
+		)
+	case details.RDSInstanceDetails != nil:
+		values = nonEmpty(
+			aws.ToString(details.RDSInstanceDetails.InstanceType),
+			aws.ToString(details.RDSInstanceDetails.DatabaseEngine),
+			aws.ToString(details.RDSInstanceDetails.Region),
+		)
+	case details.ElastiCacheInstanceDetails != nil:
+		values = nonEmpty(
+			aws.ToString(details.ElastiCacheInstanceDetails.NodeType),
+			aws.ToString(details.ElastiCacheInstanceDetails.ProductDescription),
+			aws.ToString(details.ElastiCacheInstanceDetails.Region),
+		)
+	}
+
+	return strings.Join(values, " / ")
+}
+
+func rightsizingRecommendationToInfo(recommendation types.RightsizingRecommendation) model.RightsizingRecommendationInfo {
+	info := model.RightsizingRecommendationInfo{
+		Source:       model.RightsizingSourceCostExplorer,
+		ResourceType: model.RightsizingResourceEC2Instance,
+		Finding:      "Terminate",
+	}
+
+	if current := recommendation.CurrentInstance; current != nil {
+		info.ResourceID = aws.ToString(current.ResourceId)
+		info.Currency = aws.ToString(current.CurrencyCode)
+
+		if current.ResourceDetails != nil && current.ResourceDetails.EC2ResourceDetails != nil {
+			info.CurrentConfiguration = aws.ToString(current.ResourceDetails.EC2ResourceDetails.InstanceType)
+		}
+	}
+
+	if recommendation.RightsizingType == types.RightsizingTypeTerminate {
+		if detail := recommendation.TerminateRecommendationDetail; detail != nil {
+			info.EstimatedMonthlySavings = parseAmount(detail.EstimatedMonthlySavings)
+		}
+
+		return info
+	}
+
+	info.Finding = "Modify"
+
+	if recommendation.ModifyRecommendationDetail == nil || len(recommendation.ModifyRecommendationDetail.TargetInstances) == 0 {
+		return info
+	}
+
+	targets := recommendation.ModifyRecommendationDetail.TargetInstances
+	target := targets[0]
+
+	for _, candidate := range targets {
+		if candidate.DefaultTargetInstance {
+			target = candidate
+			break
+		}
+	}
+
+	info.EstimatedMonthlySavings = parseAmount(target.EstimatedMonthlySavings)
+
+	if target.ResourceDetails != nil && target.ResourceDetails.EC2ResourceDetails != nil {
+		info.RecommendedConfiguration = aws.ToString(target.ResourceDetails.EC2ResourceDetails.InstanceType)
+	}
+
+	if target.ExpectedResourceUtilization != nil && target.ExpectedResourceUtilization.EC2ResourceUtilization != nil {
+		utilization := target.ExpectedResourceUtilization.EC2ResourceUtilization
+		info.ProjectedCPUUtilization = parseAmount(utilization.MaxCpuUtilizationPercentage)
+		info.ProjectedMemoryUtilization = parseAmount(utilization.MaxMemoryUtilizationPercentage)
+	}
+
+	return info
+}
+
+func sortRecommendationsBySavings(recommendations []model.PurchaseRecommendationInfo) {
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].EstimatedMonthlySavings > recommendations[j].EstimatedMonthlySavings
+	})
+}
+
+func nonEmpty(values ...string) []string {
+	var result []string
+
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func setEndDate(info *model.CommitmentUtilizationInfo, endDate string, now time.Time) {
+	parsed, err := time.Parse(time.RFC3339, endDate)
+	if err != nil {
+		return
+	}
+
+	info.EndDate = parsed
+	info.DaysUntilExpiry = int(parsed.Sub(now).Hours() / 24)
+}
+
+// getAttribute looks up a Cost Explorer attribute ignoring case, as reservation and
+// Savings Plans responses use different casing for the same keys.
+func getAttribute(attributes map[string]string, key string) string {
+	if value, ok := attributes[key]; ok {
+		return value
+	}
+
+	for k, value := range attributes {
+		if strings.EqualFold(k, key) {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func joinAttributes(attributes map[string]string, keys ...string) string {
+	values := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		values = append(values, getAttribute(attributes, key))
+	}
+
+	return strings.Join(nonEmpty(values...), " / ")
+}
+
+func parseAmount(amount *string) float64 {
+	value, err := strconv.ParseFloat(aws.ToString(amount), 64)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
+
+func isDataUnavailable(err error) bool {
+	var dataUnavailable *types.DataUnavailableException
+
+	return errors.As(err, &dataUnavailable)
+}
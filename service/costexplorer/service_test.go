@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/elC0mpa/aws-doctor/model"
 )
 
 const costsAggregation = "UnblendedCost"
@@ -416,3 +417,332 @@ func TestDateHelpers_Consistency(t *testing.T) {
 		}
 	}
 }
+
+func TestGetLookbackTimePeriod(t *testing.T) {
+	s := &service{}
+
+	now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	got := s.getLookbackTimePeriod(now, 30)
+
+	if aws.ToString(got.Start) != "2024-02-14" {
+		t.Errorf("Start = %v, want 2024-02-14", aws.ToString(got.Start))
+	}
+
+	if aws.ToString(got.End) != "2024-03-15" {
+		t.Errorf("End = %v, want 2024-03-15", aws.ToString(got.End))
+	}
+}
+
+func TestGetForecastTimePeriod(t *testing.T) {
+	s := &service{}
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		wantStart string
+		wantEnd   string
+		wantOK    bool
+	}{
+		{name: "mid_month", now: time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC), wantStart: "2024-03-15", wantEnd: "2024-04-01", wantOK: true},
+		{name: "end_of_january", now: time.Date(2024, 1, 30, 23, 0, 0, 0, time.UTC), wantStart: "2024-01-30", wantEnd: "2024-02-01", wantOK: true},
+		{name: "december", now: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC), wantStart: "2024-12-01", wantEnd: "2025-01-01", wantOK: true},
+		{name: "last_day_of_month", now: time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := s.getForecastTimePeriod(tt.now)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+
+			if !ok {
+				return
+			}
+
+			if aws.ToString(got.Start) != tt.wantStart || aws.ToString(got.End) != tt.wantEnd {
+				t.Errorf("period = %s to %s, want %s to %s", aws.ToString(got.Start), aws.ToString(got.End), tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestGetAttribute(t *testing.T) {
+	attributes := map[string]string{
+		"endDateTime":      "2024-06-01T00:00:00Z",
+		"SavingsPlansType": "ComputeSavingsPlans",
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{name: "exact_match", key: "endDateTime", want: "2024-06-01T00:00:00Z"},
+		{name: "case_insensitive_match", key: "EndDateTime", want: "2024-06-01T00:00:00Z"},
+		{name: "savings_plan_key", key: "savingsPlansType", want: "ComputeSavingsPlans"},
+		{name: "missing_key", key: "region", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getAttribute(attributes, tt.key); got != tt.want {
+				t.Errorf("getAttribute(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinAttributes(t *testing.T) {
+	attributes := map[string]string{
+		"instanceType": "m5.large",
+		"region":       "US East (N. Virginia)",
+	}
+
+	if got := joinAttributes(attributes, "instanceType", "platform", "region"); got != "m5.large / US East (N. Virginia)" {
+		t.Errorf("joinAttributes() = %q, want 'm5.large / US East (N. Virginia)'", got)
+	}
+
+	if got := joinAttributes(nil, "instanceType"); got != "" {
+		t.Errorf("joinAttributes(nil) = %q, want empty", got)
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount *string
+		want   float64
+	}{
+		{name: "valid_amount", amount: aws.String("87.5"), want: 87.5},
+		{name: "nil_amount", amount: nil, want: 0},
+		{name: "invalid_amount", amount: aws.String("n/a"), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseAmount(tt.amount); got != tt.want {
+				t.Errorf("parseAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetEndDate(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	var info model.CommitmentUtilizationInfo
+
+	setEndDate(&info, "2024-03-11T00:00:00.000Z", now)
+
+	if info.DaysUntilExpiry != 10 {
+		t.Errorf("DaysUntilExpiry = %d, want 10", info.DaysUntilExpiry)
+	}
+
+	var invalid model.CommitmentUtilizationInfo
+
+	setEndDate(&invalid, "", now)
+
+	if !invalid.EndDate.IsZero() {
+		t.Error("EndDate should stay zero for an unparsable date")
+	}
+}
+
+func TestClassifySavingsPlans(t *testing.T) {
+	plans := []model.CommitmentUtilizationInfo{
+		{ID: "sp-healthy", UtilizationPercent: 99, EndDate: time.Now().AddDate(1, 0, 0), DaysUntilExpiry: 365},
+		{ID: "sp-underutilized", UtilizationPercent: 40, EndDate: time.Now().AddDate(1, 0, 0), DaysUntilExpiry: 365},
+		{ID: "sp-expiring", UtilizationPercent: 95, EndDate: time.Now().AddDate(0, 0, 10), DaysUntilExpiry: 10},
+		{ID: "sp-both", UtilizationPercent: 10, EndDate: time.Now().AddDate(0, 0, 5), DaysUntilExpiry: 5},
+		{ID: "sp-unknown-end", UtilizationPercent: 90},
+	}
+
+	underutilized, expiring := classifySavingsPlans(plans, 80, 30)
+
+	if len(underutilized) != 2 || underutilized[0].ID != "sp-underutilized" || underutilized[1].ID != "sp-both" {
+		t.Errorf("underutilized = %+v, want sp-underutilized and sp-both", underutilized)
+	}
+
+	if len(expiring) != 2 || expiring[0].ID != "sp-expiring" || expiring[1].ID != "sp-both" {
+		t.Errorf("expiring = %+v, want sp-expiring and sp-both", expiring)
+	}
+}
+
+func TestRecommendationOptionConversions(t *testing.T) {
+	if got := toTermInYears(3); got != types.TermInYearsThreeYears {
+		t.Errorf("toTermInYears(3) = %v, want THREE_YEARS", got)
+	}
+
+	if got := toTermInYears(1); got != types.TermInYearsOneYear {
+		t.Errorf("toTermInYears(1) = %v, want ONE_YEAR", got)
+	}
+
+	if got := toPaymentOption(model.PaymentOptionPartialUpfront); got != types.PaymentOptionPartialUpfront {
+		t.Errorf("toPaymentOption(partial-upfront) = %v, want PARTIAL_UPFRONT", got)
+	}
+
+	if got := toPaymentOption(model.PaymentOptionAllUpfront); got != types.PaymentOptionAllUpfront {
+		t.Errorf("toPaymentOption(all-upfront) = %v, want ALL_UPFRONT", got)
+	}
+
+	if got := toPaymentOption(model.PaymentOptionNoUpfront); got != types.PaymentOptionNoUpfront {
+		t.Errorf("toPaymentOption(no-upfront) = %v, want NO_UPFRONT", got)
+	}
+
+	if got := toLookbackPeriod(7); got != types.LookbackPeriodInDaysSevenDays {
+		t.Errorf("toLookbackPeriod(7) = %v, want SEVEN_DAYS", got)
+	}
+
+	if got := toLookbackPeriod(60); got != types.LookbackPeriodInDaysSixtyDays {
+		t.Errorf("toLookbackPeriod(60) = %v, want SIXTY_DAYS", got)
+	}
+
+	if got := toLookbackPeriod(30); got != types.LookbackPeriodInDaysThirtyDays {
+		t.Errorf("toLookbackPeriod(30) = %v, want THIRTY_DAYS", got)
+	}
+}
+
+func TestBreakEvenMonths(t *testing.T) {
+	tests := []struct {
+		name           string
+		upfrontCost    float64
+		monthlySavings float64
+		want           float64
+	}{
+		{name: "partial_upfront", upfrontCost: 1200, monthlySavings: 100, want: 12},
+		{name: "no_upfront", upfrontCost: 0, monthlySavings: 100, want: 0},
+		{name: "no_savings", upfrontCost: 1200, monthlySavings: 0, want: model.BreakEvenNever},
+		{name: "negative_savings", upfrontCost: 1200, monthlySavings: -10, want: model.BreakEvenNever},
+		{name: "no_upfront_no_savings", upfrontCost: 0, monthlySavings: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := breakEvenMonths(tt.upfrontCost, tt.monthlySavings); got != tt.want {
+				t.Errorf("breakEvenMonths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescribeInstanceDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		details *types.InstanceDetails
+		want    string
+	}{
+		{
+			name: "ec2",
+			details: &types.InstanceDetails{EC2InstanceDetails: &types.EC2InstanceDetails{
+				InstanceType: aws.String("m5.large"),
+				Platform:     aws.String("Linux/UNIX"),
+				Region:       aws.String("us-east-1"),
+			}},
+			want: "m5.large / Linux/UNIX / us-east-1",
+		},
+		{
+			name: "rds",
+			details: &types.InstanceDetails{RDSInstanceDetails: &types.RDSInstanceDetails{
+				InstanceType:   aws.String("db.r5.large"),
+				DatabaseEngine: aws.String("MySQL"),
+			}},
+			want: "db.r5.large / MySQL",
+		},
+		{
+			name: "elasticache",
+			details: &types.InstanceDetails{ElastiCacheInstanceDetails: &types.ElastiCacheInstanceDetails{
+				NodeType:           aws.String("cache.r6g.large"),
+				ProductDescription: aws.String("redis"),
+				Region:             aws.String("eu-west-1"),
+			}},
+			want: "cache.r6g.large / redis / eu-west-1",
+		},
+		{name: "nil", details: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeInstanceDetails(tt.details); got != tt.want {
+				t.Errorf("describeInstanceDetails() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortRecommendationsBySavings(t *testing.T) {
+	recommendations := []model.PurchaseRecommendationInfo{
+		{Service: "low", EstimatedMonthlySavings: 10},
+		{Service: "high", EstimatedMonthlySavings: 300},
+		{Service: "mid", EstimatedMonthlySavings: 50},
+	}
+
+	sortRecommendationsBySavings(recommendations)
+
+	if recommendations[0].Service != "high" || recommendations[2].Service != "low" {
+		t.Errorf("sortRecommendationsBySavings() order = %s, %s, %s, want high, mid, low",
+			recommendations[0].Service, recommendations[1].Service, recommendations[2].Service)
+	}
+}
+
+func TestRightsizingRecommendationToInfo(t *testing.T) {
+	currentInstance := &types.CurrentInstance{
+		ResourceId:   aws.String("i-0abc"),
+		CurrencyCode: aws.String("USD"),
+		ResourceDetails: &types.ResourceDetails{
+			EC2ResourceDetails: &types.EC2ResourceDetails{InstanceType: aws.String("m5.2xlarge")},
+		},
+	}
+
+	t.Run("terminate", func(t *testing.T) {
+		info := rightsizingRecommendationToInfo(types.RightsizingRecommendation{
+			CurrentInstance:               currentInstance,
+			RightsizingType:               types.RightsizingTypeTerminate,
+			TerminateRecommendationDetail: &types.TerminateRecommendationDetail{EstimatedMonthlySavings: aws.String("280.32")},
+		})
+
+		if info.Finding != "Terminate" || info.RecommendedConfiguration != "" {
+			t.Errorf("Finding = %q, Recommended = %q, want Terminate with no recommended type", info.Finding, info.RecommendedConfiguration)
+		}
+
+		if info.EstimatedMonthlySavings != 280.32 {
+			t.Errorf("EstimatedMonthlySavings = %v, want 280.32", info.EstimatedMonthlySavings)
+		}
+	})
+
+	t.Run("modify_uses_default_target", func(t *testing.T) {
+		info := rightsizingRecommendationToInfo(types.RightsizingRecommendation{
+			CurrentInstance: currentInstance,
+			RightsizingType: types.RightsizingTypeModify,
+			ModifyRecommendationDetail: &types.ModifyRecommendationDetail{
+				TargetInstances: []types.TargetInstance{
+					{
+						EstimatedMonthlySavings: aws.String("50"),
+						ResourceDetails:         &types.ResourceDetails{EC2ResourceDetails: &types.EC2ResourceDetails{InstanceType: aws.String("m5.large")}},
+					},
+					{
+						DefaultTargetInstance:   true,
+						EstimatedMonthlySavings: aws.String("140"),
+						ResourceDetails:         &types.ResourceDetails{EC2ResourceDetails: &types.EC2ResourceDetails{InstanceType: aws.String("m5.xlarge")}},
+						ExpectedResourceUtilization: &types.ResourceUtilization{EC2ResourceUtilization: &types.EC2ResourceUtilization{
+							MaxCpuUtilizationPercentage:    aws.String("55.5"),
+							MaxMemoryUtilizationPercentage: aws.String("40"),
+						}},
+					},
+				},
+			},
+		})
+
+		if info.Source != model.RightsizingSourceCostExplorer || info.ResourceID != "i-0abc" {
+			t.Errorf("Source/ResourceID = %s/%s, want cost_explorer/i-0abc", info.Source, info.ResourceID)
+		}
+
+		if info.CurrentConfiguration != "m5.2xlarge" || info.RecommendedConfiguration != "m5.xlarge" {
+			t.Errorf("Configuration = %s -> %s, want m5.2xlarge -> m5.xlarge", info.CurrentConfiguration, info.RecommendedConfiguration)
+		}
+
+		if info.EstimatedMonthlySavings != 140 || info.ProjectedCPUUtilization != 55.5 || info.ProjectedMemoryUtilization != 40 {
+			t.Errorf("Savings/CPU/Memory = %v/%v/%v, want 140/55.5/40", info.EstimatedMonthlySavings, info.ProjectedCPUUtilization, info.ProjectedMemoryUtilization)
+		}
+	})
+}
@@ -20,4 +20,12 @@ type Service interface {
 	GetCurrentMonthTotalCosts(ctx context.Context) (*string, error)
 	GetLastMonthTotalCosts(ctx context.Context) (*string, error)
 	GetLastSixMonthsCosts(ctx context.Context) ([]model.CostInfo, error)
+	GetCurrentMonthForecast(ctx context.Context) (*model.CostForecast, error)
+	GetReservationSummary(ctx context.Context, lookbackDays int) (*model.CommitmentSummaryInfo, error)
+	GetSavingsPlansSummary(ctx context.Context, lookbackDays int) (*model.CommitmentSummaryInfo, error)
+	GetUnderutilizedReservations(ctx context.Context, lookbackDays int, utilizationThreshold float64) ([]model.CommitmentUtilizationInfo, error)
+	GetRightsizingRecommendations(ctx context.Context) ([]model.RightsizingRecommendationInfo, error)
+	GetSavingsPlansPurchaseRecommendations(ctx context.Context, options model.RecommendationOptions) ([]model.PurchaseRecommendationInfo, error)
+	GetReservationPurchaseRecommendations(ctx context.Context, options model.RecommendationOptions) ([]model.PurchaseRecommendationInfo, error)
+	GetSavingsPlansCommitments(ctx context.Context, lookbackDays int, utilizationThreshold float64, expiryDays int) ([]model.CommitmentUtilizationInfo, []model.CommitmentUtilizationInfo, error)
 }
@@ -3,13 +3,20 @@ package awscostexplorer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	astypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/elC0mpa/aws-doctor/model"
 	"github.com/elC0mpa/aws-doctor/utils"
 )
@@ -19,9 +26,11 @@ const ebsSnapshotCostPerGBMonth = 0.05
 // NewService creates a new EC2 service.
 func NewService(awsconfig aws.Config) Service {
 	client := ec2.NewFromConfig(awsconfig)
+	autoscalingClient := autoscaling.NewFromConfig(awsconfig)
 
 	return &service{
-		client: client,
+		client:            client,
+		autoscalingClient: autoscalingClient,
 	}
 }
 
@@ -237,31 +246,146 @@ func (s *service) GetReservedInstanceExpiringOrExpired30DaysWaste(ctx context.Co
 	return results, nil
 }
 
-// GetUnusedAMIs returns AMIs that are not used by any running or stopped instances.
-// It uses pagination for DescribeImages and adds safety warnings about potential
-// ASG/Launch Template usage.
-func (s *service) GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMIWasteInfo, error) {
-	var results []model.AMIWasteInfo
+// GetUnusedCapacityReservations returns active On-Demand Capacity Reservations that have
+// no running instances or whose utilization is below utilizationThreshold percent.
+// Unused reserved capacity is billed at the On-Demand rate.
+func (s *service) GetUnusedCapacityReservations(ctx context.Context, utilizationThreshold float64) ([]model.CapacityReservationWasteInfo, error) {
+	var results []model.CapacityReservationWasteInfo
 
-	// Get all instances to find which AMIs are in use using pagination
-	amiUsage := make(map[string]int)
+	paginator := ec2.NewDescribeCapacityReservationsPaginator(s.client, &ec2.DescribeCapacityReservationsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []string{"active"},
+			},
+		},
+	})
 
-	instancePaginator := ec2.NewDescribeInstancesPaginator(s.client, &ec2.DescribeInstancesInput{})
-	for instancePaginator.HasMorePages() {
-		page, err := instancePaginator.NextPage(ctx)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to describe instances: %w", err)
+			return nil, fmt.Errorf("failed to describe capacity reservations: %w", err)
 		}
 
-		for _, reservation := range page.Reservations {
-			for _, instance := range reservation.Instances {
-				if instance.ImageId != nil {
-					amiUsage[*instance.ImageId]++
-				}
+		for _, cr := range page.CapacityReservations {
+			if info, ok := classifyCapacityReservation(cr, utilizationThreshold); ok {
+				results = append(results, info)
 			}
 		}
 	}
 
+	return results, nil
+}
+
+// classifyCapacityReservation reports a Capacity Reservation as UNUSED when none of its
+// reserved capacity is in use, or as UNDERUTILIZED when its utilization is below
+// utilizationThreshold percent. AvailableInstanceCount is the unused capacity billed at the
+// On-Demand rate. Reservations with no capacity, or utilized at or above the threshold, are
+// not waste.
+func classifyCapacityReservation(cr types.CapacityReservation, utilizationThreshold float64) (model.CapacityReservationWasteInfo, bool) {
+	total := aws.ToInt32(cr.TotalInstanceCount)
+	available := aws.ToInt32(cr.AvailableInstanceCount)
+
+	if total == 0 {
+		return model.CapacityReservationWasteInfo{}, false
+	}
+
+	utilization := float64(total-available) / float64(total) * 100
+
+	status := "UNDERUTILIZED"
+	if available == total {
+		status = "UNUSED"
+	} else if utilization >= utilizationThreshold {
+		return model.CapacityReservationWasteInfo{}, false
+	}
+
+	return model.CapacityReservationWasteInfo{
+		CapacityReservationID:  aws.ToString(cr.CapacityReservationId),
+		InstanceType:           aws.ToString(cr.InstanceType),
+		InstancePlatform:       string(cr.InstancePlatform),
+		AvailabilityZone:       aws.ToString(cr.AvailabilityZone),
+		TotalInstanceCount:     total,
+		AvailableInstanceCount: available,
+		UtilizationPercent:     utilization,
+		State:                  string(cr.State),
+		Status:                 status,
+	}, true
+}
+
+// GetIdleDedicatedHosts returns allocated Dedicated Hosts that have no instances running
+// on them. Dedicated Hosts are billed for as long as they are allocated.
+func (s *service) GetIdleDedicatedHosts(ctx context.Context) ([]model.DedicatedHostWasteInfo, error) {
+	var results []model.DedicatedHostWasteInfo
+
+	paginator := ec2.NewDescribeHostsPaginator(s.client, &ec2.DescribeHostsInput{
+		Filter: []types.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []string{"available", "under-assessment"},
+			},
+		},
+	})
+
+	now := time.Now()
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe dedicated hosts: %w", err)
+		}
+
+		for _, host := range page.Hosts {
+			if len(host.Instances) > 0 {
+				continue
+			}
+
+			info := model.DedicatedHostWasteInfo{
+				HostID:           aws.ToString(host.HostId),
+				AvailabilityZone: aws.ToString(host.AvailabilityZone),
+				State:            string(host.State),
+			}
+
+			if host.HostProperties != nil {
+				info.InstanceFamily = aws.ToString(host.HostProperties.InstanceFamily)
+				info.InstanceType = aws.ToString(host.HostProperties.InstanceType)
+			}
+
+			if host.AllocationTime != nil {
+				info.AllocationTime = *host.AllocationTime
+				info.DaysSinceAllocation = int(now.Sub(*host.AllocationTime).Hours() / 24)
+			}
+
+			results = append(results, info)
+		}
+	}
+
+	return results, nil
+}
+
+// GetUnusedAMIs returns AMIs that are not used by any running or stopped instances, Auto Scaling
+// group, launch configuration, or default or latest launch template version. AMIs still referenced
+// by other launch template versions are reported with a safety warning naming those versions.
+// References the credentials are not allowed to read are returned as skipped checks, and every
+// AMI is then reported with a safety warning.
+func (s *service) GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMIWasteInfo, []model.SkippedCheck, error) {
+	var results []model.AMIWasteInfo
+
+	amiUsage, err := s.getInstanceAMIUsage(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inUseByTemplates, templateWarnings, unchecked, err := s.getAMIReferences(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var skipped []model.SkippedCheck
+
+	for _, source := range unchecked {
+		skipped = append(skipped, model.SkippedCheck{Name: "AMI references in " + source, Reason: "access denied"})
+	}
+
 	// Get all owned AMIs using pagination
 	amiPaginator := ec2.NewDescribeImagesPaginator(s.client, &ec2.DescribeImagesInput{
 		Owners: []string{"self"},
@@ -273,7 +397,7 @@ func (s *service) GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMI
 	for amiPaginator.HasMorePages() {
 		page, err := amiPaginator.NextPage(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to describe images: %w", err)
+			return nil, nil, fmt.Errorf("failed to describe images: %w", err)
 		}
 
 		for _, image := range page.Images {
@@ -301,30 +425,14 @@ func (s *service) GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMI
 
 			isStale := !creationDate.IsZero() && creationDate.Before(cutoffTime)
 
-			// Consider unused if not used by any instance AND is stale
-			if usageCount == 0 && isStale {
-				// Collect snapshot IDs and sizes
-				var (
-					snapshotIDs       []string
-					totalSnapshotSize int64
-				)
-
-				for _, bdm := range image.BlockDeviceMappings {
-					if bdm.Ebs != nil && bdm.Ebs.SnapshotId != nil {
-						snapshotIDs = append(snapshotIDs, *bdm.Ebs.SnapshotId)
-						if bdm.Ebs.VolumeSize != nil {
-							totalSnapshotSize += int64(*bdm.Ebs.VolumeSize)
-						}
-					}
-				}
+			// Consider unused if not used by any instance or launch template AND is stale
+			if usageCount == 0 && !inUseByTemplates[imageID] && isStale {
+				snapshotIDs, totalSnapshotSize := imageSnapshots(image)
 
 				// EBS Snapshot pricing: ~$0.05 per GB per month
 				// Note: This is max potential savings - actual snapshot billing is incremental
 				maxPotentialSaving := float64(totalSnapshotSize) * 0.05
 
-				// Safety warning: AMI may be used by ASGs or Launch Templates
-				safetyWarning := "Verify before deleting: AMI may be used by Auto Scaling Groups or Launch Templates not currently running instances"
-
 				results = append(results, model.AMIWasteInfo{
 					ImageID:            imageID,
 					Name:               aws.ToString(image.Name),
@@ -336,17 +444,317 @@ func (s *service) GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMI
 					SnapshotSizeGB:     totalSnapshotSize,
 					UsedByInstances:    usageCount,
 					MaxPotentialSaving: maxPotentialSaving,
-					SafetyWarning:      safetyWarning,
+					SafetyWarning:      amiSafetyWarning(templateWarnings[imageID], unchecked),
 				})
 			}
 		}
 	}
 
-	return results, nil
+	return results, skipped, nil
+}
+
+// amiSafetyWarning appends to the launch template warning of an AMI the references that could not
+// be checked, since the AMI may be in use by one of them.
+func amiSafetyWarning(templateWarning string, unchecked []string) string {
+	if len(unchecked) == 0 {
+		return templateWarning
+	}
+
+	warning := "References by " + strings.Join(unchecked, ", ") + " could not be checked"
+	if templateWarning == "" {
+		return warning
+	}
+
+	return templateWarning + "; " + warning
+}
+
+// getInstanceAMIUsage counts the running and stopped instances launched from each AMI.
+func (s *service) getInstanceAMIUsage(ctx context.Context) (map[string]int, error) {
+	amiUsage := make(map[string]int)
+
+	instancePaginator := ec2.NewDescribeInstancesPaginator(s.client, &ec2.DescribeInstancesInput{})
+	for instancePaginator.HasMorePages() {
+		page, err := instancePaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe instances: %w", err)
+		}
+
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.ImageId != nil {
+					amiUsage[*instance.ImageId]++
+				}
+			}
+		}
+	}
+
+	return amiUsage, nil
+}
+
+// imageSnapshots returns the EBS snapshots backing an AMI and their total size in GiB.
+func imageSnapshots(image types.Image) ([]string, int64) {
+	var (
+		snapshotIDs       []string
+		totalSnapshotSize int64
+	)
+
+	for _, bdm := range image.BlockDeviceMappings {
+		if bdm.Ebs != nil && bdm.Ebs.SnapshotId != nil {
+			snapshotIDs = append(snapshotIDs, *bdm.Ebs.SnapshotId)
+			if bdm.Ebs.VolumeSize != nil {
+				totalSnapshotSize += int64(*bdm.Ebs.VolumeSize)
+			}
+		}
+	}
+
+	return snapshotIDs, totalSnapshotSize
+}
+
+// launchTemplateVersions holds the AMI referenced by each version of a launch template.
+type launchTemplateVersions struct {
+	id             string
+	name           string
+	defaultVersion int64
+	latestVersion  int64
+	imageIDs       map[int64]string
+}
+
+// getAMIReferences returns the AMIs in use by Auto Scaling groups, launch configurations and
+// default or latest launch template versions, along with a warning for AMIs only referenced by
+// other launch template versions. Sources the credentials are not allowed to read are returned
+// as unchecked instead of failing the check.
+func (s *service) getAMIReferences(ctx context.Context) (map[string]bool, map[string]string, []string, error) {
+	var unchecked []string
+
+	degrade := func(source string, err error) error {
+		if isAccessDenied(err) {
+			unchecked = append(unchecked, source)
+
+			return nil
+		}
+
+		return err
+	}
+
+	templates, err := s.getLaunchTemplateVersions(ctx)
+	if err = degrade("launch templates", err); err != nil {
+		return nil, nil, nil, err
+	}
+
+	groups, err := s.getAutoScalingGroups(ctx)
+	if err = degrade("Auto Scaling groups", err); err != nil {
+		return nil, nil, nil, err
+	}
+
+	configurations, err := s.getLaunchConfigurations(ctx)
+	if err = degrade("launch configurations", err); err != nil {
+		return nil, nil, nil, err
+	}
+
+	inUse, warnings := findAMIReferences(templates, groups, configurations)
+
+	return inUse, warnings, unchecked, nil
+}
+
+func (s *service) getAutoScalingGroups(ctx context.Context) ([]astypes.AutoScalingGroup, error) {
+	var groups []astypes.AutoScalingGroup
+
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(s.autoscalingClient, &autoscaling.DescribeAutoScalingGroupsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe auto scaling groups: %w", err)
+		}
+
+		groups = append(groups, page.AutoScalingGroups...)
+	}
+
+	return groups, nil
+}
+
+func (s *service) getLaunchConfigurations(ctx context.Context) ([]astypes.LaunchConfiguration, error) {
+	var configurations []astypes.LaunchConfiguration
+
+	paginator := autoscaling.NewDescribeLaunchConfigurationsPaginator(s.autoscalingClient, &autoscaling.DescribeLaunchConfigurationsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe launch configurations: %w", err)
+		}
+
+		configurations = append(configurations, page.LaunchConfigurations...)
+	}
+
+	return configurations, nil
+}
+
+// isAccessDenied reports whether err means the credentials are not allowed to make an EC2 or
+// Auto Scaling call.
+func isAccessDenied(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	switch apiErr.ErrorCode() {
+	case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation":
+		return true
+	default:
+		return false
+	}
+}
+
+// getLaunchTemplateVersions returns every version of every launch template in the region.
+func (s *service) getLaunchTemplateVersions(ctx context.Context) ([]launchTemplateVersions, error) {
+	var templates []launchTemplateVersions
+
+	templatePaginator := ec2.NewDescribeLaunchTemplatesPaginator(s.client, &ec2.DescribeLaunchTemplatesInput{})
+	for templatePaginator.HasMorePages() {
+		page, err := templatePaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe launch templates: %w", err)
+		}
+
+		for _, template := range page.LaunchTemplates {
+			versions := launchTemplateVersions{
+				id:             aws.ToString(template.LaunchTemplateId),
+				name:           aws.ToString(template.LaunchTemplateName),
+				defaultVersion: aws.ToInt64(template.DefaultVersionNumber),
+				latestVersion:  aws.ToInt64(template.LatestVersionNumber),
+				imageIDs:       make(map[int64]string),
+			}
+
+			versionPaginator := ec2.NewDescribeLaunchTemplateVersionsPaginator(s.client, &ec2.DescribeLaunchTemplateVersionsInput{
+				LaunchTemplateId: template.LaunchTemplateId,
+			})
+			for versionPaginator.HasMorePages() {
+				versionPage, err := versionPaginator.NextPage(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to describe launch template versions: %w", err)
+				}
+
+				for _, version := range versionPage.LaunchTemplateVersions {
+					if version.LaunchTemplateData != nil {
+						versions.imageIDs[aws.ToInt64(version.VersionNumber)] = aws.ToString(version.LaunchTemplateData.ImageId)
+					}
+				}
+			}
+
+			templates = append(templates, versions)
+		}
+	}
+
+	return templates, nil
+}
+
+// findAMIReferences marks the AMIs referenced by launch configurations, by the default and latest
+// version of each launch template, and by the launch template versions Auto Scaling groups use,
+// including those of mixed instances policies. AMIs referenced only by other launch template
+// versions get a warning listing those versions instead.
+func findAMIReferences(templates []launchTemplateVersions, groups []astypes.AutoScalingGroup, configurations []astypes.LaunchConfiguration) (map[string]bool, map[string]string) {
+	inUse := make(map[string]bool)
+
+	for _, configuration := range configurations {
+		inUse[aws.ToString(configuration.ImageId)] = true
+	}
+
+	usedVersions := make(map[string]map[int64]bool)
+
+	for _, template := range templates {
+		usedVersions[template.id] = map[int64]bool{template.defaultVersion: true, template.latestVersion: true}
+	}
+
+	for _, group := range groups {
+		for _, specification := range groupLaunchTemplates(group) {
+			if specification == nil {
+				continue
+			}
+
+			for _, template := range templates {
+				if template.id != aws.ToString(specification.LaunchTemplateId) && template.name != aws.ToString(specification.LaunchTemplateName) {
+					continue
+				}
+
+				if version, ok := resolveLaunchTemplateVersion(template, aws.ToString(specification.Version)); ok {
+					usedVersions[template.id][version] = true
+				}
+			}
+		}
+	}
+
+	for _, template := range templates {
+		for version := range usedVersions[template.id] {
+			if imageID := template.imageIDs[version]; imageID != "" {
+				inUse[imageID] = true
+			}
+		}
+	}
+
+	return inUse, unusedVersionWarnings(templates, usedVersions, inUse)
+}
+
+// groupLaunchTemplates returns the launch templates an Auto Scaling group specifies directly and
+// through its mixed instances policy. Unset specifications are nil.
+func groupLaunchTemplates(group astypes.AutoScalingGroup) []*astypes.LaunchTemplateSpecification {
+	specifications := []*astypes.LaunchTemplateSpecification{group.LaunchTemplate}
+
+	if policy := group.MixedInstancesPolicy; policy != nil && policy.LaunchTemplate != nil {
+		specifications = append(specifications, policy.LaunchTemplate.LaunchTemplateSpecification)
+
+		for _, override := range policy.LaunchTemplate.Overrides {
+			specifications = append(specifications, override.LaunchTemplateSpecification)
+		}
+	}
+
+	return specifications
+}
+
+// unusedVersionWarnings lists, for every AMI not otherwise in use, the launch template versions
+// that still reference it.
+func unusedVersionWarnings(templates []launchTemplateVersions, usedVersions map[string]map[int64]bool, inUse map[string]bool) map[string]string {
+	unusedVersions := make(map[string][]string)
+
+	for _, template := range templates {
+		for _, version := range slices.Sorted(maps.Keys(template.imageIDs)) {
+			imageID := template.imageIDs[version]
+			if imageID == "" || inUse[imageID] || usedVersions[template.id][version] {
+				continue
+			}
+
+			unusedVersions[imageID] = append(unusedVersions[imageID], fmt.Sprintf("%s (%s) version %d", template.name, template.id, version))
+		}
+	}
+
+	warnings := make(map[string]string, len(unusedVersions))
+
+	for imageID, versions := range unusedVersions {
+		warnings[imageID] = "Referenced by launch template " + strings.Join(versions, ", ")
+	}
+
+	return warnings
+}
+
+// resolveLaunchTemplateVersion turns the version an Auto Scaling group specifies into a version
+// number. An empty version means the default version.
+func resolveLaunchTemplateVersion(template launchTemplateVersions, version string) (int64, bool) {
+	switch version {
+	case "", "$Default":
+		return template.defaultVersion, true
+	case "$Latest":
+		return template.latestVersion, true
+	}
+
+	number, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
 }
 
 // GetOrphanedSnapshots returns EBS snapshots that are potentially orphaned
-// (source volume deleted, not used by any AMI, or older than staleDays)
+// (source volume deleted, not used by any AMI, or older than staleDays). Snapshots created by
+// AWS Backup are left to the Backup recovery point check.
 func (s *service) GetOrphanedSnapshots(ctx context.Context, staleDays int) ([]model.SnapshotWasteInfo, error) {
 	var results []model.SnapshotWasteInfo
 
@@ -422,6 +830,13 @@ func (s *service) GetOrphanedSnapshots(ctx context.Context, staleDays int) ([]mo
 			continue
 		}
 
+		// AWS Backup snapshots are reported with the Backup recovery points, so skip them
+		// to avoid counting their cost twice
+		managedBy := snapshotManagedBy(snapshot)
+		if managedBy == model.SnapshotManagedByBackup {
+			continue
+		}
+
 		sizeGB := int32(0)
 		if snapshot.VolumeSize != nil {
 			sizeGB = *snapshot.VolumeSize
@@ -447,6 +862,7 @@ func (s *service) GetOrphanedSnapshots(ctx context.Context, staleDays int) ([]mo
 				Category:            model.SnapshotCategoryOrphaned,
 				Reason:              "Volume Deleted",
 				MaxPotentialSavings: maxPotentialSavings,
+				ManagedBy:           managedBy,
 			})
 		} else if startTime.Before(cutoffTime) {
 			// Stale: Volume exists but snapshot is old - needs review (low confidence)
@@ -463,6 +879,7 @@ func (s *service) GetOrphanedSnapshots(ctx context.Context, staleDays int) ([]mo
 				Category:            model.SnapshotCategoryStale,
 				Reason:              "Old Backup",
 				MaxPotentialSavings: maxPotentialSavings,
+				ManagedBy:           managedBy,
 			})
 		}
 	}
@@ -470,6 +887,33 @@ func (s *service) GetOrphanedSnapshots(ctx context.Context, staleDays int) ([]mo
 	return results, nil
 }
 
+// snapshotManagedBy identifies the tool that created a snapshot from the tags and description
+// AWS Backup and Data Lifecycle Manager attach to it. Snapshots created by those tools are
+// deleted by their retention rules and should be cleaned up there rather than directly.
+func snapshotManagedBy(snapshot types.Snapshot) string {
+	for _, tag := range snapshot.Tags {
+		key := aws.ToString(tag.Key)
+
+		switch {
+		case strings.HasPrefix(key, "aws:backup:"):
+			return model.SnapshotManagedByBackup
+		case strings.HasPrefix(key, "aws:dlm:"):
+			return model.SnapshotManagedByDLM
+		}
+	}
+
+	description := aws.ToString(snapshot.Description)
+
+	switch {
+	case strings.Contains(description, "created by the AWS Backup service"):
+		return model.SnapshotManagedByBackup
+	case strings.HasPrefix(description, "Created for policy: policy-"):
+		return model.SnapshotManagedByDLM
+	}
+
+	return ""
+}
+
 func (s *service) getResourceTypeFromDescription(description string) types.NetworkInterfaceType {
 	desc := strings.ToLower(description)
 
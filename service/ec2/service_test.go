@@ -1,9 +1,15 @@
 package awscostexplorer
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	astypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/elC0mpa/aws-doctor/model"
 )
 
 func TestGetResourceTypeFromDescription(t *testing.T) {
@@ -271,3 +277,254 @@ func BenchmarkGetResourceTypeFromDescription(b *testing.B) {
 		}
 	}
 }
+
+func TestSnapshotManagedBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot types.Snapshot
+		want     string
+	}{
+		{
+			name: "backup_tag",
+			snapshot: types.Snapshot{
+				Tags: []types.Tag{{Key: aws.String("aws:backup:source-resource"), Value: aws.String("vol-123")}},
+			},
+			want: model.SnapshotManagedByBackup,
+		},
+		{
+			name:     "backup_description",
+			snapshot: types.Snapshot{Description: aws.String("This snapshot is created by the AWS Backup service.")},
+			want:     model.SnapshotManagedByBackup,
+		},
+		{
+			name: "dlm_tag",
+			snapshot: types.Snapshot{
+				Tags: []types.Tag{{Key: aws.String("aws:dlm:lifecycle-policy-id"), Value: aws.String("policy-123")}},
+			},
+			want: model.SnapshotManagedByDLM,
+		},
+		{
+			name:     "dlm_description",
+			snapshot: types.Snapshot{Description: aws.String("Created for policy: policy-0123456789abcdef schedule: Default Schedule")},
+			want:     model.SnapshotManagedByDLM,
+		},
+		{
+			name: "manual",
+			snapshot: types.Snapshot{
+				Description: aws.String("before upgrade"),
+				Tags:        []types.Tag{{Key: aws.String("Name"), Value: aws.String("db-data")}},
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapshotManagedBy(tt.snapshot); got != tt.want {
+				t.Errorf("snapshotManagedBy() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyCapacityReservation(t *testing.T) {
+	reservation := func(total, available int32) types.CapacityReservation {
+		return types.CapacityReservation{
+			CapacityReservationId:  aws.String("cr-123"),
+			InstanceType:           aws.String("m5.large"),
+			TotalInstanceCount:     aws.Int32(total),
+			AvailableInstanceCount: aws.Int32(available),
+		}
+	}
+
+	tests := []struct {
+		name            string
+		reservation     types.CapacityReservation
+		wantOK          bool
+		wantStatus      string
+		wantAvailable   int32
+		wantUtilization float64
+	}{
+		{
+			name:            "unused",
+			reservation:     reservation(4, 4),
+			wantOK:          true,
+			wantStatus:      "UNUSED",
+			wantAvailable:   4,
+			wantUtilization: 0,
+		},
+		{
+			name:            "underutilized",
+			reservation:     reservation(4, 3),
+			wantOK:          true,
+			wantStatus:      "UNDERUTILIZED",
+			wantAvailable:   3,
+			wantUtilization: 25,
+		},
+		{
+			name:        "exactly_at_threshold",
+			reservation: reservation(4, 2),
+			wantOK:      false,
+		},
+		{
+			name:        "above_threshold",
+			reservation: reservation(4, 1),
+			wantOK:      false,
+		},
+		{
+			name:        "no_capacity",
+			reservation: reservation(0, 0),
+			wantOK:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := classifyCapacityReservation(tt.reservation, 50)
+			if ok != tt.wantOK {
+				t.Fatalf("classifyCapacityReservation() ok = %v, want %v", ok, tt.wantOK)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got.Status != tt.wantStatus || got.AvailableInstanceCount != tt.wantAvailable || got.UtilizationPercent != tt.wantUtilization {
+				t.Errorf("classifyCapacityReservation() = %s with %d available at %.0f%%, want %s with %d available at %.0f%%",
+					got.Status, got.AvailableInstanceCount, got.UtilizationPercent, tt.wantStatus, tt.wantAvailable, tt.wantUtilization)
+			}
+		})
+	}
+}
+
+func TestFindAMIReferences(t *testing.T) {
+	templates := []launchTemplateVersions{
+		{
+			id:             "lt-web",
+			name:           "web",
+			defaultVersion: 3,
+			latestVersion:  4,
+			imageIDs:       map[int64]string{1: "ami-old", 2: "ami-pinned", 3: "ami-default", 4: "ami-latest"},
+		},
+		{
+			id:             "lt-batch",
+			name:           "batch",
+			defaultVersion: 2,
+			latestVersion:  2,
+			imageIDs:       map[int64]string{1: "ami-mixed", 2: "ami-batch"},
+		},
+	}
+
+	groups := []astypes.AutoScalingGroup{
+		{LaunchTemplate: &astypes.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-web"), Version: aws.String("2")}},
+		{
+			MixedInstancesPolicy: &astypes.MixedInstancesPolicy{
+				LaunchTemplate: &astypes.LaunchTemplate{
+					LaunchTemplateSpecification: &astypes.LaunchTemplateSpecification{LaunchTemplateName: aws.String("batch")},
+					Overrides: []astypes.LaunchTemplateOverrides{
+						{LaunchTemplateSpecification: &astypes.LaunchTemplateSpecification{LaunchTemplateName: aws.String("batch"), Version: aws.String("1")}},
+					},
+				},
+			},
+		},
+	}
+
+	configurations := []astypes.LaunchConfiguration{{ImageId: aws.String("ami-legacy")}}
+
+	inUse, warnings := findAMIReferences(templates, groups, configurations)
+
+	for _, imageID := range []string{"ami-pinned", "ami-default", "ami-latest", "ami-mixed", "ami-batch", "ami-legacy"} {
+		if !inUse[imageID] {
+			t.Errorf("expected %s to be in use", imageID)
+		}
+	}
+
+	if inUse["ami-old"] {
+		t.Error("expected ami-old not to be in use")
+	}
+
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %v", warnings)
+	}
+
+	if want := "Referenced by launch template web (lt-web) version 1"; warnings["ami-old"] != want {
+		t.Errorf("warnings[ami-old] = %q, want %q", warnings["ami-old"], want)
+	}
+}
+
+func TestResolveLaunchTemplateVersion(t *testing.T) {
+	template := launchTemplateVersions{defaultVersion: 2, latestVersion: 5}
+
+	tests := []struct {
+		version string
+		want    int64
+		wantOK  bool
+	}{
+		{version: "", want: 2, wantOK: true},
+		{version: "$Default", want: 2, wantOK: true},
+		{version: "$Latest", want: 5, wantOK: true},
+		{version: "3", want: 3, wantOK: true},
+		{version: "invalid", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, ok := resolveLaunchTemplateVersion(template, tt.version)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("resolveLaunchTemplateVersion(%q) = (%d, %v), want (%d, %v)", tt.version, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAMISafetyWarning(t *testing.T) {
+	tests := []struct {
+		name            string
+		templateWarning string
+		unchecked       []string
+		want            string
+	}{
+		{name: "all_checked", templateWarning: "Referenced by launch template web", want: "Referenced by launch template web"},
+		{
+			name:      "unchecked_sources",
+			unchecked: []string{"Auto Scaling groups", "launch configurations"},
+			want:      "References by Auto Scaling groups, launch configurations could not be checked",
+		},
+		{
+			name:            "both",
+			templateWarning: "Referenced by launch template web",
+			unchecked:       []string{"Auto Scaling groups"},
+			want:            "Referenced by launch template web; References by Auto Scaling groups could not be checked",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := amiSafetyWarning(tt.templateWarning, tt.unchecked); got != tt.want {
+				t.Errorf("amiSafetyWarning() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAccessDenied(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "ec2_unauthorized", err: fmt.Errorf("failed to describe launch templates: %w", &smithy.GenericAPIError{Code: "UnauthorizedOperation"}), want: true},
+		{name: "autoscaling_denied", err: &smithy.GenericAPIError{Code: "AccessDenied"}, want: true},
+		{name: "throttled", err: &smithy.GenericAPIError{Code: "Throttling"}, want: false},
+		{name: "other_error", err: errors.New("connection reset"), want: false},
+		{name: "nil", err: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAccessDenied(tt.err); got != tt.want {
+				t.Errorf("isAccessDenied() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,13 +3,15 @@ package awscostexplorer
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/elC0mpa/aws-doctor/model"
 )
 
 type service struct {
-	client *ec2.Client
+	client            *ec2.Client
+	autoscalingClient *autoscaling.Client
 }
 
 // Service is the interface for AWS EC2 service.
@@ -19,6 +21,8 @@ type Service interface {
 	GetUnusedEBSVolumes(ctx context.Context) ([]types.Volume, error)
 	GetStoppedInstancesInfo(ctx context.Context) ([]types.Instance, []types.Volume, error)
 	GetReservedInstanceExpiringOrExpired30DaysWaste(ctx context.Context) ([]model.RiExpirationInfo, error)
-	GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMIWasteInfo, error)
+	GetUnusedCapacityReservations(ctx context.Context, utilizationThreshold float64) ([]model.CapacityReservationWasteInfo, error)
+	GetIdleDedicatedHosts(ctx context.Context) ([]model.DedicatedHostWasteInfo, error)
+	GetUnusedAMIs(ctx context.Context, staleDays int) ([]model.AMIWasteInfo, []model.SkippedCheck, error)
 	GetOrphanedSnapshots(ctx context.Context, staleDays int) ([]model.SnapshotWasteInfo, error)
 }
@@ -0,0 +1,300 @@
+// Package ecs provides a service for interacting with Amazon Elastic Container Service.
+package ecs
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Load balancer pricing: ~$0.0225 per hour before capacity units
+const (
+	loadBalancerHourlyCost = 0.0225
+	hoursPerMonth          = 730
+)
+
+// DescribeServices accepts at most 10 services per call
+const describeServicesBatchSize = 10
+
+// NewService creates a new ECS service.
+func NewService(awsconfig aws.Config) Service {
+	client := ecs.NewFromConfig(awsconfig)
+	elbClient := elb.NewFromConfig(awsconfig)
+
+	return &service{
+		client:    client,
+		elbClient: elbClient,
+	}
+}
+
+// GetIdleServices returns ECS services with a desired count of zero whose target groups
+// still exist, together with the load balancers those target groups keep alive. A load
+// balancer is only charged when none of its other target groups serves a running service or
+// has registered targets, and only once when several idle services share it.
+func (s *service) GetIdleServices(ctx context.Context) ([]model.ECSServiceWasteInfo, error) {
+	var results []model.ECSServiceWasteInfo
+
+	runningTargetGroups := make(map[string]bool)
+
+	clusterPaginator := ecs.NewListClustersPaginator(s.client, &ecs.ListClustersInput{})
+
+	for clusterPaginator.HasMorePages() {
+		clusterOutput, err := clusterPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, clusterArn := range clusterOutput.ClusterArns {
+			services, err := s.getServices(ctx, clusterArn)
+			if err != nil {
+				return nil, err
+			}
+
+			for targetGroupArn := range collectRunningTargetGroups(services) {
+				runningTargetGroups[targetGroupArn] = true
+			}
+
+			for _, svc := range filterScaledToZeroServices(services) {
+				info, err := s.toServiceWasteInfo(ctx, clusterArn, svc)
+				if err != nil {
+					return nil, err
+				}
+
+				if len(info.TargetGroupArns) > 0 {
+					results = append(results, info)
+				}
+			}
+		}
+	}
+
+	sharedLoadBalancers, err := s.findSharedLoadBalancers(ctx, results, runningTargetGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	estimateLoadBalancerCosts(results, sharedLoadBalancers)
+
+	return results, nil
+}
+
+func (s *service) getServices(ctx context.Context, clusterArn string) ([]types.Service, error) {
+	var serviceArns []string
+
+	paginator := ecs.NewListServicesPaginator(s.client, &ecs.ListServicesInput{Cluster: aws.String(clusterArn)})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		serviceArns = append(serviceArns, output.ServiceArns...)
+	}
+
+	var services []types.Service
+
+	for start := 0; start < len(serviceArns); start += describeServicesBatchSize {
+		end := min(start+describeServicesBatchSize, len(serviceArns))
+
+		output, err := s.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(clusterArn),
+			Services: serviceArns[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		services = append(services, output.Services...)
+	}
+
+	return services, nil
+}
+
+// findSharedLoadBalancers returns the load balancers of the idle services that also route to
+// a target group of a running service, or to any other target group with registered targets,
+// such as one serving EC2 instances or Lambda functions.
+func (s *service) findSharedLoadBalancers(ctx context.Context, idle []model.ECSServiceWasteInfo, runningTargetGroups map[string]bool) (map[string]bool, error) {
+	shared := make(map[string]bool)
+	checked := make(map[string]bool)
+	idleTargetGroups := make(map[string]bool)
+
+	for _, info := range idle {
+		for _, targetGroupArn := range info.TargetGroupArns {
+			idleTargetGroups[targetGroupArn] = true
+		}
+	}
+
+	for _, info := range idle {
+		for _, lbArn := range info.LoadBalancerArns {
+			if checked[lbArn] {
+				continue
+			}
+
+			checked[lbArn] = true
+
+			paginator := elb.NewDescribeTargetGroupsPaginator(s.elbClient, &elb.DescribeTargetGroupsInput{
+				LoadBalancerArn: aws.String(lbArn),
+			})
+
+			for paginator.HasMorePages() && !shared[lbArn] {
+				output, err := paginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, tg := range output.TargetGroups {
+					targetGroupArn := aws.ToString(tg.TargetGroupArn)
+
+					if runningTargetGroups[targetGroupArn] {
+						shared[lbArn] = true
+						break
+					}
+
+					if idleTargetGroups[targetGroupArn] {
+						continue
+					}
+
+					registered, err := s.hasRegisteredTargets(ctx, targetGroupArn)
+					if err != nil {
+						return nil, err
+					}
+
+					if registered {
+						shared[lbArn] = true
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return shared, nil
+}
+
+// hasRegisteredTargets reports whether any target is registered with the target group.
+func (s *service) hasRegisteredTargets(ctx context.Context, targetGroupArn string) (bool, error) {
+	output, err := s.elbClient.DescribeTargetHealth(ctx, &elb.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupArn),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(output.TargetHealthDescriptions) > 0, nil
+}
+
+// toServiceWasteInfo keeps only the target groups that still exist and collects their load balancers.
+func (s *service) toServiceWasteInfo(ctx context.Context, clusterArn string, svc types.Service) (model.ECSServiceWasteInfo, error) {
+	info := model.ECSServiceWasteInfo{
+		ClusterName: clusterNameFromArn(clusterArn),
+		ServiceName: aws.ToString(svc.ServiceName),
+	}
+
+	loadBalancerArns := make(map[string]bool)
+
+	for _, lb := range svc.LoadBalancers {
+		targetGroupArn := aws.ToString(lb.TargetGroupArn)
+		if targetGroupArn == "" {
+			continue
+		}
+
+		output, err := s.elbClient.DescribeTargetGroups(ctx, &elb.DescribeTargetGroupsInput{
+			TargetGroupArns: []string{targetGroupArn},
+		})
+		if err != nil {
+			var notFound *elbtypes.TargetGroupNotFoundException
+			if errors.As(err, &notFound) {
+				continue
+			}
+
+			return info, err
+		}
+
+		info.TargetGroupArns = append(info.TargetGroupArns, targetGroupArn)
+
+		for _, tg := range output.TargetGroups {
+			for _, lbArn := range tg.LoadBalancerArns {
+				if !loadBalancerArns[lbArn] {
+					loadBalancerArns[lbArn] = true
+
+					info.LoadBalancerArns = append(info.LoadBalancerArns, lbArn)
+				}
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// estimateLoadBalancerCosts charges every load balancer not shared with a running service to
+// the first idle service that uses it, so costs are not counted twice.
+func estimateLoadBalancerCosts(services []model.ECSServiceWasteInfo, sharedLoadBalancers map[string]bool) {
+	charged := make(map[string]bool)
+
+	for i := range services {
+		var count int
+
+		for _, lbArn := range services[i].LoadBalancerArns {
+			if sharedLoadBalancers[lbArn] || charged[lbArn] {
+				continue
+			}
+
+			charged[lbArn] = true
+			count++
+		}
+
+		services[i].EstimatedMonthlyCost = float64(count) * loadBalancerHourlyCost * hoursPerMonth
+	}
+}
+
+// collectRunningTargetGroups returns the target groups of active services with a desired count above zero.
+func collectRunningTargetGroups(services []types.Service) map[string]bool {
+	targetGroups := make(map[string]bool)
+
+	for _, svc := range services {
+		if aws.ToString(svc.Status) != "ACTIVE" || svc.DesiredCount == 0 {
+			continue
+		}
+
+		for _, lb := range svc.LoadBalancers {
+			if targetGroupArn := aws.ToString(lb.TargetGroupArn); targetGroupArn != "" {
+				targetGroups[targetGroupArn] = true
+			}
+		}
+	}
+
+	return targetGroups
+}
+
+// filterScaledToZeroServices returns active services with a desired count of zero that are
+// still attached to at least one load balancer or target group.
+func filterScaledToZeroServices(services []types.Service) []types.Service {
+	var result []types.Service
+
+	for _, svc := range services {
+		if aws.ToString(svc.Status) != "ACTIVE" || svc.DesiredCount != 0 || len(svc.LoadBalancers) == 0 {
+			continue
+		}
+
+		result = append(result, svc)
+	}
+
+	return result
+}
+
+// clusterNameFromArn extracts the cluster name from ARNs such as
+// arn:aws:ecs:us-east-1:123456789012:cluster/my-cluster.
+func clusterNameFromArn(arn string) string {
+	if idx := strings.LastIndex(arn, "/"); idx >= 0 {
+		return arn[idx+1:]
+	}
+
+	return arn
+}
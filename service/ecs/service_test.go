@@ -0,0 +1,68 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestFilterScaledToZeroServices(t *testing.T) {
+	withTargetGroup := []types.LoadBalancer{{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/tg/1")}}
+
+	services := []types.Service{
+		{ServiceName: aws.String("idle"), Status: aws.String("ACTIVE"), DesiredCount: 0, LoadBalancers: withTargetGroup},
+		{ServiceName: aws.String("running"), Status: aws.String("ACTIVE"), DesiredCount: 2, LoadBalancers: withTargetGroup},
+		{ServiceName: aws.String("no-lb"), Status: aws.String("ACTIVE"), DesiredCount: 0},
+		{ServiceName: aws.String("draining"), Status: aws.String("DRAINING"), DesiredCount: 0, LoadBalancers: withTargetGroup},
+	}
+
+	result := filterScaledToZeroServices(services)
+
+	if len(result) != 1 || aws.ToString(result[0].ServiceName) != "idle" {
+		t.Errorf("filterScaledToZeroServices() = %v, want only 'idle'", result)
+	}
+}
+
+func TestCollectRunningTargetGroups(t *testing.T) {
+	services := []types.Service{
+		{Status: aws.String("ACTIVE"), DesiredCount: 2, LoadBalancers: []types.LoadBalancer{{TargetGroupArn: aws.String("tg-running")}}},
+		{Status: aws.String("ACTIVE"), DesiredCount: 0, LoadBalancers: []types.LoadBalancer{{TargetGroupArn: aws.String("tg-idle")}}},
+		{Status: aws.String("DRAINING"), DesiredCount: 1, LoadBalancers: []types.LoadBalancer{{TargetGroupArn: aws.String("tg-draining")}}},
+		{Status: aws.String("ACTIVE"), DesiredCount: 1, LoadBalancers: []types.LoadBalancer{{LoadBalancerName: aws.String("classic")}}},
+	}
+
+	result := collectRunningTargetGroups(services)
+
+	if len(result) != 1 || !result["tg-running"] {
+		t.Errorf("collectRunningTargetGroups() = %v, want only 'tg-running'", result)
+	}
+}
+
+func TestEstimateLoadBalancerCosts(t *testing.T) {
+	services := []model.ECSServiceWasteInfo{
+		{ServiceName: "a", LoadBalancerArns: []string{"lb-idle", "lb-shared"}},
+		{ServiceName: "b", LoadBalancerArns: []string{"lb-idle"}},
+		{ServiceName: "c", LoadBalancerArns: []string{"lb-shared"}},
+	}
+
+	estimateLoadBalancerCosts(services, map[string]bool{"lb-shared": true})
+
+	want := []float64{loadBalancerHourlyCost * hoursPerMonth, 0, 0}
+	for i, svc := range services {
+		if svc.EstimatedMonthlyCost != want[i] {
+			t.Errorf("service %s cost = %.2f, want %.2f", svc.ServiceName, svc.EstimatedMonthlyCost, want[i])
+		}
+	}
+}
+
+func TestClusterNameFromArn(t *testing.T) {
+	if got := clusterNameFromArn("arn:aws:ecs:us-east-1:123456789012:cluster/production"); got != "production" {
+		t.Errorf("clusterNameFromArn() = %q, want 'production'", got)
+	}
+
+	if got := clusterNameFromArn("default"); got != "default" {
+		t.Errorf("clusterNameFromArn() = %q, want 'default'", got)
+	}
+}
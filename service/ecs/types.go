@@ -0,0 +1,19 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client    *ecs.Client
+	elbClient *elb.Client
+}
+
+// Service defines the interface for AWS ECS service.
+type Service interface {
+	GetIdleServices(ctx context.Context) ([]model.ECSServiceWasteInfo, error)
+}
@@ -0,0 +1,165 @@
+// Package efs provides a service for interacting with Amazon Elastic File System.
+package efs
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// EFS storage pricing (us-east-1): ~$0.30 per GB-month for Standard, ~$0.016 for Infrequent Access
+// and ~$0.008 for Archive
+const (
+	standardCostPerGBMonth = 0.30
+	iaCostPerGBMonth       = 0.016
+	archiveCostPerGBMonth  = 0.008
+	bytesPerGB             = 1024 * 1024 * 1024
+	secondsPerDay          = 24 * 60 * 60
+)
+
+// NewService creates a new EFS service.
+func NewService(awsconfig aws.Config) Service {
+	client := efs.NewFromConfig(awsconfig)
+	cloudwatchClient := cloudwatch.NewFromConfig(awsconfig)
+
+	return &service{
+		client:           client,
+		cloudwatchClient: cloudwatchClient,
+	}
+}
+
+// GetFileSystemWaste returns available file systems with no mount targets, file systems older than
+// idleDays with no client connections in that period, and file systems storing more than
+// standardSizeThresholdGB of Standard-class data without a transition to Infrequent Access.
+func (s *service) GetFileSystemWaste(ctx context.Context, idleDays int, standardSizeThresholdGB float64) ([]model.EFSFileSystemWasteInfo, error) {
+	var results []model.EFSFileSystemWasteInfo
+
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -idleDays)
+
+	paginator := efs.NewDescribeFileSystemsPaginator(s.client, &efs.DescribeFileSystemsInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fileSystem := range output.FileSystems {
+			if fileSystem.LifeCycleState != types.LifeCycleStateAvailable {
+				continue
+			}
+
+			hasIALifecycle, err := s.hasIALifecycle(ctx, aws.ToString(fileSystem.FileSystemId))
+			if err != nil {
+				return nil, err
+			}
+
+			// Connections are only meaningful for mounted file systems that existed for the whole period
+			connections := -1.0
+
+			if fileSystem.NumberOfMountTargets > 0 && aws.ToTime(fileSystem.CreationTime).Before(startTime) {
+				connections, err = s.sumClientConnections(ctx, aws.ToString(fileSystem.FileSystemId), startTime, endTime)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if info, isWaste := classifyFileSystem(fileSystem, connections, hasIALifecycle, standardSizeThresholdGB); isWaste {
+				results = append(results, info)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (s *service) hasIALifecycle(ctx context.Context, fileSystemID string) (bool, error) {
+	output, err := s.client.DescribeLifecycleConfiguration(ctx, &efs.DescribeLifecycleConfigurationInput{
+		FileSystemId: aws.String(fileSystemID),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, policy := range output.LifecyclePolicies {
+		if policy.TransitionToIA != "" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// sumClientConnections returns the total number of client connections to a file system between startTime and endTime.
+func (s *service) sumClientConnections(ctx context.Context, fileSystemID string, startTime, endTime time.Time) (float64, error) {
+	output, err := s.cloudwatchClient.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/EFS"),
+		MetricName: aws.String("ClientConnections"),
+		Dimensions: []cwtypes.Dimension{
+			{Name: aws.String("FileSystemId"), Value: aws.String(fileSystemID)},
+		},
+		StartTime:  aws.Time(startTime),
+		EndTime:    aws.Time(endTime),
+		Period:     aws.Int32(secondsPerDay),
+		Statistics: []cwtypes.Statistic{cwtypes.StatisticSum},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total float64
+
+	for _, datapoint := range output.Datapoints {
+		total += aws.ToFloat64(datapoint.Sum)
+	}
+
+	return total, nil
+}
+
+// classifyFileSystem decides whether a file system is waste. A negative connection count means
+// connections were not measured. Idle statuses take precedence over the missing lifecycle policy,
+// whose potential savings are reported regardless of the status.
+func classifyFileSystem(fileSystem types.FileSystemDescription, connections float64, hasIALifecycle bool, standardSizeThresholdGB float64) (model.EFSFileSystemWasteInfo, bool) {
+	var standardGB, iaGB, archiveGB, totalGB float64
+
+	if size := fileSystem.SizeInBytes; size != nil {
+		standardGB = float64(aws.ToInt64(size.ValueInStandard)) / bytesPerGB
+		iaGB = float64(aws.ToInt64(size.ValueInIA)) / bytesPerGB
+		archiveGB = float64(aws.ToInt64(size.ValueInArchive)) / bytesPerGB
+		totalGB = float64(size.Value) / bytesPerGB
+	}
+
+	info := model.EFSFileSystemWasteInfo{
+		FileSystemID:         aws.ToString(fileSystem.FileSystemId),
+		Name:                 aws.ToString(fileSystem.Name),
+		MountTargetCount:     fileSystem.NumberOfMountTargets,
+		StandardSizeGB:       standardGB,
+		TotalSizeGB:          totalGB,
+		HasIALifecycle:       hasIALifecycle,
+		EstimatedMonthlyCost: standardGB*standardCostPerGBMonth + iaGB*iaCostPerGBMonth + archiveGB*archiveCostPerGBMonth,
+	}
+
+	if !hasIALifecycle {
+		info.PotentialIASavings = standardGB * (standardCostPerGBMonth - iaCostPerGBMonth)
+	}
+
+	switch {
+	case fileSystem.NumberOfMountTargets == 0:
+		info.Status = model.EFSFileSystemNoMountTargets
+	case connections == 0:
+		info.Status = model.EFSFileSystemNoConnections
+	case !hasIALifecycle && standardGB > standardSizeThresholdGB:
+		info.Status = model.EFSFileSystemNoIALifecycle
+	default:
+		return info, false
+	}
+
+	return info, true
+}
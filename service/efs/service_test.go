@@ -0,0 +1,114 @@
+package efs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func fileSystem(mountTargets int32, standardGB, iaGB int64) types.FileSystemDescription {
+	return types.FileSystemDescription{
+		FileSystemId:         aws.String("fs-123"),
+		Name:                 aws.String("shared"),
+		NumberOfMountTargets: mountTargets,
+		SizeInBytes: &types.FileSystemSize{
+			Value:           (standardGB + iaGB) * bytesPerGB,
+			ValueInStandard: aws.Int64(standardGB * bytesPerGB),
+			ValueInIA:       aws.Int64(iaGB * bytesPerGB),
+		},
+	}
+}
+
+func TestClassifyFileSystem(t *testing.T) {
+	tests := []struct {
+		name           string
+		fileSystem     types.FileSystemDescription
+		connections    float64
+		hasIALifecycle bool
+		wantWaste      bool
+		wantStatus     string
+		wantCost       float64
+		wantSavings    float64
+	}{
+		{
+			name:           "no_mount_targets",
+			fileSystem:     fileSystem(0, 10, 0),
+			connections:    -1,
+			hasIALifecycle: true,
+			wantWaste:      true,
+			wantStatus:     model.EFSFileSystemNoMountTargets,
+			wantCost:       3,
+		},
+		{
+			name:           "no_connections",
+			fileSystem:     fileSystem(2, 10, 100),
+			connections:    0,
+			hasIALifecycle: true,
+			wantWaste:      true,
+			wantStatus:     model.EFSFileSystemNoConnections,
+			wantCost:       4.6,
+		},
+		{
+			name:           "large_without_lifecycle",
+			fileSystem:     fileSystem(2, 500, 0),
+			connections:    42,
+			hasIALifecycle: false,
+			wantWaste:      true,
+			wantStatus:     model.EFSFileSystemNoIALifecycle,
+			wantCost:       150,
+			wantSavings:    142,
+		},
+		{
+			name:           "idle_without_lifecycle_reports_savings",
+			fileSystem:     fileSystem(0, 10, 0),
+			connections:    -1,
+			hasIALifecycle: false,
+			wantWaste:      true,
+			wantStatus:     model.EFSFileSystemNoMountTargets,
+			wantCost:       3,
+			wantSavings:    2.84,
+		},
+		{
+			name:           "small_without_lifecycle",
+			fileSystem:     fileSystem(2, 50, 0),
+			connections:    42,
+			hasIALifecycle: false,
+			wantWaste:      false,
+		},
+		{
+			name:           "connections_not_measured",
+			fileSystem:     fileSystem(1, 10, 0),
+			connections:    -1,
+			hasIALifecycle: true,
+			wantWaste:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, isWaste := classifyFileSystem(tt.fileSystem, tt.connections, tt.hasIALifecycle, 100)
+
+			if isWaste != tt.wantWaste {
+				t.Fatalf("classifyFileSystem() waste = %v, want %v", isWaste, tt.wantWaste)
+			}
+
+			if !tt.wantWaste {
+				return
+			}
+
+			if info.Status != tt.wantStatus {
+				t.Errorf("classifyFileSystem() status = %q, want %q", info.Status, tt.wantStatus)
+			}
+
+			if diff := info.EstimatedMonthlyCost - tt.wantCost; diff > 0.001 || diff < -0.001 {
+				t.Errorf("classifyFileSystem() cost = %.2f, want %.2f", info.EstimatedMonthlyCost, tt.wantCost)
+			}
+
+			if diff := info.PotentialIASavings - tt.wantSavings; diff > 0.001 || diff < -0.001 {
+				t.Errorf("classifyFileSystem() IA savings = %.2f, want %.2f", info.PotentialIASavings, tt.wantSavings)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package efs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client           *efs.Client
+	cloudwatchClient *cloudwatch.Client
+}
+
+// Service defines the interface for Amazon EFS service.
+type Service interface {
+	GetFileSystemWaste(ctx context.Context, idleDays int, standardSizeThresholdGB float64) ([]model.EFSFileSystemWasteInfo, error)
+}
@@ -0,0 +1,247 @@
+// Package eks provides a service for interacting with Amazon Elastic Kubernetes Service.
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// EKS control plane pricing: $0.10 per hour in standard support and $0.60 per hour in extended support
+const (
+	standardSupportHourlyCost = 0.10
+	extendedSupportHourlyCost = 0.60
+	hoursPerMonth             = 730
+)
+
+// NewService creates a new EKS service.
+func NewService(awsconfig aws.Config) Service {
+	client := eks.NewFromConfig(awsconfig)
+	ec2Client := ec2.NewFromConfig(awsconfig)
+
+	return &service{
+		client:    client,
+		ec2Client: ec2Client,
+	}
+}
+
+// GetIdleClusters returns EKS clusters with no managed node groups or Fargate profiles,
+// clusters whose node groups are all scaled to zero, and clusters running a Kubernetes
+// version in extended support. EKS Auto Mode clusters and clusters with running EC2
+// instances tagged for the cluster, such as self-managed or Karpenter nodes, are never idle.
+func (s *service) GetIdleClusters(ctx context.Context) ([]model.EKSClusterWasteInfo, error) {
+	versionStatuses, err := s.getVersionStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []model.EKSClusterWasteInfo
+
+	paginator := eks.NewListClustersPaginator(s.client, &eks.ListClustersInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, clusterName := range output.Clusters {
+			cluster, err := s.client.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(clusterName)})
+			if err != nil {
+				return nil, err
+			}
+
+			compute := clusterCompute{
+				autoMode: cluster.Cluster.ComputeConfig != nil && aws.ToBool(cluster.Cluster.ComputeConfig.Enabled),
+			}
+
+			compute.nodeGroupDesiredSizes, err = s.getNodeGroupDesiredSizes(ctx, clusterName)
+			if err != nil {
+				return nil, err
+			}
+
+			compute.fargateProfiles, err = s.countFargateProfiles(ctx, clusterName)
+			if err != nil {
+				return nil, err
+			}
+
+			// Only clusters that look idle are checked for nodes outside of managed node groups
+			if compute.idleStatus() != "" {
+				compute.taggedInstances, err = s.hasRunningClusterInstances(ctx, clusterName)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			version := aws.ToString(cluster.Cluster.Version)
+
+			if info, isWaste := classifyCluster(clusterName, version, compute, versionStatuses[version]); isWaste {
+				results = append(results, info)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// getVersionStatuses maps every Kubernetes version known to EKS to its support status.
+func (s *service) getVersionStatuses(ctx context.Context) (map[string]types.VersionStatus, error) {
+	statuses := make(map[string]types.VersionStatus)
+
+	paginator := eks.NewDescribeClusterVersionsPaginator(s.client, &eks.DescribeClusterVersionsInput{
+		IncludeAll: aws.Bool(true),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, version := range output.ClusterVersions {
+			statuses[aws.ToString(version.ClusterVersion)] = version.VersionStatus
+		}
+	}
+
+	return statuses, nil
+}
+
+func (s *service) getNodeGroupDesiredSizes(ctx context.Context, clusterName string) ([]int32, error) {
+	var desiredSizes []int32
+
+	paginator := eks.NewListNodegroupsPaginator(s.client, &eks.ListNodegroupsInput{ClusterName: aws.String(clusterName)})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, nodeGroupName := range output.Nodegroups {
+			nodeGroup, err := s.client.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String(clusterName),
+				NodegroupName: aws.String(nodeGroupName),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var desiredSize int32
+			if nodeGroup.Nodegroup.ScalingConfig != nil {
+				desiredSize = aws.ToInt32(nodeGroup.Nodegroup.ScalingConfig.DesiredSize)
+			}
+
+			desiredSizes = append(desiredSizes, desiredSize)
+		}
+	}
+
+	return desiredSizes, nil
+}
+
+func (s *service) countFargateProfiles(ctx context.Context, clusterName string) (int, error) {
+	var count int
+
+	paginator := eks.NewListFargateProfilesPaginator(s.client, &eks.ListFargateProfilesInput{ClusterName: aws.String(clusterName)})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(output.FargateProfileNames)
+	}
+
+	return count, nil
+}
+
+// hasRunningClusterInstances reports whether any running or pending EC2 instance carries the
+// kubernetes.io/cluster/<name> tag that self-managed and Karpenter nodes are launched with.
+func (s *service) hasRunningClusterInstances(ctx context.Context, clusterName string) (bool, error) {
+	paginator := ec2.NewDescribeInstancesPaginator(s.ec2Client, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("tag-key"), Values: []string{"kubernetes.io/cluster/" + clusterName}},
+			{Name: aws.String("instance-state-name"), Values: []string{"pending", "running"}},
+		},
+		MaxResults: aws.Int32(5),
+	})
+
+	// Filtered pages can be empty while more pages remain, so stop only at the first instance.
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		for _, reservation := range output.Reservations {
+			if len(reservation.Instances) > 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// clusterCompute describes where the pods of a cluster can run.
+type clusterCompute struct {
+	nodeGroupDesiredSizes []int32
+	fargateProfiles       int
+	autoMode              bool // EKS Auto Mode manages the nodes
+	taggedInstances       bool // Running EC2 instances are tagged for the cluster
+}
+
+// idleStatus returns the idle status of the cluster, or "" when pods can run on it. Clusters
+// with Fargate profiles, EKS Auto Mode or tagged instances are never idle.
+func (c clusterCompute) idleStatus() string {
+	if c.fargateProfiles > 0 || c.autoMode || c.taggedInstances {
+		return ""
+	}
+
+	var totalNodes int32
+	for _, size := range c.nodeGroupDesiredSizes {
+		totalNodes += size
+	}
+
+	switch {
+	case len(c.nodeGroupDesiredSizes) == 0:
+		return model.EKSClusterNoNodeGroups
+	case totalNodes == 0:
+		return model.EKSClusterZeroNodes
+	default:
+		return ""
+	}
+}
+
+// classifyCluster decides whether a cluster is waste: idle clusters are charged the full control
+// plane cost, and clusters in extended support the extended support fee.
+func classifyCluster(clusterName, version string, compute clusterCompute, versionStatus types.VersionStatus) (model.EKSClusterWasteInfo, bool) {
+	info := model.EKSClusterWasteInfo{
+		ClusterName:       clusterName,
+		KubernetesVersion: version,
+		NodeGroupCount:    len(compute.nodeGroupDesiredSizes),
+		Status:            compute.idleStatus(),
+		ExtendedSupport:   versionStatus == types.VersionStatusExtendedSupport,
+	}
+
+	hourlyCost := standardSupportHourlyCost
+	if info.ExtendedSupport {
+		hourlyCost = extendedSupportHourlyCost
+	}
+
+	switch {
+	case info.Status != "":
+		info.EstimatedMonthlyCost = hourlyCost * hoursPerMonth
+	case info.ExtendedSupport:
+		info.EstimatedMonthlyCost = (extendedSupportHourlyCost - standardSupportHourlyCost) * hoursPerMonth
+	default:
+		return info, false
+	}
+
+	return info, true
+}
@@ -0,0 +1,119 @@
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestClassifyCluster(t *testing.T) {
+	tests := []struct {
+		name            string
+		desiredSizes    []int32
+		fargateProfiles int
+		autoMode        bool
+		taggedInstances bool
+		versionStatus   types.VersionStatus
+		wantWaste       bool
+		wantStatus      string
+		wantCost        float64
+	}{
+		{
+			name:          "no_node_groups",
+			versionStatus: types.VersionStatusStandardSupport,
+			wantWaste:     true,
+			wantStatus:    model.EKSClusterNoNodeGroups,
+			wantCost:      73,
+		},
+		{
+			name:          "node_groups_scaled_to_zero",
+			desiredSizes:  []int32{0, 0},
+			versionStatus: types.VersionStatusStandardSupport,
+			wantWaste:     true,
+			wantStatus:    model.EKSClusterZeroNodes,
+			wantCost:      73,
+		},
+		{
+			name:          "idle_on_extended_support",
+			versionStatus: types.VersionStatusExtendedSupport,
+			wantWaste:     true,
+			wantStatus:    model.EKSClusterNoNodeGroups,
+			wantCost:      438,
+		},
+		{
+			name:          "running_on_extended_support",
+			desiredSizes:  []int32{3},
+			versionStatus: types.VersionStatusExtendedSupport,
+			wantWaste:     true,
+			wantStatus:    "",
+			wantCost:      365,
+		},
+		{
+			name:            "fargate_only",
+			fargateProfiles: 1,
+			versionStatus:   types.VersionStatusStandardSupport,
+			wantWaste:       false,
+		},
+		{
+			name:          "auto_mode",
+			autoMode:      true,
+			versionStatus: types.VersionStatusStandardSupport,
+			wantWaste:     false,
+		},
+		{
+			name:            "self_managed_nodes",
+			taggedInstances: true,
+			versionStatus:   types.VersionStatusStandardSupport,
+			wantWaste:       false,
+		},
+		{
+			name:            "self_managed_nodes_on_extended_support",
+			desiredSizes:    []int32{0},
+			taggedInstances: true,
+			versionStatus:   types.VersionStatusExtendedSupport,
+			wantWaste:       true,
+			wantStatus:      "",
+			wantCost:        365,
+		},
+		{
+			name:          "healthy",
+			desiredSizes:  []int32{0, 2},
+			versionStatus: types.VersionStatusStandardSupport,
+			wantWaste:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compute := clusterCompute{
+				nodeGroupDesiredSizes: tt.desiredSizes,
+				fargateProfiles:       tt.fargateProfiles,
+				autoMode:              tt.autoMode,
+				taggedInstances:       tt.taggedInstances,
+			}
+
+			info, isWaste := classifyCluster("cluster", "1.29", compute, tt.versionStatus)
+
+			if isWaste != tt.wantWaste {
+				t.Fatalf("classifyCluster() waste = %v, want %v", isWaste, tt.wantWaste)
+			}
+
+			if !tt.wantWaste {
+				return
+			}
+
+			if info.Status != tt.wantStatus {
+				t.Errorf("classifyCluster() status = %q, want %q", info.Status, tt.wantStatus)
+			}
+
+			if diff := info.EstimatedMonthlyCost - tt.wantCost; diff > 0.001 || diff < -0.001 {
+				t.Errorf("classifyCluster() cost = %.2f, want %.2f", info.EstimatedMonthlyCost, tt.wantCost)
+			}
+
+			if info.NodeGroupCount != len(tt.desiredSizes) {
+				t.Errorf("classifyCluster() node groups = %d, want %d", info.NodeGroupCount, len(tt.desiredSizes))
+			}
+		})
+	}
+}
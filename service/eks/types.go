@@ -0,0 +1,19 @@
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client    *eks.Client
+	ec2Client *ec2.Client
+}
+
+// Service defines the interface for AWS EKS service.
+type Service interface {
+	GetIdleClusters(ctx context.Context) ([]model.EKSClusterWasteInfo, error)
+}
@@ -5,16 +5,21 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	elbclassic "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbclassictypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/elC0mpa/aws-doctor/model"
 )
 
 // NewService creates a new ELB service.
 func NewService(awsconfig aws.Config) Service {
 	client := elb.NewFromConfig(awsconfig)
+	classicClient := elbclassic.NewFromConfig(awsconfig)
 
 	return &service{
-		client: client,
+		client:        client,
+		classicClient: classicClient,
 	}
 }
 
@@ -54,7 +59,7 @@ func (s *service) GetUnusedLoadBalancers(ctx context.Context) ([]types.LoadBalan
 	var orphanedLbs []types.LoadBalancer
 
 	for _, lb := range allLoadBalancers {
-		if lb.Type != types.LoadBalancerTypeEnumApplication && lb.Type != types.LoadBalancerTypeEnumNetwork {
+		if lb.Type != types.LoadBalancerTypeEnumApplication && lb.Type != types.LoadBalancerTypeEnumNetwork && lb.Type != types.LoadBalancerTypeEnumGateway {
 			continue
 		}
 
@@ -67,3 +72,70 @@ func (s *service) GetUnusedLoadBalancers(ctx context.Context) ([]types.LoadBalan
 
 	return orphanedLbs, nil
 }
+
+// GetUnusedClassicLoadBalancers returns the Classic Load Balancers that have no registered
+// instances or whose registered instances are all OutOfService.
+func (s *service) GetUnusedClassicLoadBalancers(ctx context.Context) ([]model.ClassicLoadBalancerWasteInfo, error) {
+	var unused []model.ClassicLoadBalancerWasteInfo
+
+	paginator := elbclassic.NewDescribeLoadBalancersPaginator(s.classicClient, &elbclassic.DescribeLoadBalancersInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lb := range output.LoadBalancerDescriptions {
+			var states []elbclassictypes.InstanceState
+
+			// Instance health is only meaningful when instances are registered
+			if len(lb.Instances) > 0 {
+				health, err := s.classicClient.DescribeInstanceHealth(ctx, &elbclassic.DescribeInstanceHealthInput{
+					LoadBalancerName: lb.LoadBalancerName,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				states = health.InstanceStates
+			}
+
+			if info, isUnused := classifyClassicLoadBalancer(lb, states); isUnused {
+				unused = append(unused, info)
+			}
+		}
+	}
+
+	return unused, nil
+}
+
+// classifyClassicLoadBalancer reports a Classic Load Balancer as unused when it has no
+// registered instances or when every registered instance is OutOfService.
+func classifyClassicLoadBalancer(lb elbclassictypes.LoadBalancerDescription, states []elbclassictypes.InstanceState) (model.ClassicLoadBalancerWasteInfo, bool) {
+	info := model.ClassicLoadBalancerWasteInfo{
+		Name:          aws.ToString(lb.LoadBalancerName),
+		DNSName:       aws.ToString(lb.DNSName),
+		InstanceCount: len(lb.Instances),
+	}
+
+	if len(lb.Instances) == 0 {
+		info.Status = model.ClassicLoadBalancerNoInstances
+
+		return info, true
+	}
+
+	if len(states) == 0 {
+		return info, false
+	}
+
+	for _, state := range states {
+		if aws.ToString(state.State) != "OutOfService" {
+			return info, false
+		}
+	}
+
+	info.Status = model.ClassicLoadBalancerAllOutOfService
+
+	return info, true
+}
@@ -0,0 +1,70 @@
+package elb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbclassictypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestClassifyClassicLoadBalancer(t *testing.T) {
+	registered := []elbclassictypes.Instance{{InstanceId: aws.String("i-1")}, {InstanceId: aws.String("i-2")}}
+
+	tests := []struct {
+		name       string
+		lb         elbclassictypes.LoadBalancerDescription
+		states     []elbclassictypes.InstanceState
+		wantUnused bool
+		wantStatus string
+	}{
+		{
+			name:       "no_instances",
+			lb:         elbclassictypes.LoadBalancerDescription{LoadBalancerName: aws.String("empty")},
+			wantUnused: true,
+			wantStatus: model.ClassicLoadBalancerNoInstances,
+		},
+		{
+			name: "all_out_of_service",
+			lb:   elbclassictypes.LoadBalancerDescription{LoadBalancerName: aws.String("down"), Instances: registered},
+			states: []elbclassictypes.InstanceState{
+				{InstanceId: aws.String("i-1"), State: aws.String("OutOfService")},
+				{InstanceId: aws.String("i-2"), State: aws.String("OutOfService")},
+			},
+			wantUnused: true,
+			wantStatus: model.ClassicLoadBalancerAllOutOfService,
+		},
+		{
+			name: "some_in_service",
+			lb:   elbclassictypes.LoadBalancerDescription{LoadBalancerName: aws.String("partial"), Instances: registered},
+			states: []elbclassictypes.InstanceState{
+				{InstanceId: aws.String("i-1"), State: aws.String("InService")},
+				{InstanceId: aws.String("i-2"), State: aws.String("OutOfService")},
+			},
+			wantUnused: false,
+		},
+		{
+			name:       "health_unknown",
+			lb:         elbclassictypes.LoadBalancerDescription{LoadBalancerName: aws.String("unknown"), Instances: registered},
+			wantUnused: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, isUnused := classifyClassicLoadBalancer(tt.lb, tt.states)
+
+			if isUnused != tt.wantUnused {
+				t.Fatalf("classifyClassicLoadBalancer() unused = %v, want %v", isUnused, tt.wantUnused)
+			}
+
+			if info.Status != tt.wantStatus {
+				t.Errorf("classifyClassicLoadBalancer() status = %q, want %q", info.Status, tt.wantStatus)
+			}
+
+			if info.InstanceCount != len(tt.lb.Instances) {
+				t.Errorf("classifyClassicLoadBalancer() instance count = %d, want %d", info.InstanceCount, len(tt.lb.Instances))
+			}
+		})
+	}
+}
@@ -3,15 +3,19 @@ package elb
 import (
 	"context"
 
+	elbclassic "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/elC0mpa/aws-doctor/model"
 )
 
 type service struct {
-	client *elb.Client
+	client        *elb.Client
+	classicClient *elbclassic.Client
 }
 
 // Service defines the interface for AWS ELB service.
 type Service interface {
 	GetUnusedLoadBalancers(ctx context.Context) ([]types.LoadBalancer, error)
+	GetUnusedClassicLoadBalancers(ctx context.Context) ([]model.ClassicLoadBalancerWasteInfo, error)
 }
@@ -3,10 +3,17 @@ package flag
 
 import (
 	"flag"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/elC0mpa/aws-doctor/model"
 )
 
+// minServeInterval is the shortest --interval accepted by the serve command
+const minServeInterval = 15 * time.Minute
+
 // NewService creates a new Flag service.
 func NewService() Service {
 	return &service{}
@@ -17,19 +24,230 @@ func (s *service) GetParsedFlags() (model.Flags, error) {
 	profile := flag.String("profile", "", "AWS profile configuration")
 	trend := flag.Bool("trend", false, "Display a trend report for the last 6 months")
 	waste := flag.Bool("waste", false, "Display AWS waste report")
-	output := flag.String("output", "table", "Output format: table or json")
+	commitments := flag.Bool("commitments", false, "Display Reserved Instance and Savings Plans utilization and coverage report")
+	rightsizing := flag.Bool("rightsizing", false, "Display EC2, EBS and Lambda rightsizing recommendations from Cost Explorer and Compute Optimizer")
+	recommendations := flag.Bool("recommendations", false, "Display Savings Plans and Reserved Instance purchase recommendations")
+	term := flag.Int("term", 1, "Commitment term in years for purchase recommendations: 1 or 3")
+	paymentOption := flag.String("payment-option", model.PaymentOptionNoUpfront, "Payment option for purchase recommendations: no-upfront, partial-upfront or all-upfront")
+	lookbackDays := flag.Int("lookback-days", 30, "Usage lookback period in days for purchase recommendations: 7, 30 or 60")
+	output := flag.String("output", "table", "Comma-separated output formats, each optionally followed by :path (table, json, csv, markdown, pdf, html, template or openmetrics)")
+	outFile := flag.String("out-file", "", "File to write the report to when a single --output format is given; required by pdf and html")
+	templatePath := flag.String("template", "", "Go text/template file executed by --output template")
+	metrics := flag.Bool("metrics", false, "With the serve command: expose cost and waste results as Prometheus metrics")
+	listen := flag.String("listen", ":9877", "With the serve command: address the metrics endpoint listens on")
+	interval := flag.Duration("interval", 6*time.Hour, "With the serve command: how often the cost and waste workflows run (minimum 15m)")
 	version := flag.Bool("version", false, "Display version information")
 	update := flag.Bool("update", false, "Update aws-doctor to the latest version")
+	noIPRanges := flag.Bool("no-ip-ranges", false, "Do not download the AWS IP address ranges used to check the addresses of Route 53 A records")
 
 	flag.Parse()
 
-	return model.Flags{
-		Region:  *region,
-		Profile: *profile,
-		Trend:   *trend,
-		Waste:   *waste,
-		Output:  *output,
-		Version: *version,
-		Update:  *update,
-	}, nil
+	if err := validateRecommendationFlags(*term, *paymentOption, *lookbackDays); err != nil {
+		return model.Flags{}, err
+	}
+
+	command, err := parseCommand(flag.Args())
+	if err != nil {
+		return model.Flags{}, err
+	}
+
+	if err := validateServeFlags(command == "serve", *metrics, *interval); err != nil {
+		return model.Flags{}, err
+	}
+
+	outputs, err := parseOutputTargets(*output, *outFile, *templatePath)
+	if err != nil {
+		return model.Flags{}, err
+	}
+
+	flags := model.Flags{
+		Region:          *region,
+		Profile:         *profile,
+		Trend:           *trend,
+		Waste:           *waste,
+		Commitments:     *commitments,
+		Rightsizing:     *rightsizing,
+		Recommendations: *recommendations,
+		Term:            *term,
+		PaymentOption:   *paymentOption,
+		LookbackDays:    *lookbackDays,
+		Output:          *output,
+		OutFile:         *outFile,
+		Template:        *templatePath,
+		Outputs:         outputs,
+		Version:         *version,
+		Schema:          command == "schema",
+		Serve:           command == "serve",
+		TUI:             command == "tui",
+		Metrics:         *metrics,
+		Listen:          *listen,
+		Interval:        *interval,
+		Update:          *update,
+		NoIPRanges:      *noIPRanges,
+	}
+
+	if err := validateReportOutputs(flags); err != nil {
+		return model.Flags{}, err
+	}
+
+	return flags, nil
+}
+
+// parseCommand returns the command given after the global flags, if any. Flags following the
+// command, as in "serve --metrics", are parsed as well.
+func parseCommand(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+
+	command := args[0]
+
+	switch command {
+	case "schema", "serve", "tui":
+	default:
+		return "", fmt.Errorf("unknown command %q: must be schema, serve or tui", command)
+	}
+
+	if err := flag.CommandLine.Parse(args[1:]); err != nil {
+		return "", err
+	}
+
+	if flag.NArg() > 0 {
+		return "", fmt.Errorf("unexpected arguments after %s: %s", command, strings.Join(flag.Args(), " "))
+	}
+
+	return command, nil
+}
+
+func validateServeFlags(serve, metrics bool, interval time.Duration) error {
+	if !serve {
+		if metrics {
+			return fmt.Errorf("--metrics can only be used with the serve command")
+		}
+
+		return nil
+	}
+
+	if !metrics {
+		return fmt.Errorf("serve requires --metrics")
+	}
+
+	// Every run queries Cost Explorer, which is billed per request
+	if interval < minServeInterval {
+		return fmt.Errorf("invalid --interval %s: must be at least %s", interval, minServeInterval)
+	}
+
+	return nil
+}
+
+func validateRecommendationFlags(term int, paymentOption string, lookbackDays int) error {
+	if term != 1 && term != 3 {
+		return fmt.Errorf("invalid --term %d: must be 1 or 3", term)
+	}
+
+	switch paymentOption {
+	case model.PaymentOptionNoUpfront, model.PaymentOptionPartialUpfront, model.PaymentOptionAllUpfront:
+	default:
+		return fmt.Errorf("invalid --payment-option %q: must be no-upfront, partial-upfront or all-upfront", paymentOption)
+	}
+
+	if lookbackDays != 7 && lookbackDays != 30 && lookbackDays != 60 {
+		return fmt.Errorf("invalid --lookback-days %d: must be 7, 30 or 60", lookbackDays)
+	}
+
+	return nil
+}
+
+// reportFormats lists the --output formats of the reports that do not support every format
+var reportFormats = map[string][]string{
+	"--trend":           {"table", "json", "csv", "markdown", "pdf", "html", "template"},
+	"--commitments":     {"table", "json"},
+	"--recommendations": {"table", "json"},
+	"--rightsizing":     {"table", "json"},
+}
+
+// validateReportOutputs rejects --output formats the selected report cannot be rendered in, so the
+// report is not fetched from AWS only to fail when it is written
+func validateReportOutputs(flags model.Flags) error {
+	// These workflows do not render a report with --output
+	if flags.Update || flags.Version || flags.Schema || flags.Serve || flags.TUI {
+		return nil
+	}
+
+	// The report the orchestrator runs when several report flags are given
+	var report string
+
+	switch {
+	case flags.Waste:
+		return nil
+	case flags.Commitments:
+		report = "--commitments"
+	case flags.Recommendations:
+		report = "--recommendations"
+	case flags.Rightsizing:
+		report = "--rightsizing"
+	case flags.Trend:
+		report = "--trend"
+	default:
+		return nil
+	}
+
+	formats := reportFormats[report]
+
+	for _, target := range flags.Outputs {
+		if !slices.Contains(formats, target.Format) {
+			return fmt.Errorf("--output %s is not supported by the %s report: must be %s", target.Format, report, strings.Join(formats, ", "))
+		}
+	}
+
+	return nil
+}
+
+// parseOutputTargets turns the --output list (e.g. "table,json:report.json,csv:report.csv") into
+// output targets. --out-file is the destination of a single entry given without a path.
+func parseOutputTargets(output, outFile, templatePath string) ([]model.OutputTarget, error) {
+	entries := strings.Split(output, ",")
+	targets := make([]model.OutputTarget, 0, len(entries))
+
+	for _, entry := range entries {
+		format, path, _ := strings.Cut(strings.TrimSpace(entry), ":")
+
+		switch format {
+		case "table", "json", "csv", "markdown", "pdf", "html", "template", "openmetrics":
+		default:
+			return nil, fmt.Errorf("invalid --output format %q: must be table, json, csv, markdown, pdf, html, template or openmetrics", format)
+		}
+
+		targets = append(targets, model.OutputTarget{Format: format, Path: path})
+	}
+
+	if outFile != "" {
+		if len(targets) != 1 || targets[0].Path != "" {
+			return nil, fmt.Errorf("--out-file can only be used with a single --output format; use format:path instead")
+		}
+
+		targets[0].Path = outFile
+	}
+
+	stdoutTargets := 0
+
+	for _, target := range targets {
+		if target.Path == "" {
+			stdoutTargets++
+
+			if target.Format == "pdf" || target.Format == "html" {
+				return nil, fmt.Errorf("--output %s requires a file: use --out-file or %s:path", target.Format, target.Format)
+			}
+		}
+
+		if target.Format == "template" && templatePath == "" {
+			return nil, fmt.Errorf("--output template requires --template")
+		}
+	}
+
+	if stdoutTargets > 1 {
+		return nil, fmt.Errorf("only one --output format can be written to standard output; use format:path for the others")
+	}
+
+	return targets, nil
 }
@@ -4,7 +4,9 @@ import (
 	"flag"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/elC0mpa/aws-doctor/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,3 +27,263 @@ func TestGetParsedFlags(t *testing.T) {
 	assert.False(t, flags.Waste)
 	assert.False(t, flags.Version)
 }
+
+func TestGetParsedFlags_NoIPRanges(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	os.Args = []string{"cmd", "--waste", "--no-ip-ranges"}
+
+	flags, err := NewService().GetParsedFlags()
+
+	assert.NoError(t, err)
+	assert.True(t, flags.Waste)
+	assert.True(t, flags.NoIPRanges)
+}
+
+func TestGetParsedFlags_Recommendations(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	os.Args = []string{"cmd", "-recommendations", "-term", "3", "-payment-option", "partial-upfront", "-lookback-days", "60"}
+
+	svc := NewService()
+	flags, err := svc.GetParsedFlags()
+
+	assert.NoError(t, err)
+	assert.True(t, flags.Recommendations)
+	assert.Equal(t, 3, flags.Term)
+	assert.Equal(t, "partial-upfront", flags.PaymentOption)
+	assert.Equal(t, 60, flags.LookbackDays)
+}
+
+func TestGetParsedFlags_Schema(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	os.Args = []string{"cmd", "schema"}
+
+	svc := NewService()
+	flags, err := svc.GetParsedFlags()
+
+	assert.NoError(t, err)
+	assert.True(t, flags.Schema)
+}
+
+func TestGetParsedFlags_Serve(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	os.Args = []string{"cmd", "--profile", "prod", "serve", "--metrics", "--listen", ":9100", "--interval", "1h"}
+
+	svc := NewService()
+	flags, err := svc.GetParsedFlags()
+
+	assert.NoError(t, err)
+	assert.True(t, flags.Serve)
+	assert.True(t, flags.Metrics)
+	assert.Equal(t, "prod", flags.Profile)
+	assert.Equal(t, ":9100", flags.Listen)
+	assert.Equal(t, time.Hour, flags.Interval)
+}
+
+func TestGetParsedFlags_TUI(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	os.Args = []string{"cmd", "--region", "eu-west-1", "tui"}
+
+	svc := NewService()
+	flags, err := svc.GetParsedFlags()
+
+	assert.NoError(t, err)
+	assert.True(t, flags.TUI)
+	assert.False(t, flags.Serve)
+	assert.Equal(t, "eu-west-1", flags.Region)
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{name: "no_command"},
+		{name: "schema", args: []string{"schema"}, want: "schema"},
+		{name: "serve_with_flags", args: []string{"serve", "--metrics"}, want: "serve"},
+		{name: "tui", args: []string{"tui"}, want: "tui"},
+		{name: "unknown_command", args: []string{"schemas"}, wantErr: "unknown command"},
+		{name: "extra_arguments", args: []string{"schema", "extra"}, wantErr: "unexpected arguments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+			flag.Bool("metrics", false, "")
+
+			got, err := parseCommand(tt.args)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateServeFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		serve    bool
+		metrics  bool
+		interval time.Duration
+		wantErr  string
+	}{
+		{name: "no_serve", interval: 6 * time.Hour},
+		{name: "serve_metrics", serve: true, metrics: true, interval: 6 * time.Hour},
+		{name: "serve_without_metrics", serve: true, interval: 6 * time.Hour, wantErr: "requires --metrics"},
+		{name: "metrics_without_serve", metrics: true, interval: 6 * time.Hour, wantErr: "serve command"},
+		{name: "interval_too_short", serve: true, metrics: true, interval: time.Minute, wantErr: "--interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateServeFlags(tt.serve, tt.metrics, tt.interval)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateRecommendationFlags(t *testing.T) {
+	tests := []struct {
+		name          string
+		term          int
+		paymentOption string
+		lookbackDays  int
+		wantErr       string
+	}{
+		{name: "defaults", term: 1, paymentOption: "no-upfront", lookbackDays: 30},
+		{name: "invalid_term", term: 2, paymentOption: "no-upfront", lookbackDays: 30, wantErr: "--term"},
+		{name: "invalid_payment_option", term: 1, paymentOption: "monthly", lookbackDays: 30, wantErr: "--payment-option"},
+		{name: "invalid_lookback", term: 3, paymentOption: "all-upfront", lookbackDays: 14, wantErr: "--lookback-days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRecommendationFlags(tt.term, tt.paymentOption, tt.lookbackDays)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetParsedFlags_Outputs(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	os.Args = []string{"cmd", "-output", "table,json:report.json,csv:report.csv"}
+
+	svc := NewService()
+	flags, err := svc.GetParsedFlags()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.OutputTarget{
+		{Format: "table"},
+		{Format: "json", Path: "report.json"},
+		{Format: "csv", Path: "report.csv"},
+	}, flags.Outputs)
+}
+
+func TestParseOutputTargets(t *testing.T) {
+	tests := []struct {
+		name         string
+		output       string
+		outFile      string
+		templatePath string
+		want         []model.OutputTarget
+		wantErr      string
+	}{
+		{name: "table", output: "table", want: []model.OutputTarget{{Format: "table"}}},
+		{name: "json_with_out_file", output: "json", outFile: "report.json", want: []model.OutputTarget{{Format: "json", Path: "report.json"}}},
+		{name: "pdf_with_out_file", output: "pdf", outFile: "report.pdf", want: []model.OutputTarget{{Format: "pdf", Path: "report.pdf"}}},
+		{name: "pdf_with_path", output: "pdf:report.pdf", want: []model.OutputTarget{{Format: "pdf", Path: "report.pdf"}}},
+		{
+			name:   "multiple",
+			output: "table, json:out/report.json ,html:report.html",
+			want: []model.OutputTarget{
+				{Format: "table"},
+				{Format: "json", Path: "out/report.json"},
+				{Format: "html", Path: "report.html"},
+			},
+		},
+		{name: "pdf_without_out_file", output: "pdf", wantErr: "--out-file"},
+		{name: "html_without_out_file", output: "table,html", wantErr: "html:path"},
+		{name: "template_with_path", output: "template", templatePath: "report.tmpl", want: []model.OutputTarget{{Format: "template"}}},
+		{name: "template_without_path", output: "template", wantErr: "--template"},
+		{name: "openmetrics_to_file", output: "openmetrics:/var/lib/node_exporter/aws_doctor.prom", want: []model.OutputTarget{{Format: "openmetrics", Path: "/var/lib/node_exporter/aws_doctor.prom"}}},
+		{name: "unknown_format", output: "table,xml:report.xml", wantErr: "invalid --output format"},
+		{name: "out_file_with_multiple_formats", output: "table,json", outFile: "report.json", wantErr: "single --output format"},
+		{name: "out_file_with_path", output: "json:a.json", outFile: "b.json", wantErr: "single --output format"},
+		{name: "multiple_stdout_targets", output: "table,json", wantErr: "standard output"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOutputTargets(tt.output, tt.outFile, tt.templatePath)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetParsedFlags_UnsupportedReportOutput(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	os.Args = []string{"cmd", "-commitments", "-output", "csv"}
+
+	svc := NewService()
+	_, err := svc.GetParsedFlags()
+
+	assert.ErrorContains(t, err, "--output csv is not supported by the --commitments report: must be table, json")
+}
+
+func TestValidateReportOutputs(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   model.Flags
+		wantErr string
+	}{
+		{name: "cost_openmetrics", flags: model.Flags{Outputs: []model.OutputTarget{{Format: "openmetrics"}}}},
+		{name: "waste_openmetrics", flags: model.Flags{Waste: true, Outputs: []model.OutputTarget{{Format: "openmetrics"}}}},
+		{name: "trend_csv", flags: model.Flags{Trend: true, Outputs: []model.OutputTarget{{Format: "csv"}}}},
+		{name: "trend_openmetrics", flags: model.Flags{Trend: true, Outputs: []model.OutputTarget{{Format: "openmetrics"}}}, wantErr: "--output openmetrics is not supported by the --trend report"},
+		{name: "commitments_csv", flags: model.Flags{Commitments: true, Outputs: []model.OutputTarget{{Format: "csv", Path: "report.csv"}}}, wantErr: "--commitments report"},
+		{name: "recommendations_json", flags: model.Flags{Recommendations: true, Outputs: []model.OutputTarget{{Format: "table"}, {Format: "json", Path: "report.json"}}}},
+		{name: "rightsizing_pdf", flags: model.Flags{Rightsizing: true, Outputs: []model.OutputTarget{{Format: "table"}, {Format: "pdf", Path: "report.pdf"}}}, wantErr: "--output pdf is not supported by the --rightsizing report"},
+		{name: "waste_takes_precedence", flags: model.Flags{Waste: true, Trend: true, Outputs: []model.OutputTarget{{Format: "openmetrics"}}}},
+		{name: "tui_ignores_output", flags: model.Flags{TUI: true, Commitments: true, Outputs: []model.OutputTarget{{Format: "csv"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateReportOutputs(tt.flags)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Package kafka provides a service for interacting with Amazon Managed Streaming for Apache Kafka.
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Approximate on-demand hourly prices (us-east-1) per MSK broker.
+// Instance types missing from this table are reported without a cost estimate.
+var brokerHourlyCost = map[string]float64{
+	"kafka.t3.small":     0.0456,
+	"kafka.m5.large":     0.21,
+	"kafka.m5.xlarge":    0.42,
+	"kafka.m5.2xlarge":   0.84,
+	"kafka.m5.4xlarge":   1.68,
+	"kafka.m5.8xlarge":   3.36,
+	"kafka.m5.12xlarge":  5.04,
+	"kafka.m5.16xlarge":  6.72,
+	"kafka.m5.24xlarge":  10.08,
+	"kafka.m7g.large":    0.204,
+	"kafka.m7g.xlarge":   0.408,
+	"kafka.m7g.2xlarge":  0.816,
+	"kafka.m7g.4xlarge":  1.632,
+	"kafka.m7g.8xlarge":  3.264,
+	"kafka.m7g.12xlarge": 4.896,
+	"kafka.m7g.16xlarge": 6.528,
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// NewService creates a new MSK service.
+func NewService(awsconfig aws.Config) Service {
+	client := kafka.NewFromConfig(awsconfig)
+	cloudwatchClient := cloudwatch.NewFromConfig(awsconfig)
+
+	return &service{
+		client:           client,
+		cloudwatchClient: cloudwatchClient,
+	}
+}
+
+// GetIdleClusters returns active provisioned clusters older than observationDays whose brokers
+// together averaged less than bytesInThreshold bytes per second of incoming data in that period.
+func (s *service) GetIdleClusters(ctx context.Context, observationDays int, bytesInThreshold float64) ([]model.MSKClusterWasteInfo, error) {
+	var results []model.MSKClusterWasteInfo
+
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -observationDays)
+
+	paginator := kafka.NewListClustersPaginator(s.client, &kafka.ListClustersInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range output.ClusterInfoList {
+			if cluster.State != types.ClusterStateActive || aws.ToTime(cluster.CreationTime).After(startTime) {
+				continue
+			}
+
+			bytesIn, err := s.getBytesInPerSec(ctx, cluster, startTime, endTime)
+			if err != nil {
+				return nil, err
+			}
+
+			if info, isWaste := classifyCluster(cluster, bytesIn, observationDays, bytesInThreshold); isWaste {
+				results = append(results, info)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// getBytesInPerSec returns the average BytesInPerSec of a cluster summed across its brokers, or
+// -1 when CloudWatch has no datapoints for any broker.
+func (s *service) getBytesInPerSec(ctx context.Context, cluster types.ClusterInfo, startTime, endTime time.Time) (float64, error) {
+	total := -1.0
+
+	paginator := kafka.NewListNodesPaginator(s.client, &kafka.ListNodesInput{ClusterArn: cluster.ClusterArn})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, node := range output.NodeInfoList {
+			if node.BrokerNodeInfo == nil {
+				continue
+			}
+
+			brokerID := strconv.FormatFloat(aws.ToFloat64(node.BrokerNodeInfo.BrokerId), 'f', -1, 64)
+
+			metrics, err := s.cloudwatchClient.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
+				Namespace:  aws.String("AWS/Kafka"),
+				MetricName: aws.String("BytesInPerSec"),
+				Dimensions: []cwtypes.Dimension{
+					{Name: aws.String("Cluster Name"), Value: cluster.ClusterName},
+					{Name: aws.String("Broker ID"), Value: aws.String(brokerID)},
+				},
+				StartTime:  aws.Time(startTime),
+				EndTime:    aws.Time(endTime),
+				Period:     aws.Int32(secondsPerDay),
+				Statistics: []cwtypes.Statistic{cwtypes.StatisticAverage},
+			})
+			if err != nil {
+				return 0, err
+			}
+
+			if average, ok := averageDatapoints(metrics.Datapoints); ok {
+				total = max(total, 0) + average
+			}
+		}
+	}
+
+	return total, nil
+}
+
+func averageDatapoints(datapoints []cwtypes.Datapoint) (float64, bool) {
+	if len(datapoints) == 0 {
+		return 0, false
+	}
+
+	var total float64
+
+	for _, datapoint := range datapoints {
+		total += aws.ToFloat64(datapoint.Average)
+	}
+
+	return total / float64(len(datapoints)), true
+}
+
+// classifyCluster decides whether a cluster is idle. A negative bytesInPerSec means the metric
+// was not available and the cluster is not classified.
+func classifyCluster(cluster types.ClusterInfo, bytesInPerSec float64, observationDays int, bytesInThreshold float64) (model.MSKClusterWasteInfo, bool) {
+	if bytesInPerSec < 0 || bytesInPerSec >= bytesInThreshold {
+		return model.MSKClusterWasteInfo{}, false
+	}
+
+	var instanceType string
+	if cluster.BrokerNodeGroupInfo != nil {
+		instanceType = aws.ToString(cluster.BrokerNodeGroupInfo.InstanceType)
+	}
+
+	brokerCount := aws.ToInt32(cluster.NumberOfBrokerNodes)
+
+	return model.MSKClusterWasteInfo{
+		ClusterName:          aws.ToString(cluster.ClusterName),
+		ClusterArn:           aws.ToString(cluster.ClusterArn),
+		InstanceType:         instanceType,
+		BrokerCount:          brokerCount,
+		AverageBytesInPerSec: bytesInPerSec,
+		ObservationDays:      observationDays,
+		HourlyCost:           brokerHourlyCost[instanceType] * float64(brokerCount),
+	}, true
+}
@@ -0,0 +1,74 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+)
+
+func TestClassifyCluster(t *testing.T) {
+	cluster := types.ClusterInfo{
+		ClusterName:         aws.String("orders"),
+		ClusterArn:          aws.String("arn:aws:kafka:us-east-1:123456789012:cluster/orders/abc"),
+		NumberOfBrokerNodes: aws.Int32(3),
+		BrokerNodeGroupInfo: &types.BrokerNodeGroupInfo{InstanceType: aws.String("kafka.m5.large")},
+	}
+
+	tests := []struct {
+		name           string
+		cluster        types.ClusterInfo
+		bytesIn        float64
+		wantWaste      bool
+		wantHourlyCost float64
+	}{
+		{name: "idle", cluster: cluster, bytesIn: 12, wantWaste: true, wantHourlyCost: 0.63},
+		{name: "busy", cluster: cluster, bytesIn: 50000, wantWaste: false},
+		{name: "not_measured", cluster: cluster, bytesIn: -1, wantWaste: false},
+		{
+			name: "unpriced_instance_type",
+			cluster: types.ClusterInfo{
+				ClusterName:         aws.String("legacy"),
+				NumberOfBrokerNodes: aws.Int32(2),
+				BrokerNodeGroupInfo: &types.BrokerNodeGroupInfo{InstanceType: aws.String("kafka.unknown")},
+			},
+			bytesIn:        0,
+			wantWaste:      true,
+			wantHourlyCost: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, isWaste := classifyCluster(tt.cluster, tt.bytesIn, 14, 1024)
+
+			if isWaste != tt.wantWaste {
+				t.Fatalf("classifyCluster() waste = %v, want %v", isWaste, tt.wantWaste)
+			}
+
+			if !tt.wantWaste {
+				return
+			}
+
+			if info.ClusterName != aws.ToString(tt.cluster.ClusterName) || info.BrokerCount != aws.ToInt32(tt.cluster.NumberOfBrokerNodes) {
+				t.Errorf("unexpected cluster info: %+v", info)
+			}
+
+			if diff := info.HourlyCost - tt.wantHourlyCost; diff > 0.001 || diff < -0.001 {
+				t.Errorf("HourlyCost = %v, want %v", info.HourlyCost, tt.wantHourlyCost)
+			}
+		})
+	}
+}
+
+func TestAverageDatapoints(t *testing.T) {
+	if _, ok := averageDatapoints(nil); ok {
+		t.Error("averageDatapoints(nil) should report no data")
+	}
+
+	average, ok := averageDatapoints([]cwtypes.Datapoint{{Average: aws.Float64(10)}, {Average: aws.Float64(20)}})
+	if !ok || average != 15 {
+		t.Errorf("averageDatapoints() = (%v, %v), want (15, true)", average, ok)
+	}
+}
@@ -0,0 +1,19 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client           *kafka.Client
+	cloudwatchClient *cloudwatch.Client
+}
+
+// Service defines the interface for Amazon Managed Streaming for Apache Kafka service.
+type Service interface {
+	GetIdleClusters(ctx context.Context, observationDays int, bytesInThreshold float64) ([]model.MSKClusterWasteInfo, error)
+}
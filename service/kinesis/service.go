@@ -0,0 +1,198 @@
+// Package kinesis provides a service for interacting with Amazon Kinesis Data Streams.
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Kinesis Data Streams pricing (us-east-1): ~$0.015 per shard hour for provisioned streams and
+// ~$0.04 per stream hour for on-demand streams, excluding data charges
+const (
+	shardHourlyCost          = 0.015
+	onDemandStreamHourlyCost = 0.04
+	secondsPerHour           = 60 * 60
+)
+
+// Each shard accepts up to 1 MB/s and 1,000 records/s of writes. Suggested shard counts keep
+// headroom over the observed hourly peak, and a stream is over-provisioned when it has at least
+// overProvisionFactor times the suggested shard count.
+const (
+	shardBytesPerSec    = 1024 * 1024
+	shardRecordsPerSec  = 1000
+	shardHeadroom       = 2.0
+	overProvisionFactor = 2
+	// Traffic whose hourly peak is this many times its average is better served by on-demand mode
+	spikyTrafficRatio = 4.0
+)
+
+// NewService creates a new Kinesis service.
+func NewService(awsconfig aws.Config) Service {
+	client := kinesis.NewFromConfig(awsconfig)
+	cloudwatchClient := cloudwatch.NewFromConfig(awsconfig)
+
+	return &service{
+		client:           client,
+		cloudwatchClient: cloudwatchClient,
+	}
+}
+
+// GetStreamWaste returns active streams older than observationDays that had no enhanced fan-out
+// consumers and no GetRecords reads during that period, and provisioned streams whose shard count
+// far exceeds their peak IncomingBytes and IncomingRecords.
+func (s *service) GetStreamWaste(ctx context.Context, observationDays int) ([]model.KinesisStreamWasteInfo, error) {
+	var results []model.KinesisStreamWasteInfo
+
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -observationDays)
+
+	paginator := kinesis.NewListStreamsPaginator(s.client, &kinesis.ListStreamsInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stream := range output.StreamSummaries {
+			if stream.StreamStatus != types.StreamStatusActive || aws.ToTime(stream.StreamCreationTimestamp).After(startTime) {
+				continue
+			}
+
+			described, err := s.client.DescribeStreamSummary(ctx, &kinesis.DescribeStreamSummaryInput{
+				StreamARN: stream.StreamARN,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			streamName := aws.ToString(stream.StreamName)
+
+			incomingBytes, err := s.getHourlySums(ctx, "IncomingBytes", streamName, startTime, endTime)
+			if err != nil {
+				return nil, err
+			}
+
+			incomingRecords, err := s.getHourlySums(ctx, "IncomingRecords", streamName, startTime, endTime)
+			if err != nil {
+				return nil, err
+			}
+
+			readRecords, err := s.getHourlySums(ctx, "GetRecords.Records", streamName, startTime, endTime)
+			if err != nil {
+				return nil, err
+			}
+
+			if info, isWaste := classifyStream(*described.StreamDescriptionSummary, incomingBytes, incomingRecords, readRecords, observationDays); isWaste {
+				results = append(results, info)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// getHourlySums returns one Sum datapoint per hour for a stream-level AWS/Kinesis metric.
+func (s *service) getHourlySums(ctx context.Context, metricName, streamName string, startTime, endTime time.Time) ([]cwtypes.Datapoint, error) {
+	output, err := s.cloudwatchClient.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/Kinesis"),
+		MetricName: aws.String(metricName),
+		Dimensions: []cwtypes.Dimension{
+			{Name: aws.String("StreamName"), Value: aws.String(streamName)},
+		},
+		StartTime:  aws.Time(startTime),
+		EndTime:    aws.Time(endTime),
+		Period:     aws.Int32(secondsPerHour),
+		Statistics: []cwtypes.Statistic{cwtypes.StatisticSum},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Datapoints, nil
+}
+
+// classifyStream decides whether a stream is waste from hourly sums of its write and read
+// metrics. A stream without consumers is reported regardless of its capacity; otherwise only
+// provisioned streams can be over-provisioned. Averages are taken over the whole observation
+// period since CloudWatch omits hours without data.
+func classifyStream(summary types.StreamDescriptionSummary, incomingBytes, incomingRecords, readRecords []cwtypes.Datapoint, observationDays int) (model.KinesisStreamWasteInfo, bool) {
+	peakBytes, totalBytes := peakAndTotal(incomingBytes)
+	peakRecords, totalRecords := peakAndTotal(incomingRecords)
+	_, totalReads := peakAndTotal(readRecords)
+
+	mode := types.StreamModeProvisioned
+	if summary.StreamModeDetails != nil {
+		mode = summary.StreamModeDetails.StreamMode
+	}
+
+	shardCount := aws.ToInt32(summary.OpenShardCount)
+
+	info := model.KinesisStreamWasteInfo{
+		StreamName:                aws.ToString(summary.StreamName),
+		StreamMode:                string(mode),
+		ShardCount:                shardCount,
+		PeakIncomingBytesPerSec:   peakBytes,
+		PeakIncomingRecordsPerSec: peakRecords,
+		ObservationDays:           observationDays,
+		HourlyCost:                float64(shardCount) * shardHourlyCost,
+	}
+
+	if mode == types.StreamModeOnDemand {
+		info.HourlyCost = onDemandStreamHourlyCost
+	}
+
+	if aws.ToInt32(summary.ConsumerCount) == 0 && totalReads == 0 {
+		info.Status = model.KinesisStreamNoConsumers
+		info.Recommendation = "Delete the stream if no application reads from it"
+
+		return info, true
+	}
+
+	if mode != types.StreamModeProvisioned {
+		return info, false
+	}
+
+	required := max(peakBytes/shardBytesPerSec, peakRecords/shardRecordsPerSec) * shardHeadroom
+	suggested := max(int32(math.Ceil(required)), 1)
+
+	if shardCount < suggested*overProvisionFactor {
+		return info, false
+	}
+
+	info.Status = model.KinesisStreamOverProvisioned
+	info.SuggestedShardCount = suggested
+	info.Recommendation = fmt.Sprintf("Reduce the shard count to %d", suggested)
+
+	observedSeconds := float64(observationDays * 24 * secondsPerHour)
+	averageBytes := totalBytes / observedSeconds
+	averageRecords := totalRecords / observedSeconds
+
+	if peakBytes >= spikyTrafficRatio*averageBytes && peakRecords >= spikyTrafficRatio*averageRecords && totalRecords > 0 {
+		info.Recommendation = fmt.Sprintf("Switch to on-demand mode (spiky traffic) or reduce the shard count to %d", suggested)
+	}
+
+	return info, true
+}
+
+// peakAndTotal returns the highest per-second rate of hourly Sum datapoints and their total.
+func peakAndTotal(datapoints []cwtypes.Datapoint) (float64, float64) {
+	var peak, total float64
+
+	for _, datapoint := range datapoints {
+		sum := aws.ToFloat64(datapoint.Sum)
+		peak = max(peak, sum)
+		total += sum
+	}
+
+	return peak / secondsPerHour, total
+}
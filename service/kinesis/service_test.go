@@ -0,0 +1,135 @@
+package kinesis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// hourlySums returns count datapoints with the same hourly Sum
+func hourlySums(sum float64, count int) []cwtypes.Datapoint {
+	datapoints := make([]cwtypes.Datapoint, count)
+	for i := range datapoints {
+		datapoints[i] = cwtypes.Datapoint{Sum: aws.Float64(sum)}
+	}
+
+	return datapoints
+}
+
+func TestClassifyStream(t *testing.T) {
+	const hours = 14 * 24
+
+	provisioned := func(shards, consumers int32) types.StreamDescriptionSummary {
+		return types.StreamDescriptionSummary{
+			StreamName:        aws.String("events"),
+			StreamModeDetails: &types.StreamModeDetails{StreamMode: types.StreamModeProvisioned},
+			OpenShardCount:    aws.Int32(shards),
+			ConsumerCount:     aws.Int32(consumers),
+		}
+	}
+
+	onDemand := types.StreamDescriptionSummary{
+		StreamName:        aws.String("clicks"),
+		StreamModeDetails: &types.StreamModeDetails{StreamMode: types.StreamModeOnDemand},
+		OpenShardCount:    aws.Int32(4),
+	}
+
+	tests := []struct {
+		name               string
+		summary            types.StreamDescriptionSummary
+		bytes              []cwtypes.Datapoint
+		records            []cwtypes.Datapoint
+		reads              []cwtypes.Datapoint
+		wantWaste          bool
+		wantStatus         string
+		wantSuggested      int32
+		wantOnDemandAdvice bool
+		wantHourlyCost     float64
+	}{
+		{
+			name:           "no_consumers",
+			summary:        provisioned(10, 0),
+			bytes:          hourlySums(3600*1024, hours),
+			records:        hourlySums(3600*10, hours),
+			wantWaste:      true,
+			wantStatus:     model.KinesisStreamNoConsumers,
+			wantHourlyCost: 0.15,
+		},
+		{
+			name:           "on_demand_without_consumers",
+			summary:        onDemand,
+			wantWaste:      true,
+			wantStatus:     model.KinesisStreamNoConsumers,
+			wantHourlyCost: onDemandStreamHourlyCost,
+		},
+		{
+			name:      "on_demand_with_readers",
+			summary:   onDemand,
+			reads:     hourlySums(100, hours),
+			wantWaste: false,
+		},
+		{
+			name:      "right_sized",
+			summary:   provisioned(10, 0),
+			bytes:     hourlySums(3600*4*1024*1024, hours),
+			records:   hourlySums(3600*500, hours),
+			reads:     hourlySums(3600*500, hours),
+			wantWaste: false,
+		},
+		{
+			name:           "over_provisioned_steady",
+			summary:        provisioned(10, 1),
+			bytes:          hourlySums(3600*100*1024, hours),
+			records:        hourlySums(3600*50, hours),
+			wantWaste:      true,
+			wantStatus:     model.KinesisStreamOverProvisioned,
+			wantSuggested:  1,
+			wantHourlyCost: 0.15,
+		},
+		{
+			name:               "over_provisioned_spiky",
+			summary:            provisioned(8, 1),
+			bytes:              hourlySums(3600*900*1024, 2),
+			records:            hourlySums(3600*900, 2),
+			wantWaste:          true,
+			wantStatus:         model.KinesisStreamOverProvisioned,
+			wantSuggested:      2,
+			wantOnDemandAdvice: true,
+			wantHourlyCost:     0.12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, isWaste := classifyStream(tt.summary, tt.bytes, tt.records, tt.reads, 14)
+
+			if isWaste != tt.wantWaste {
+				t.Fatalf("classifyStream() waste = %v, want %v", isWaste, tt.wantWaste)
+			}
+
+			if !tt.wantWaste {
+				return
+			}
+
+			if info.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", info.Status, tt.wantStatus)
+			}
+
+			if info.SuggestedShardCount != tt.wantSuggested {
+				t.Errorf("SuggestedShardCount = %d, want %d", info.SuggestedShardCount, tt.wantSuggested)
+			}
+
+			if got := strings.Contains(info.Recommendation, "on-demand"); got != tt.wantOnDemandAdvice {
+				t.Errorf("Recommendation = %q, on-demand advice = %v, want %v", info.Recommendation, got, tt.wantOnDemandAdvice)
+			}
+
+			if diff := info.HourlyCost - tt.wantHourlyCost; diff > 0.001 || diff < -0.001 {
+				t.Errorf("HourlyCost = %v, want %v", info.HourlyCost, tt.wantHourlyCost)
+			}
+		})
+	}
+}
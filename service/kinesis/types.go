@@ -0,0 +1,19 @@
+package kinesis
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client           *kinesis.Client
+	cloudwatchClient *cloudwatch.Client
+}
+
+// Service defines the interface for Amazon Kinesis Data Streams service.
+type Service interface {
+	GetStreamWaste(ctx context.Context, observationDays int) ([]model.KinesisStreamWasteInfo, error)
+}
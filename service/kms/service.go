@@ -0,0 +1,147 @@
+// Package kms provides a service for interacting with AWS Key Management Service.
+package kms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// KMS pricing: $1 per customer managed key per month
+const keyMonthlyCost = 1.0
+
+// CloudTrail LookupEvents is limited to two requests per second per account and region, a quota
+// shared with the CloudTrail waste check, so key lookups run one at a time at half that rate.
+const lookupInterval = time.Second
+
+// keyActivity is what CloudTrail event history tells about the use of a key.
+type keyActivity int
+
+const (
+	activityNone keyActivity = iota
+	activitySeen
+	activityUnknown // The lookup failed or was throttled
+)
+
+// NewService creates a new KMS service.
+func NewService(awsconfig aws.Config) Service {
+	client := kms.NewFromConfig(awsconfig)
+	cloudtrailClient := cloudtrail.NewFromConfig(awsconfig)
+
+	return &service{
+		client:           client,
+		cloudtrailClient: cloudtrailClient,
+	}
+}
+
+// GetUnusedKeys returns customer managed keys that are disabled but not scheduled for deletion,
+// and enabled keys older than unusedDays with no CloudTrail events in that period. CloudTrail
+// event history only covers the last 90 days, so larger values are capped. Keys whose event
+// lookup fails are reported with an unknown usage instead of failing the whole check.
+func (s *service) GetUnusedKeys(ctx context.Context, unusedDays int) ([]model.KMSKeyWasteInfo, error) {
+	var results []model.KMSKeyWasteInfo
+
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -min(unusedDays, 90))
+
+	limiter := time.NewTicker(lookupInterval)
+	defer limiter.Stop()
+
+	paginator := kms.NewListKeysPaginator(s.client, &kms.ListKeysInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range output.Keys {
+			described, err := s.client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: key.KeyId})
+			if err != nil {
+				return nil, err
+			}
+
+			metadata := described.KeyMetadata
+
+			activity := activityNone
+
+			if metadata.KeyManager == types.KeyManagerTypeCustomer && metadata.KeyState == types.KeyStateEnabled &&
+				aws.ToTime(metadata.CreationDate).Before(startTime) {
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-limiter.C:
+				}
+
+				activity = s.lookupKeyActivity(ctx, aws.ToString(metadata.Arn), startTime, endTime)
+			}
+
+			if info, isWaste := classifyKey(*metadata, activity, startTime); isWaste {
+				results = append(results, info)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// lookupKeyActivity reports whether CloudTrail recorded any event referencing the key between startTime and endTime.
+func (s *service) lookupKeyActivity(ctx context.Context, keyArn string, startTime, endTime time.Time) keyActivity {
+	output, err := s.cloudtrailClient.LookupEvents(ctx, &cloudtrail.LookupEventsInput{
+		LookupAttributes: []cttypes.LookupAttribute{
+			{AttributeKey: cttypes.LookupAttributeKeyResourceName, AttributeValue: aws.String(keyArn)},
+		},
+		StartTime:  aws.Time(startTime),
+		EndTime:    aws.Time(endTime),
+		MaxResults: aws.Int32(1),
+	})
+	if err != nil {
+		return activityUnknown
+	}
+
+	if len(output.Events) > 0 {
+		return activitySeen
+	}
+
+	return activityNone
+}
+
+// classifyKey decides whether a key is waste. AWS managed keys and keys pending deletion are
+// never reported; enabled keys created after observedSince are too new to be judged unused.
+// Keys whose activity is unknown are reported without a savings estimate.
+func classifyKey(metadata types.KeyMetadata, activity keyActivity, observedSince time.Time) (model.KMSKeyWasteInfo, bool) {
+	info := model.KMSKeyWasteInfo{
+		KeyID:        aws.ToString(metadata.KeyId),
+		ARN:          aws.ToString(metadata.Arn),
+		Description:  aws.ToString(metadata.Description),
+		KeyState:     string(metadata.KeyState),
+		CreationDate: aws.ToTime(metadata.CreationDate),
+		MonthlyCost:  keyMonthlyCost,
+	}
+
+	if metadata.KeyManager != types.KeyManagerTypeCustomer {
+		return info, false
+	}
+
+	switch {
+	case metadata.KeyState == types.KeyStateDisabled:
+		info.Status = model.KMSKeyDisabled
+	case metadata.KeyState != types.KeyStateEnabled || !info.CreationDate.Before(observedSince):
+		return info, false
+	case activity == activityNone:
+		info.Status = model.KMSKeyUnused
+	case activity == activityUnknown:
+		info.Status = model.KMSKeyUsageUnknown
+		info.MonthlyCost = 0
+	default:
+		return info, false
+	}
+
+	return info, true
+}
@@ -0,0 +1,97 @@
+package kms
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestClassifyKey(t *testing.T) {
+	observedSince := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	oldKey := aws.Time(observedSince.AddDate(-1, 0, 0))
+	newKey := aws.Time(observedSince.AddDate(0, 0, 10))
+
+	tests := []struct {
+		name       string
+		metadata   types.KeyMetadata
+		activity   keyActivity
+		wantWaste  bool
+		wantStatus string
+		wantCost   float64
+	}{
+		{
+			name:       "disabled",
+			metadata:   types.KeyMetadata{KeyManager: types.KeyManagerTypeCustomer, KeyState: types.KeyStateDisabled, CreationDate: newKey},
+			wantWaste:  true,
+			wantStatus: model.KMSKeyDisabled,
+			wantCost:   1,
+		},
+		{
+			name:       "enabled_without_events",
+			metadata:   types.KeyMetadata{KeyManager: types.KeyManagerTypeCustomer, KeyState: types.KeyStateEnabled, CreationDate: oldKey},
+			wantWaste:  true,
+			wantStatus: model.KMSKeyUnused,
+			wantCost:   1,
+		},
+		{
+			name:      "enabled_and_used",
+			metadata:  types.KeyMetadata{KeyManager: types.KeyManagerTypeCustomer, KeyState: types.KeyStateEnabled, CreationDate: oldKey},
+			activity:  activitySeen,
+			wantWaste: false,
+		},
+		{
+			name:       "lookup_failed",
+			metadata:   types.KeyMetadata{KeyManager: types.KeyManagerTypeCustomer, KeyState: types.KeyStateEnabled, CreationDate: oldKey},
+			activity:   activityUnknown,
+			wantWaste:  true,
+			wantStatus: model.KMSKeyUsageUnknown,
+			wantCost:   0,
+		},
+		{
+			name:      "lookup_failed_for_new_key",
+			metadata:  types.KeyMetadata{KeyManager: types.KeyManagerTypeCustomer, KeyState: types.KeyStateEnabled, CreationDate: newKey},
+			activity:  activityUnknown,
+			wantWaste: false,
+		},
+		{
+			name:      "enabled_and_new",
+			metadata:  types.KeyMetadata{KeyManager: types.KeyManagerTypeCustomer, KeyState: types.KeyStateEnabled, CreationDate: newKey},
+			wantWaste: false,
+		},
+		{
+			name:      "pending_deletion",
+			metadata:  types.KeyMetadata{KeyManager: types.KeyManagerTypeCustomer, KeyState: types.KeyStatePendingDeletion, CreationDate: oldKey},
+			wantWaste: false,
+		},
+		{
+			name:      "aws_managed",
+			metadata:  types.KeyMetadata{KeyManager: types.KeyManagerTypeAws, KeyState: types.KeyStateDisabled, CreationDate: oldKey},
+			wantWaste: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, isWaste := classifyKey(tt.metadata, tt.activity, observedSince)
+
+			if isWaste != tt.wantWaste {
+				t.Fatalf("classifyKey() waste = %v, want %v", isWaste, tt.wantWaste)
+			}
+
+			if !tt.wantWaste {
+				return
+			}
+
+			if info.Status != tt.wantStatus {
+				t.Errorf("classifyKey() status = %q, want %q", info.Status, tt.wantStatus)
+			}
+
+			if info.MonthlyCost != tt.wantCost {
+				t.Errorf("classifyKey() cost = %.2f, want %.2f", info.MonthlyCost, tt.wantCost)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client           *kms.Client
+	cloudtrailClient *cloudtrail.Client
+}
+
+// Service defines the interface for AWS KMS service.
+type Service interface {
+	GetUnusedKeys(ctx context.Context, unusedDays int) ([]model.KMSKeyWasteInfo, error)
+}
@@ -0,0 +1,163 @@
+// Package metrics provides a service exposing aws-doctor results as Prometheus gauges.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/elC0mpa/aws-doctor/utils"
+)
+
+// shutdownTimeout bounds how long in-flight scrapes may take once the exporter is stopped
+const shutdownTimeout = 5 * time.Second
+
+// NewService creates a new metrics service; waste gauges are labelled with region
+func NewService(region string) Service {
+	return &service{
+		region:   region,
+		now:      time.Now,
+		families: make(map[string][]model.MetricFamily),
+		runs:     make(map[string]runStatus),
+	}
+}
+
+func (s *service) RecordCostComparison(accountID, lastTotalCost, currentTotalCost string, lastMonth, currentMonth *model.CostInfo, forecast *model.CostForecast) {
+	s.record(WorkflowCost, utils.BuildCostMetrics(
+		accountID,
+		utils.ParseCostString(lastTotalCost),
+		utils.ParseCostString(currentTotalCost),
+		lastMonth,
+		currentMonth,
+		forecast,
+	))
+}
+
+func (s *service) RecordWaste(accountID string, report model.WasteReport) {
+	s.record(WorkflowWaste, utils.BuildWasteMetrics(accountID, s.region, report))
+}
+
+func (s *service) RecordFailure(workflow string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := s.runs[workflow]
+	status.lastRun = s.now()
+	status.succeeded = false
+	s.runs[workflow] = status
+}
+
+func (s *service) record(workflow string, families []model.MetricFamily) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.families[workflow] = families
+	s.runs[workflow] = runStatus{lastRun: now, lastSuccess: now, succeeded: true}
+}
+
+func (s *service) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /metrics", s.serveMetrics)
+
+	return mux
+}
+
+func (s *service) Serve(ctx context.Context, addr string) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("metrics server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to stop metrics server: %w", err)
+	}
+
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("metrics server failed: %w", err)
+	}
+
+	return nil
+}
+
+// serveMetrics writes the gauges in the OpenMetrics format when the scraper accepts it and in
+// the Prometheus text format otherwise
+func (s *service) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+
+	contentType := utils.PrometheusContentType
+	if openMetrics {
+		contentType = utils.OpenMetricsContentType
+	}
+
+	w.Header().Set("Content-Type", contentType)
+
+	_ = utils.WriteMetrics(w, s.snapshot(), openMetrics)
+}
+
+// snapshot returns the gauges of every workflow followed by the status of their latest runs
+func (s *service) snapshot() []model.MetricFamily {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var families []model.MetricFamily
+	for _, workflow := range workflows {
+		families = append(families, s.families[workflow]...)
+	}
+
+	success := model.MetricFamily{
+		Name: "aws_doctor_last_run_success",
+		Help: "Whether the latest run of the workflow succeeded (1) or failed (0).",
+	}
+	lastRun := model.MetricFamily{
+		Name: "aws_doctor_last_run_timestamp_seconds",
+		Help: "Unix time of the latest run of the workflow.",
+	}
+	lastSuccess := model.MetricFamily{
+		Name: "aws_doctor_last_success_timestamp_seconds",
+		Help: "Unix time of the latest successful run of the workflow.",
+	}
+
+	for _, workflow := range workflows {
+		status, ok := s.runs[workflow]
+		if !ok {
+			continue
+		}
+
+		labels := []model.MetricLabel{{Name: "workflow", Value: workflow}}
+
+		succeeded := 0.0
+		if status.succeeded {
+			succeeded = 1
+		}
+
+		success.Samples = append(success.Samples, model.MetricSample{Labels: labels, Value: succeeded})
+		lastRun.Samples = append(lastRun.Samples, model.MetricSample{Labels: labels, Value: float64(status.lastRun.Unix())})
+
+		if !status.lastSuccess.IsZero() {
+			lastSuccess.Samples = append(lastSuccess.Samples, model.MetricSample{Labels: labels, Value: float64(status.lastSuccess.Unix())})
+		}
+	}
+
+	return append(families, success, lastRun, lastSuccess)
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestService() *service {
+	s := NewService("us-east-1").(*service)
+	s.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	return s
+}
+
+func scrape(t *testing.T, s *service, accept string) (string, string) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	return rec.Body.String(), rec.Header().Get("Content-Type")
+}
+
+func TestServeMetrics_BeforeFirstRun(t *testing.T) {
+	body, contentType := scrape(t, newTestService(), "")
+
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", contentType)
+	assert.NotContains(t, body, "aws_doctor_cost_month_to_date_usd")
+	assert.Contains(t, body, "# TYPE aws_doctor_last_run_success gauge")
+}
+
+func TestServeMetrics_RecordedResults(t *testing.T) {
+	s := newTestService()
+
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 120, Unit: "USD"}}}
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 100, Unit: "USD"}}}
+
+	s.RecordCostComparison("123456789012", "100.00 USD", "120.00 USD", lastMonth, currentMonth, &model.CostForecast{Amount: 30, Unit: "USD"})
+	s.RecordWaste("123456789012", model.WasteReport{
+		KMSKeys: []model.KMSKeyWasteInfo{{KeyID: "key-1", MonthlyCost: 1}},
+	})
+
+	body, _ := scrape(t, s, "")
+
+	for _, want := range []string{
+		`aws_doctor_cost_month_to_date_usd{account_id="123456789012"} 120`,
+		`aws_doctor_cost_forecast_usd{account_id="123456789012"} 150`,
+		`aws_doctor_service_cost_month_to_date_usd{account_id="123456789012",service="Amazon EC2"} 120`,
+		`aws_doctor_waste_resources{account_id="123456789012",region="us-east-1",category="unused_kms_keys"} 1`,
+		`aws_doctor_waste_potential_savings_usd{account_id="123456789012",region="us-east-1",category="unused_kms_keys"} 1`,
+		`aws_doctor_last_run_success{workflow="cost"} 1`,
+		`aws_doctor_last_run_timestamp_seconds{workflow="waste"} 1700000000`,
+	} {
+		assert.Contains(t, body, want)
+	}
+
+	assert.NotContains(t, body, "# EOF")
+}
+
+func TestServeMetrics_OpenMetrics(t *testing.T) {
+	body, contentType := scrape(t, newTestService(), "application/openmetrics-text;version=1.0.0,text/plain;q=0.5")
+
+	assert.Equal(t, "application/openmetrics-text; version=1.0.0; charset=utf-8", contentType)
+	assert.True(t, strings.HasSuffix(body, "# EOF\n"))
+}
+
+func TestRecordFailure_KeepsPreviousResults(t *testing.T) {
+	s := newTestService()
+
+	s.RecordWaste("123456789012", model.WasteReport{})
+
+	s.now = func() time.Time { return time.Unix(1700003600, 0) }
+	s.RecordFailure(WorkflowWaste)
+
+	body, _ := scrape(t, s, "")
+
+	assert.Contains(t, body, `aws_doctor_waste_resources{account_id="123456789012",region="us-east-1",category="unused_kms_keys"} 0`)
+	assert.Contains(t, body, `aws_doctor_last_run_success{workflow="waste"} 0`)
+	assert.Contains(t, body, `aws_doctor_last_run_timestamp_seconds{workflow="waste"} 1700003600`)
+	assert.Contains(t, body, `aws_doctor_last_success_timestamp_seconds{workflow="waste"} 1700000000`)
+}
+
+func TestHandler_UnknownPath(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestService().Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
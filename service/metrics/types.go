@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Workflows whose results are exposed as metrics
+const (
+	WorkflowCost  = "cost"
+	WorkflowWaste = "waste"
+)
+
+// workflows lists the workflows in the order their gauges are exposed
+var workflows = []string{WorkflowCost, WorkflowWaste}
+
+// runStatus records the outcome of the latest run of a workflow
+type runStatus struct {
+	lastRun     time.Time
+	lastSuccess time.Time
+	succeeded   bool
+}
+
+// service is the internal implementation
+type service struct {
+	region string
+	now    func() time.Time
+
+	mu       sync.RWMutex
+	families map[string][]model.MetricFamily // Latest gauges of each workflow
+	runs     map[string]runStatus
+}
+
+// Service defines the interface for the Prometheus metrics exporter
+type Service interface {
+	// RecordCostComparison replaces the cost gauges with the latest cost comparison and forecast
+	RecordCostComparison(accountID, lastTotalCost, currentTotalCost string, lastMonth, currentMonth *model.CostInfo, forecast *model.CostForecast)
+
+	// RecordWaste replaces the waste gauges with the latest waste report
+	RecordWaste(accountID string, report model.WasteReport)
+
+	// RecordFailure marks the latest run of a workflow as failed; its previous gauges are kept
+	RecordFailure(workflow string)
+
+	// Handler returns the HTTP handler exposing the gauges on /metrics
+	Handler() http.Handler
+
+	// Serve exposes the gauges on addr until ctx is cancelled
+	Serve(ctx context.Context, addr string) error
+}
@@ -3,31 +3,101 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
 	"github.com/elC0mpa/aws-doctor/model"
-	awscostexplorer "github.com/elC0mpa/aws-doctor/service/costexplorer"
-	awsec2 "github.com/elC0mpa/aws-doctor/service/ec2"
-	"github.com/elC0mpa/aws-doctor/service/elb"
-	"github.com/elC0mpa/aws-doctor/service/output"
-	awssts "github.com/elC0mpa/aws-doctor/service/sts"
-	"github.com/elC0mpa/aws-doctor/service/update"
+	"github.com/elC0mpa/aws-doctor/service/metrics"
+	"github.com/elC0mpa/aws-doctor/service/tui"
 	"golang.org/x/sync/errgroup"
 )
 
+// capacityReservationUtilizationThreshold is the utilization percentage below which
+// a capacity reservation is reported as underutilized.
+const capacityReservationUtilizationThreshold = 50.0
+
+// Commitment analysis settings: utilization is measured over the last
+// commitmentLookbackDays days and Savings Plans expiring within
+// commitmentExpiryDays days are reported.
+const (
+	commitmentLookbackDays         = 30
+	commitmentUtilizationThreshold = 80.0
+	commitmentExpiryDays           = 30
+)
+
+// sageMakerIdleDays is the number of days without invocations after which SageMaker endpoints are
+// reported as idle, and sageMakerNotebookRestartDays the number of days a notebook instance may run
+// without restart before it is reported.
+const (
+	sageMakerIdleDays            = 14
+	sageMakerNotebookRestartDays = 14
+)
+
+// Redshift analysis settings: clusters are observed over the last redshiftObservationDays
+// days and manual snapshots older than redshiftSnapshotStaleDays days are reported.
+const (
+	redshiftObservationDays   = 14
+	redshiftCPUThreshold      = 5.0
+	redshiftSnapshotStaleDays = 90
+)
+
+// EFS analysis settings: mounted file systems without client connections in the last
+// efsIdleDays days are reported, as are file systems storing more than
+// efsStandardSizeThresholdGB of Standard-class data without an Infrequent Access lifecycle.
+const (
+	efsIdleDays                = 14
+	efsStandardSizeThresholdGB = 100.0
+)
+
+// unusedSecretDays and unusedKMSKeyDays are the number of days without access after which
+// secrets and customer managed KMS keys are reported as unused.
+const (
+	unusedSecretDays = 90
+	unusedKMSKeyDays = 90
+)
+
+// Streaming analysis settings: Kinesis streams and MSK clusters are observed over the last
+// streamingObservationDays days, and MSK clusters averaging less than mskBytesInThreshold
+// bytes per second of incoming data are reported as idle.
+const (
+	streamingObservationDays = 14
+	mskBytesInThreshold      = 1024.0
+)
+
 // NewService creates a new orchestrator service.
-func NewService(stsService awssts.Service, costService awscostexplorer.Service, ec2Service awsec2.Service, elbService elb.Service, outputService output.Service, updateService update.Service, versionInfo model.VersionInfo) Service {
+func NewService(deps Dependencies, versionInfo model.VersionInfo) Service {
 	return &service{
-		stsService:    stsService,
-		costService:   costService,
-		ec2Service:    ec2Service,
-		elbService:    elbService,
-		outputService: outputService,
-		updateService: updateService,
-		versionInfo:   versionInfo,
+		stsService:              deps.STS,
+		costService:             deps.Cost,
+		ec2Service:              deps.EC2,
+		elbService:              deps.ELB,
+		computeOptimizerService: deps.ComputeOptimizer,
+		eksService:              deps.EKS,
+		ecsService:              deps.ECS,
+		sageMakerService:        deps.SageMaker,
+		redshiftService:         deps.Redshift,
+		efsService:              deps.EFS,
+		backupService:           deps.Backup,
+		secretsManagerService:   deps.SecretsManager,
+		kmsService:              deps.KMS,
+		route53Service:          deps.Route53,
+		kinesisService:          deps.Kinesis,
+		mskService:              deps.MSK,
+		cloudTrailService:       deps.CloudTrail,
+		outputService:           deps.Output,
+		metricsService:          deps.Metrics,
+		tuiService:              deps.TUI,
+		updateService:           deps.Update,
+		versionInfo:             versionInfo,
 	}
 }
 
@@ -40,10 +110,34 @@ func (s *service) Orchestrate(flags model.Flags) error {
 		return s.versionWorkflow()
 	}
 
+	if flags.Schema {
+		return s.schemaWorkflow()
+	}
+
+	if flags.Serve {
+		return s.serveWorkflow(flags)
+	}
+
+	if flags.TUI {
+		return s.tuiWorkflow()
+	}
+
 	if flags.Waste {
 		return s.wasteWorkflow()
 	}
 
+	if flags.Commitments {
+		return s.commitmentsWorkflow()
+	}
+
+	if flags.Recommendations {
+		return s.recommendationsWorkflow(flags)
+	}
+
+	if flags.Rightsizing {
+		return s.rightsizingWorkflow()
+	}
+
 	if flags.Trend {
 		return s.trendWorkflow()
 	}
@@ -61,41 +155,214 @@ func (s *service) versionWorkflow() error {
 	return nil
 }
 
-func (s *service) updateWorkflow() error {
+func (s *service) schemaWorkflow() error {
 	s.outputService.StopSpinner()
 
-	return s.updateService.Update()
+	return s.outputService.RenderSchema()
 }
 
-func (s *service) defaultWorkflow() error {
-	currentMonthData, err := s.costService.GetCurrentMonthCostsByService(context.Background())
+// serveWorkflow exposes the cost and waste results as Prometheus gauges, refreshing them every
+// flags.Interval until the process is interrupted
+func (s *service) serveWorkflow(flags model.Flags) error {
+	s.outputService.StopSpinner()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Printf("Serving metrics on %s/metrics, refreshed every %s\n", flags.Listen, flags.Interval)
+
+	return s.serveMetrics(ctx, flags.Listen, flags.Interval)
+}
+
+func (s *service) serveMetrics(ctx context.Context, addr string, interval time.Duration) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		return s.metricsService.Serve(ctx, addr)
+	})
+
+	g.Go(func() error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			s.collectMetrics(ctx)
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+
+	return g.Wait()
+}
+
+// collectMetrics runs the cost and waste workflows once and records their results. A failed
+// workflow is reported on stderr and by the aws_doctor_last_run_success gauge; the exporter keeps
+// serving its previous results.
+func (s *service) collectMetrics(ctx context.Context) {
+	if err := s.collectCostMetrics(ctx); err != nil && ctx.Err() == nil {
+		s.metricsService.RecordFailure(metrics.WorkflowCost)
+		fmt.Fprintf(os.Stderr, "Error: failed to collect cost metrics: %v\n", err)
+	}
+
+	report, accountID, err := s.fetchWasteReport(ctx)
 	if err != nil {
-		return err
+		if ctx.Err() == nil {
+			s.metricsService.RecordFailure(metrics.WorkflowWaste)
+			fmt.Fprintf(os.Stderr, "Error: failed to collect waste metrics: %v\n", err)
+		}
+
+		return
 	}
 
-	lastMonthData, err := s.costService.GetLastMonthCostsByService(context.Background())
+	warnSkippedChecks(report)
+	s.metricsService.RecordWaste(accountID, report)
+}
+
+func (s *service) collectCostMetrics(ctx context.Context) error {
+	comparison, err := s.fetchCostComparison(ctx)
 	if err != nil {
 		return err
 	}
 
-	currentTotalCost, err := s.costService.GetCurrentMonthTotalCosts(context.Background())
+	forecast, err := s.costService.GetCurrentMonthForecast(ctx)
 	if err != nil {
 		return err
 	}
 
-	lastTotalCost, err := s.costService.GetLastMonthTotalCosts(context.Background())
-	if err != nil {
+	s.metricsService.RecordCostComparison(
+		comparison.accountID,
+		comparison.lastTotalCost,
+		comparison.currentTotalCost,
+		comparison.lastMonth,
+		comparison.currentMonth,
+		forecast,
+	)
+
+	return nil
+}
+
+// tuiWorkflow shows the interactive dashboard, which loads its data on start-up and on every refresh
+func (s *service) tuiWorkflow() error {
+	s.outputService.StopSpinner()
+
+	return s.tuiService.Run(context.Background(), s.fetchDashboardData)
+}
+
+// fetchDashboardData fetches the cost comparison, trend and waste data of the dashboard concurrently
+func (s *service) fetchDashboardData(ctx context.Context) (tui.Data, error) {
+	g, ctx := errgroup.WithContext(ctx)
+
+	var (
+		comparison costComparison
+		trend      []model.CostInfo
+		report     model.WasteReport
+	)
+
+	g.Go(func() error {
+		var err error
+		comparison, err = s.fetchCostComparison(ctx)
+
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		trend, err = s.costService.GetLastSixMonthsCosts(ctx)
+
 		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		report, _, err = s.fetchWasteReport(ctx)
+
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return tui.Data{}, err
 	}
 
-	stsResult, err := s.stsService.GetCallerIdentity(context.Background())
+	return tui.Data{
+		AccountID:        comparison.accountID,
+		LastTotalCost:    comparison.lastTotalCost,
+		CurrentTotalCost: comparison.currentTotalCost,
+		LastMonth:        comparison.lastMonth,
+		CurrentMonth:     comparison.currentMonth,
+		Trend:            trend,
+		Waste:            report,
+	}, nil
+}
+
+func (s *service) updateWorkflow() error {
+	s.outputService.StopSpinner()
+
+	return s.updateService.Update()
+}
+
+func (s *service) defaultWorkflow() error {
+	comparison, err := s.fetchCostComparison(context.Background())
 	if err != nil {
 		return err
 	}
 
 	s.outputService.StopSpinner()
 
-	return s.outputService.RenderCostComparison(*stsResult.Account, *lastTotalCost, *currentTotalCost, lastMonthData, currentMonthData)
+	return s.outputService.RenderCostComparison(
+		comparison.accountID,
+		comparison.lastTotalCost,
+		comparison.currentTotalCost,
+		comparison.lastMonth,
+		comparison.currentMonth,
+	)
+}
+
+// costComparison holds the month-to-date costs of the current and last month
+type costComparison struct {
+	accountID        string
+	lastTotalCost    string
+	currentTotalCost string
+	lastMonth        *model.CostInfo
+	currentMonth     *model.CostInfo
+}
+
+func (s *service) fetchCostComparison(ctx context.Context) (costComparison, error) {
+	currentMonthData, err := s.costService.GetCurrentMonthCostsByService(ctx)
+	if err != nil {
+		return costComparison{}, err
+	}
+
+	lastMonthData, err := s.costService.GetLastMonthCostsByService(ctx)
+	if err != nil {
+		return costComparison{}, err
+	}
+
+	currentTotalCost, err := s.costService.GetCurrentMonthTotalCosts(ctx)
+	if err != nil {
+		return costComparison{}, err
+	}
+
+	lastTotalCost, err := s.costService.GetLastMonthTotalCosts(ctx)
+	if err != nil {
+		return costComparison{}, err
+	}
+
+	stsResult, err := s.stsService.GetCallerIdentity(ctx)
+	if err != nil {
+		return costComparison{}, err
+	}
+
+	return costComparison{
+		accountID:        *stsResult.Account,
+		lastTotalCost:    *lastTotalCost,
+		currentTotalCost: *currentTotalCost,
+		lastMonth:        lastMonthData,
+		currentMonth:     currentMonthData,
+	}, nil
 }
 
 func (s *service) trendWorkflow() error {
@@ -115,20 +382,26 @@ func (s *service) trendWorkflow() error {
 }
 
 func (s *service) wasteWorkflow() error {
-	ctx := context.Background()
+	report, accountID, err := s.fetchWasteReport(context.Background())
+	if err != nil {
+		return err
+	}
+
+	s.outputService.StopSpinner()
+	warnSkippedChecks(report)
+
+	return s.outputService.RenderWaste(accountID, report)
+}
+
+// fetchWasteReport runs every waste check concurrently and returns the report with the account ID
+func (s *service) fetchWasteReport(ctx context.Context) (model.WasteReport, string, error) {
 	g, ctx := errgroup.WithContext(ctx)
 
 	// Results from concurrent API calls
 	var (
-		elasticIPInfo                            []types.Address
-		availableEBSVolumesInfo                  []types.Volume
-		stoppedInstancesMoreThan30Days           []types.Instance
-		attachedToStoppedInstancesEBSVolumesInfo []types.Volume
-		expireReservedInstancesInfo              []model.RiExpirationInfo
-		unusedLoadBalancers                      []elbtypes.LoadBalancer
-		unusedAMIs                               []model.AMIWasteInfo
-		orphanedSnapshots                        []model.SnapshotWasteInfo
-		stsResult                                *sts.GetCallerIdentityOutput
+		report    model.WasteReport
+		stsResult *sts.GetCallerIdentityOutput
+		skipped   skippedChecks
 	)
 
 	// Fetch unused Elastic IPs concurrently
@@ -136,7 +409,7 @@ func (s *service) wasteWorkflow() error {
 	g.Go(func() error {
 		var err error
 
-		elasticIPInfo, err = s.ec2Service.GetUnusedElasticIPAddressesInfo(ctx)
+		report.ElasticIPs, err = s.ec2Service.GetUnusedElasticIPAddressesInfo(ctx)
 
 		return err
 	})
@@ -145,7 +418,7 @@ func (s *service) wasteWorkflow() error {
 	g.Go(func() error {
 		var err error
 
-		availableEBSVolumesInfo, err = s.ec2Service.GetUnusedEBSVolumes(ctx)
+		report.UnusedVolumes, err = s.ec2Service.GetUnusedEBSVolumes(ctx)
 
 		return err
 	})
@@ -154,7 +427,7 @@ func (s *service) wasteWorkflow() error {
 	g.Go(func() error {
 		var err error
 
-		stoppedInstancesMoreThan30Days, attachedToStoppedInstancesEBSVolumesInfo, err = s.ec2Service.GetStoppedInstancesInfo(ctx)
+		report.StoppedInstances, report.StoppedVolumes, err = s.ec2Service.GetStoppedInstancesInfo(ctx)
 
 		return err
 	})
@@ -163,20 +436,177 @@ func (s *service) wasteWorkflow() error {
 	g.Go(func() error {
 		var err error
 
-		expireReservedInstancesInfo, err = s.ec2Service.GetReservedInstanceExpiringOrExpired30DaysWaste(ctx)
+		report.ReservedInstances, err = s.ec2Service.GetReservedInstanceExpiringOrExpired30DaysWaste(ctx)
 
 		return err
 	})
 
+	// Fetch unused or underutilized capacity reservations concurrently
+	g.Go(skipped.optional("Capacity Reservations", []string{"unused_capacity_reservations"}, func() error {
+		var err error
+
+		report.CapacityReservations, err = s.ec2Service.GetUnusedCapacityReservations(ctx, capacityReservationUtilizationThreshold)
+
+		return err
+	}))
+
+	// Fetch idle dedicated hosts concurrently
+	g.Go(skipped.optional("Dedicated Hosts", []string{"idle_dedicated_hosts"}, func() error {
+		var err error
+
+		report.DedicatedHosts, err = s.ec2Service.GetIdleDedicatedHosts(ctx)
+
+		return err
+	}))
+
 	// Fetch unused Load Balancers concurrently
 	g.Go(func() error {
 		var err error
 
-		unusedLoadBalancers, err = s.elbService.GetUnusedLoadBalancers(ctx)
+		report.LoadBalancers, err = s.elbService.GetUnusedLoadBalancers(ctx)
 
 		return err
 	})
 
+	// Fetch unused Classic Load Balancers concurrently
+	g.Go(skipped.optional("Classic Load Balancers", []string{"unused_classic_load_balancers"}, func() error {
+		var err error
+
+		report.ClassicLoadBalancers, err = s.elbService.GetUnusedClassicLoadBalancers(ctx)
+
+		return err
+	}))
+
+	// Fetch idle and extended-support EKS clusters concurrently
+	g.Go(skipped.optional("EKS clusters", []string{"idle_eks_clusters"}, func() error {
+		var err error
+
+		report.EKSClusters, err = s.eksService.GetIdleClusters(ctx)
+
+		return err
+	}))
+
+	// Fetch ECS services scaled to zero with attached target groups concurrently
+	g.Go(skipped.optional("ECS services", []string{"idle_ecs_services"}, func() error {
+		var err error
+
+		report.ECSServices, err = s.ecsService.GetIdleServices(ctx)
+
+		return err
+	}))
+
+	// Fetch SageMaker endpoints without invocations concurrently
+	g.Go(skipped.optional("SageMaker endpoints", []string{"idle_sagemaker_endpoints"}, func() error {
+		var err error
+
+		report.SageMakerEndpoints, err = s.sageMakerService.GetIdleEndpoints(ctx, sageMakerIdleDays)
+
+		return err
+	}))
+
+	// Fetch SageMaker notebook instances running without restart concurrently
+	g.Go(skipped.optional("SageMaker notebook instances", []string{"long_running_sagemaker_notebooks"}, func() error {
+		var err error
+
+		report.SageMakerNotebooks, err = s.sageMakerService.GetLongRunningNotebookInstances(ctx, sageMakerNotebookRestartDays)
+
+		return err
+	}))
+
+	// Fetch idle Redshift clusters concurrently
+	g.Go(skipped.optional("Redshift clusters", []string{"idle_redshift_clusters"}, func() error {
+		var err error
+
+		report.RedshiftClusters, err = s.redshiftService.GetIdleClusters(ctx, redshiftObservationDays, redshiftCPUThreshold)
+
+		return err
+	}))
+
+	// Fetch stale manual Redshift snapshots concurrently
+	g.Go(skipped.optional("Redshift snapshots", []string{"stale_redshift_snapshots"}, func() error {
+		var err error
+
+		report.RedshiftSnapshots, err = s.redshiftService.GetStaleManualSnapshots(ctx, redshiftSnapshotStaleDays)
+
+		return err
+	}))
+
+	// Fetch unused EFS file systems and file systems without an IA lifecycle concurrently
+	g.Go(skipped.optional("EFS file systems", []string{"efs_file_systems"}, func() error {
+		var err error
+
+		report.EFSFileSystems, err = s.efsService.GetFileSystemWaste(ctx, efsIdleDays, efsStandardSizeThresholdGB)
+
+		return err
+	}))
+
+	// Fetch AWS Backup recovery points for deleted resources or without lifecycle concurrently
+	g.Go(skipped.optional("AWS Backup recovery points", []string{"backup_recovery_points"}, func() error {
+		var err error
+
+		report.BackupRecoveryPoints, err = s.backupService.GetRecoveryPointWaste(ctx)
+
+		return err
+	}))
+
+	// Fetch Secrets Manager secrets not accessed recently concurrently
+	g.Go(skipped.optional("Secrets Manager secrets", []string{"unused_secrets"}, func() error {
+		var err error
+
+		report.Secrets, err = s.secretsManagerService.GetUnusedSecrets(ctx, unusedSecretDays)
+
+		return err
+	}))
+
+	// Fetch disabled and unused customer managed KMS keys concurrently
+	g.Go(skipped.optional("KMS keys", []string{"unused_kms_keys"}, func() error {
+		var err error
+
+		report.KMSKeys, err = s.kmsService.GetUnusedKeys(ctx, unusedKMSKeyDays)
+
+		return err
+	}))
+
+	// Fetch empty or dangling Route 53 hosted zones and unreferenced health checks concurrently
+	g.Go(skipped.optional("Route 53", []string{"route53_hosted_zones", "unused_route53_health_checks"}, func() error {
+		var (
+			subChecks []model.SkippedCheck
+			err       error
+		)
+
+		report.Route53HostedZones, report.Route53HealthChecks, subChecks, err = s.route53Service.GetUnusedResources(ctx)
+		skipped.add(subChecks...)
+
+		return err
+	}))
+
+	// Fetch Kinesis streams without consumers or with over-provisioned shards concurrently
+	g.Go(skipped.optional("Kinesis streams", []string{"kinesis_streams"}, func() error {
+		var err error
+
+		report.KinesisStreams, err = s.kinesisService.GetStreamWaste(ctx, streamingObservationDays)
+
+		return err
+	}))
+
+	// Fetch MSK clusters with negligible incoming traffic concurrently
+	g.Go(skipped.optional("MSK clusters", []string{"idle_msk_clusters"}, func() error {
+		var err error
+
+		report.MSKClusters, err = s.mskService.GetIdleClusters(ctx, streamingObservationDays, mskBytesInThreshold)
+
+		return err
+	}))
+
+	// Fetch duplicate management event trails and trails logging all data events concurrently
+	g.Go(skipped.optional("CloudTrail trails", []string{"cloudtrail_trails"}, func() error {
+		var err error
+
+		report.CloudTrailTrails, err = s.cloudTrailService.GetTrailWaste(ctx)
+
+		return err
+	}))
+
 	// Fetch caller identity concurrently
 	g.Go(func() error {
 		var err error
@@ -188,9 +618,13 @@ func (s *service) wasteWorkflow() error {
 
 	// Fetch unused AMIs concurrently
 	g.Go(func() error {
-		var err error
+		var (
+			subChecks []model.SkippedCheck
+			err       error
+		)
 
-		unusedAMIs, err = s.ec2Service.GetUnusedAMIs(ctx, 90)
+		report.UnusedAMIs, subChecks, err = s.ec2Service.GetUnusedAMIs(ctx, 90)
+		skipped.add(subChecks...)
 
 		return err
 	})
@@ -199,27 +633,316 @@ func (s *service) wasteWorkflow() error {
 	g.Go(func() error {
 		var err error
 
-		orphanedSnapshots, err = s.ec2Service.GetOrphanedSnapshots(ctx, 90)
+		report.Snapshots, err = s.ec2Service.GetOrphanedSnapshots(ctx, 90)
 
 		return err
 	})
 
 	// Wait for all goroutines to complete
+	if err := g.Wait(); err != nil {
+		return model.WasteReport{}, "", err
+	}
+
+	report.SkippedChecks = skipped.sorted()
+
+	return report, *stsResult.Account, nil
+}
+
+// skippedChecks collects the checks of a fetchWasteReport run that were skipped
+type skippedChecks struct {
+	mu     sync.Mutex
+	checks []model.SkippedCheck
+}
+
+// optional wraps a check of a service the credentials may not cover. When the check is denied or
+// the service is not available in the region, the check and the waste categories it fills are
+// recorded as skipped instead of failing the whole report.
+func (c *skippedChecks) optional(name string, categories []string, check func() error) func() error {
+	return func() error {
+		err := check()
+
+		reason, skip := skipReason(err)
+		if !skip {
+			return err
+		}
+
+		c.add(model.SkippedCheck{Name: name, Reason: reason, Categories: categories})
+
+		return nil
+	}
+}
+
+// add records skipped checks, such as the parts of a check a service could not run while still
+// filling its categories
+func (c *skippedChecks) add(checks ...model.SkippedCheck) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks = append(c.checks, checks...)
+}
+
+// sorted returns the skipped checks ordered by name, since the checks finish in any order
+func (c *skippedChecks) sorted() []model.SkippedCheck {
+	sort.Slice(c.checks, func(i, j int) bool {
+		return c.checks[i].Name < c.checks[j].Name
+	})
+
+	return c.checks
+}
+
+// skipReason reports whether err means a check cannot run with these credentials or in this
+// region, and why
+func skipReason(err error) (string, bool) {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation", "AuthorizationError":
+			return "access denied", true
+		case "OptInRequired", "SubscriptionRequiredException":
+			return "the account is not subscribed to the service", true
+		case "UnsupportedOperation", "InvalidAction", "UnknownOperationException":
+			return "not supported in this region", true
+		}
+	}
+
+	// Services without an endpoint in the region fail to resolve their host name
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return "not available in this region", true
+	}
+
+	return "", false
+}
+
+// warnSkippedChecks reports the skipped checks of a waste report on stderr
+func warnSkippedChecks(report model.WasteReport) {
+	for _, check := range report.SkippedChecks {
+		fmt.Fprintf(os.Stderr, "Warning: skipped the %s check: %s\n", check.Name, check.Reason)
+	}
+}
+
+func (s *service) commitmentsWorkflow() error {
+	ctx := context.Background()
+	g, ctx := errgroup.WithContext(ctx)
+
+	var (
+		report    = model.CommitmentReport{LookbackDays: commitmentLookbackDays}
+		stsResult *sts.GetCallerIdentityOutput
+	)
+
+	// Fetch Reserved Instance utilization and coverage concurrently
+	g.Go(func() error {
+		var err error
+
+		report.Reservations, err = s.costService.GetReservationSummary(ctx, commitmentLookbackDays)
+
+		return err
+	})
+
+	// Fetch Savings Plans utilization and coverage concurrently
+	g.Go(func() error {
+		var err error
+
+		report.SavingsPlans, err = s.costService.GetSavingsPlansSummary(ctx, commitmentLookbackDays)
+
+		return err
+	})
+
+	// Fetch underutilized Reserved Instances concurrently
+	g.Go(func() error {
+		var err error
+
+		report.UnderutilizedReservations, err = s.costService.GetUnderutilizedReservations(ctx, commitmentLookbackDays, commitmentUtilizationThreshold)
+
+		return err
+	})
+
+	// Fetch underutilized and expiring Savings Plans concurrently
+	g.Go(func() error {
+		var err error
+
+		report.UnderutilizedSavingsPlans, report.ExpiringSavingsPlans, err = s.costService.GetSavingsPlansCommitments(ctx, commitmentLookbackDays, commitmentUtilizationThreshold, commitmentExpiryDays)
+
+		return err
+	})
+
+	// Fetch caller identity concurrently
+	g.Go(func() error {
+		var err error
+
+		stsResult, err = s.stsService.GetCallerIdentity(ctx)
+
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	s.outputService.StopSpinner()
+
+	return s.outputService.RenderCommitments(*stsResult.Account, report)
+}
+
+func (s *service) recommendationsWorkflow(flags model.Flags) error {
+	ctx := context.Background()
+	g, ctx := errgroup.WithContext(ctx)
+
+	options := model.RecommendationOptions{
+		TermInYears:   flags.Term,
+		PaymentOption: flags.PaymentOption,
+		LookbackDays:  flags.LookbackDays,
+	}
+
+	var (
+		report    = model.RecommendationReport{Options: options}
+		stsResult *sts.GetCallerIdentityOutput
+	)
+
+	// Fetch Savings Plans purchase recommendations concurrently
+	g.Go(func() error {
+		var err error
+
+		report.SavingsPlans, err = s.costService.GetSavingsPlansPurchaseRecommendations(ctx, options)
+
+		return err
+	})
+
+	// Fetch Reserved Instance purchase recommendations concurrently
+	g.Go(func() error {
+		var err error
+
+		report.ReservedInstances, err = s.costService.GetReservationPurchaseRecommendations(ctx, options)
+
+		return err
+	})
+
+	// Fetch caller identity concurrently
+	g.Go(func() error {
+		var err error
+
+		stsResult, err = s.stsService.GetCallerIdentity(ctx)
+
+		return err
+	})
+
 	if err := g.Wait(); err != nil {
 		return err
 	}
 
 	s.outputService.StopSpinner()
 
-	return s.outputService.RenderWaste(
-		*stsResult.Account,
-		elasticIPInfo,
-		availableEBSVolumesInfo,
-		attachedToStoppedInstancesEBSVolumesInfo,
-		expireReservedInstancesInfo,
-		stoppedInstancesMoreThan30Days,
-		unusedLoadBalancers,
-		unusedAMIs,
-		orphanedSnapshots,
+	return s.outputService.RenderRecommendations(*stsResult.Account, report)
+}
+
+func (s *service) rightsizingWorkflow() error {
+	ctx := context.Background()
+	g, ctx := errgroup.WithContext(ctx)
+
+	var (
+		costExplorerRecommendations []model.RightsizingRecommendationInfo
+		instanceRecommendations     []model.RightsizingRecommendationInfo
+		volumeRecommendations       []model.RightsizingRecommendationInfo
+		lambdaRecommendations       []model.RightsizingRecommendationInfo
+		optedIn                     bool
+		stsResult                   *sts.GetCallerIdentityOutput
 	)
+
+	// Fetch Cost Explorer EC2 rightsizing recommendations concurrently
+	g.Go(func() error {
+		var err error
+
+		costExplorerRecommendations, err = s.costService.GetRightsizingRecommendations(ctx)
+
+		return err
+	})
+
+	// Check Compute Optimizer enrollment concurrently
+	g.Go(func() error {
+		var err error
+
+		optedIn, err = s.computeOptimizerService.IsOptedIn(ctx)
+
+		return err
+	})
+
+	// Fetch Compute Optimizer EC2 instance recommendations concurrently
+	// (empty when the account is not opted in)
+	g.Go(func() error {
+		var err error
+
+		instanceRecommendations, err = s.computeOptimizerService.GetEC2InstanceRecommendations(ctx)
+
+		return err
+	})
+
+	// Fetch Compute Optimizer EBS volume recommendations concurrently
+	g.Go(func() error {
+		var err error
+
+		volumeRecommendations, err = s.computeOptimizerService.GetEBSVolumeRecommendations(ctx)
+
+		return err
+	})
+
+	// Fetch Compute Optimizer Lambda function recommendations concurrently
+	g.Go(func() error {
+		var err error
+
+		lambdaRecommendations, err = s.computeOptimizerService.GetLambdaFunctionRecommendations(ctx)
+
+		return err
+	})
+
+	// Fetch caller identity concurrently
+	g.Go(func() error {
+		var err error
+
+		stsResult, err = s.stsService.GetCallerIdentity(ctx)
+
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	computeOptimizerRecommendations := append(append(instanceRecommendations, volumeRecommendations...), lambdaRecommendations...)
+
+	report := model.RightsizingReport{
+		Recommendations:         mergeRightsizingRecommendations(costExplorerRecommendations, computeOptimizerRecommendations),
+		ComputeOptimizerEnabled: optedIn,
+	}
+
+	s.outputService.StopSpinner()
+
+	return s.outputService.RenderRightsizing(*stsResult.Account, report)
+}
+
+// mergeRightsizingRecommendations combines Cost Explorer and Compute Optimizer recommendations.
+// Cost Explorer wins when both cover the same instance because its savings account for
+// Reserved Instance and Savings Plans discounts. The result is sorted by estimated savings.
+func mergeRightsizingRecommendations(costExplorer, computeOptimizer []model.RightsizingRecommendationInfo) []model.RightsizingRecommendationInfo {
+	merged := make([]model.RightsizingRecommendationInfo, 0, len(costExplorer)+len(computeOptimizer))
+	seen := make(map[string]bool)
+
+	for _, recommendation := range costExplorer {
+		seen[recommendation.ResourceID] = true
+
+		merged = append(merged, recommendation)
+	}
+
+	for _, recommendation := range computeOptimizer {
+		if seen[recommendation.ResourceID] {
+			continue
+		}
+
+		merged = append(merged, recommendation)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].EstimatedMonthlySavings > merged[j].EstimatedMonthlySavings
+	})
+
+	return merged
 }
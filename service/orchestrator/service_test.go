@@ -1,15 +1,21 @@
 package orchestrator
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
 	"github.com/elC0mpa/aws-doctor/mocks"
 	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/elC0mpa/aws-doctor/service/tui"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -24,7 +30,7 @@ func TestOrchestrate_RouteToDefaultWorkflow(t *testing.T) {
 	mockUpdate := new(mocks.MockUpdateService)
 
 	// Create service
-	svc := NewService(mockSTS, mockCost, mockEC2, mockELB, mockOutput, mockUpdate, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
 
 	// Setup expectations for default workflow
 	mockCost.On("GetCurrentMonthCostsByService", mock.Anything).Return(&model.CostInfo{}, nil)
@@ -58,7 +64,7 @@ func TestOrchestrate_RouteToUpdateWorkflow(t *testing.T) {
 	mockUpdate := new(mocks.MockUpdateService)
 
 	// Create service
-	svc := NewService(mockSTS, mockCost, mockEC2, mockELB, mockOutput, mockUpdate, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
 
 	// Setup expectations
 	mockOutput.On("StopSpinner").Return()
@@ -74,6 +80,19 @@ func TestOrchestrate_RouteToUpdateWorkflow(t *testing.T) {
 	mockUpdate.AssertExpectations(t)
 }
 
+func TestOrchestrate_RouteToSchemaWorkflow(t *testing.T) {
+	mockOutput := new(mocks.MockOutputService)
+	svc := NewService(Dependencies{Output: mockOutput}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+
+	mockOutput.On("StopSpinner").Return()
+	mockOutput.On("RenderSchema").Return(nil)
+
+	err := svc.Orchestrate(model.Flags{Schema: true})
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
 func TestOrchestrate_RouteToTrendWorkflow(t *testing.T) {
 	// Setup mocks
 	mockSTS := new(mocks.MockSTSService)
@@ -84,7 +103,7 @@ func TestOrchestrate_RouteToTrendWorkflow(t *testing.T) {
 	mockUpdate := new(mocks.MockUpdateService)
 
 	// Create service
-	svc := NewService(mockSTS, mockCost, mockEC2, mockELB, mockOutput, mockUpdate, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
 
 	// Setup expectations for trend workflow
 	mockCost.On("GetLastSixMonthsCosts", mock.Anything).Return([]model.CostInfo{}, nil)
@@ -106,6 +125,40 @@ func TestOrchestrate_RouteToTrendWorkflow(t *testing.T) {
 }
 
 func TestOrchestrate_RouteToWasteWorkflow(t *testing.T) {
+	// Setup mocks
+	m := newWasteMocks()
+	svc := m.service()
+
+	// Setup expectations for waste workflow
+	m.expectEmptyWaste()
+	m.output.On("StopSpinner").Return()
+	m.output.On("RenderWaste", mock.Anything, mock.Anything).Return(nil)
+
+	// Execute with Waste flag
+	flags := model.Flags{Waste: true, Output: "json"}
+	err := svc.Orchestrate(flags)
+
+	// Assert
+	assert.NoError(t, err)
+	m.ec2.AssertExpectations(t)
+	m.elb.AssertExpectations(t)
+	m.eks.AssertExpectations(t)
+	m.ecs.AssertExpectations(t)
+	m.sagemaker.AssertExpectations(t)
+	m.redshift.AssertExpectations(t)
+	m.efs.AssertExpectations(t)
+	m.backup.AssertExpectations(t)
+	m.secrets.AssertExpectations(t)
+	m.kms.AssertExpectations(t)
+	m.route53.AssertExpectations(t)
+	m.kinesis.AssertExpectations(t)
+	m.msk.AssertExpectations(t)
+	m.cloudtrail.AssertExpectations(t)
+	m.sts.AssertExpectations(t)
+	m.output.AssertExpectations(t)
+}
+
+func TestOrchestrate_RouteToCommitmentsWorkflow(t *testing.T) {
 	// Setup mocks
 	mockSTS := new(mocks.MockSTSService)
 	mockCost := new(mocks.MockCostService)
@@ -115,36 +168,31 @@ func TestOrchestrate_RouteToWasteWorkflow(t *testing.T) {
 	mockUpdate := new(mocks.MockUpdateService)
 
 	// Create service
-	svc := NewService(mockSTS, mockCost, mockEC2, mockELB, mockOutput, mockUpdate, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
 
-	// Setup expectations for waste workflow
-	mockEC2.On("GetUnusedElasticIPAddressesInfo", mock.Anything).Return([]types.Address{}, nil)
-	mockEC2.On("GetUnusedEBSVolumes", mock.Anything).Return([]types.Volume{}, nil)
-	mockEC2.On("GetStoppedInstancesInfo", mock.Anything).Return([]types.Instance{}, []types.Volume{}, nil)
-	mockEC2.On("GetReservedInstanceExpiringOrExpired30DaysWaste", mock.Anything).Return([]model.RiExpirationInfo{}, nil)
-	mockEC2.On("GetUnusedAMIs", mock.Anything, mock.Anything).Return([]model.AMIWasteInfo{}, nil)
-	mockEC2.On("GetOrphanedSnapshots", mock.Anything, mock.Anything).Return([]model.SnapshotWasteInfo{}, nil)
-	mockELB.On("GetUnusedLoadBalancers", mock.Anything).Return([]elbtypes.LoadBalancer{}, nil)
+	// Setup expectations for commitments workflow
+	mockCost.On("GetReservationSummary", mock.Anything, 30).Return(&model.CommitmentSummaryInfo{}, nil)
+	mockCost.On("GetSavingsPlansSummary", mock.Anything, 30).Return((*model.CommitmentSummaryInfo)(nil), nil)
+	mockCost.On("GetUnderutilizedReservations", mock.Anything, 30, 80.0).Return([]model.CommitmentUtilizationInfo{}, nil)
+	mockCost.On("GetSavingsPlansCommitments", mock.Anything, 30, 80.0, 30).Return([]model.CommitmentUtilizationInfo{}, []model.CommitmentUtilizationInfo{}, nil)
 	mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
 		Account: aws.String("123456789012"),
 	}, nil)
 	mockOutput.On("StopSpinner").Return()
-	mockOutput.On("RenderWaste", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockOutput.On("RenderCommitments", "123456789012", mock.Anything).Return(nil)
 
-	// Execute with Waste flag
-	flags := model.Flags{Waste: true, Output: "json"}
+	// Execute with Commitments flag
+	flags := model.Flags{Commitments: true, Output: "json"}
 	err := svc.Orchestrate(flags)
 
 	// Assert
 	assert.NoError(t, err)
-	mockEC2.AssertExpectations(t)
-	mockELB.AssertExpectations(t)
+	mockCost.AssertExpectations(t)
 	mockSTS.AssertExpectations(t)
 	mockOutput.AssertExpectations(t)
 }
 
-func TestOrchestrate_WasteTakesPrecedenceOverTrend(t *testing.T) {
-	// Setup mocks
+func TestCommitmentsWorkflow_Error(t *testing.T) {
 	mockSTS := new(mocks.MockSTSService)
 	mockCost := new(mocks.MockCostService)
 	mockEC2 := new(mocks.MockEC2Service)
@@ -152,22 +200,166 @@ func TestOrchestrate_WasteTakesPrecedenceOverTrend(t *testing.T) {
 	mockOutput := new(mocks.MockOutputService)
 	mockUpdate := new(mocks.MockUpdateService)
 
-	// Create service
-	svc := NewService(mockSTS, mockCost, mockEC2, mockELB, mockOutput, mockUpdate, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+	mockCost.On("GetReservationSummary", mock.Anything, mock.Anything).Return((*model.CommitmentSummaryInfo)(nil), errors.New("reservation utilization error"))
+	mockCost.On("GetSavingsPlansSummary", mock.Anything, mock.Anything).Return((*model.CommitmentSummaryInfo)(nil), nil)
+	mockCost.On("GetUnderutilizedReservations", mock.Anything, mock.Anything, mock.Anything).Return([]model.CommitmentUtilizationInfo{}, nil)
+	mockCost.On("GetSavingsPlansCommitments", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.CommitmentUtilizationInfo{}, []model.CommitmentUtilizationInfo{}, nil)
+	mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789012"),
+	}, nil)
+	mockOutput.On("StopSpinner").Return().Maybe()
+	mockOutput.On("RenderCommitments", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	// Setup expectations for waste workflow (should be called, not trend)
-	mockEC2.On("GetUnusedElasticIPAddressesInfo", mock.Anything).Return([]types.Address{}, nil)
-	mockEC2.On("GetUnusedEBSVolumes", mock.Anything).Return([]types.Volume{}, nil)
-	mockEC2.On("GetStoppedInstancesInfo", mock.Anything).Return([]types.Instance{}, []types.Volume{}, nil)
-	mockEC2.On("GetReservedInstanceExpiringOrExpired30DaysWaste", mock.Anything).Return([]model.RiExpirationInfo{}, nil)
-	mockEC2.On("GetUnusedAMIs", mock.Anything, mock.Anything).Return([]model.AMIWasteInfo{}, nil)
-	mockEC2.On("GetOrphanedSnapshots", mock.Anything, mock.Anything).Return([]model.SnapshotWasteInfo{}, nil)
-	mockELB.On("GetUnusedLoadBalancers", mock.Anything).Return([]elbtypes.LoadBalancer{}, nil)
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+	err := svc.Orchestrate(model.Flags{Commitments: true, Output: "json"})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "reservation utilization error")
+	mockOutput.AssertNotCalled(t, "RenderCommitments", mock.Anything, mock.Anything)
+}
+
+func TestOrchestrate_RouteToRecommendationsWorkflow(t *testing.T) {
+	mockSTS := new(mocks.MockSTSService)
+	mockCost := new(mocks.MockCostService)
+	mockEC2 := new(mocks.MockEC2Service)
+	mockELB := new(mocks.MockELBService)
+	mockOutput := new(mocks.MockOutputService)
+	mockUpdate := new(mocks.MockUpdateService)
+
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+
+	options := model.RecommendationOptions{TermInYears: 3, PaymentOption: model.PaymentOptionAllUpfront, LookbackDays: 60}
+	savingsPlans := []model.PurchaseRecommendationInfo{{CommitmentType: model.CommitmentTypeSavingsPlan, EstimatedMonthlySavings: 100}}
+
+	mockCost.On("GetSavingsPlansPurchaseRecommendations", mock.Anything, options).Return(savingsPlans, nil)
+	mockCost.On("GetReservationPurchaseRecommendations", mock.Anything, options).Return([]model.PurchaseRecommendationInfo{}, nil)
 	mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
 		Account: aws.String("123456789012"),
 	}, nil)
 	mockOutput.On("StopSpinner").Return()
-	mockOutput.On("RenderWaste", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockOutput.On("RenderRecommendations", "123456789012", model.RecommendationReport{
+		Options:           options,
+		SavingsPlans:      savingsPlans,
+		ReservedInstances: []model.PurchaseRecommendationInfo{},
+	}).Return(nil)
+
+	flags := model.Flags{Recommendations: true, Term: 3, PaymentOption: model.PaymentOptionAllUpfront, LookbackDays: 60, Output: "json"}
+	err := svc.Orchestrate(flags)
+
+	assert.NoError(t, err)
+	mockCost.AssertExpectations(t)
+	mockSTS.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestRecommendationsWorkflow_Error(t *testing.T) {
+	mockSTS := new(mocks.MockSTSService)
+	mockCost := new(mocks.MockCostService)
+	mockEC2 := new(mocks.MockEC2Service)
+	mockELB := new(mocks.MockELBService)
+	mockOutput := new(mocks.MockOutputService)
+	mockUpdate := new(mocks.MockUpdateService)
+
+	mockCost.On("GetSavingsPlansPurchaseRecommendations", mock.Anything, mock.Anything).Return(nil, errors.New("recommendation error"))
+	mockCost.On("GetReservationPurchaseRecommendations", mock.Anything, mock.Anything).Return([]model.PurchaseRecommendationInfo{}, nil)
+	mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789012"),
+	}, nil)
+	mockOutput.On("StopSpinner").Return().Maybe()
+	mockOutput.On("RenderRecommendations", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+	err := svc.Orchestrate(model.Flags{Recommendations: true, Term: 1, PaymentOption: model.PaymentOptionNoUpfront, LookbackDays: 30, Output: "json"})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "recommendation error")
+	mockOutput.AssertNotCalled(t, "RenderRecommendations", mock.Anything, mock.Anything)
+}
+
+func TestOrchestrate_RouteToRightsizingWorkflow(t *testing.T) {
+	mockSTS := new(mocks.MockSTSService)
+	mockCost := new(mocks.MockCostService)
+	mockComputeOptimizer := new(mocks.MockComputeOptimizerService)
+	mockOutput := new(mocks.MockOutputService)
+
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, ComputeOptimizer: mockComputeOptimizer, Output: mockOutput}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+
+	mockCost.On("GetRightsizingRecommendations", mock.Anything).Return([]model.RightsizingRecommendationInfo{
+		{Source: model.RightsizingSourceCostExplorer, ResourceID: "i-1", EstimatedMonthlySavings: 10},
+	}, nil)
+	mockComputeOptimizer.On("IsOptedIn", mock.Anything).Return(false, nil)
+	mockComputeOptimizer.On("GetEC2InstanceRecommendations", mock.Anything).Return(nil, nil)
+	mockComputeOptimizer.On("GetEBSVolumeRecommendations", mock.Anything).Return(nil, nil)
+	mockComputeOptimizer.On("GetLambdaFunctionRecommendations", mock.Anything).Return(nil, nil)
+	mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789012"),
+	}, nil)
+	mockOutput.On("StopSpinner").Return()
+	mockOutput.On("RenderRightsizing", "123456789012", mock.MatchedBy(func(report model.RightsizingReport) bool {
+		return !report.ComputeOptimizerEnabled && len(report.Recommendations) == 1
+	})).Return(nil)
+
+	err := svc.Orchestrate(model.Flags{Rightsizing: true, Output: "json"})
+
+	assert.NoError(t, err)
+	mockCost.AssertExpectations(t)
+	mockComputeOptimizer.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestRightsizingWorkflow_Error(t *testing.T) {
+	mockSTS := new(mocks.MockSTSService)
+	mockCost := new(mocks.MockCostService)
+	mockComputeOptimizer := new(mocks.MockComputeOptimizerService)
+	mockOutput := new(mocks.MockOutputService)
+
+	mockCost.On("GetRightsizingRecommendations", mock.Anything).Return([]model.RightsizingRecommendationInfo{}, nil)
+	mockComputeOptimizer.On("IsOptedIn", mock.Anything).Return(true, nil)
+	mockComputeOptimizer.On("GetEC2InstanceRecommendations", mock.Anything).Return(nil, errors.New("compute optimizer error"))
+	mockComputeOptimizer.On("GetEBSVolumeRecommendations", mock.Anything).Return([]model.RightsizingRecommendationInfo{}, nil)
+	mockComputeOptimizer.On("GetLambdaFunctionRecommendations", mock.Anything).Return([]model.RightsizingRecommendationInfo{}, nil)
+	mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789012"),
+	}, nil)
+	mockOutput.On("StopSpinner").Return().Maybe()
+	mockOutput.On("RenderRightsizing", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, ComputeOptimizer: mockComputeOptimizer, Output: mockOutput}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+	err := svc.Orchestrate(model.Flags{Rightsizing: true, Output: "json"})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "compute optimizer error")
+	mockOutput.AssertNotCalled(t, "RenderRightsizing", mock.Anything, mock.Anything)
+}
+
+func TestMergeRightsizingRecommendations(t *testing.T) {
+	costExplorer := []model.RightsizingRecommendationInfo{
+		{Source: model.RightsizingSourceCostExplorer, ResourceID: "i-1", EstimatedMonthlySavings: 50},
+	}
+	computeOptimizer := []model.RightsizingRecommendationInfo{
+		{Source: model.RightsizingSourceComputeOptimizer, ResourceID: "i-1", EstimatedMonthlySavings: 80},
+		{Source: model.RightsizingSourceComputeOptimizer, ResourceID: "vol-1", EstimatedMonthlySavings: 100},
+		{Source: model.RightsizingSourceComputeOptimizer, ResourceID: "worker", EstimatedMonthlySavings: 5},
+	}
+
+	merged := mergeRightsizingRecommendations(costExplorer, computeOptimizer)
+
+	assert.Len(t, merged, 3)
+	assert.Equal(t, "vol-1", merged[0].ResourceID)
+	assert.Equal(t, "i-1", merged[1].ResourceID)
+	assert.Equal(t, model.RightsizingSourceCostExplorer, merged[1].Source)
+	assert.Equal(t, "worker", merged[2].ResourceID)
+}
+
+func TestOrchestrate_WasteTakesPrecedenceOverTrend(t *testing.T) {
+	// Setup mocks
+	m := newWasteMocks()
+	svc := m.service()
+
+	// Setup expectations for waste workflow (should be called, not trend)
+	m.expectEmptyWaste()
+	m.output.On("StopSpinner").Return()
+	m.output.On("RenderWaste", mock.Anything, mock.Anything).Return(nil)
 
 	// Execute with both flags - Waste should take precedence
 	flags := model.Flags{Waste: true, Trend: true, Output: "json"}
@@ -175,7 +367,7 @@ func TestOrchestrate_WasteTakesPrecedenceOverTrend(t *testing.T) {
 
 	// Assert - cost service should NOT be called for trend
 	assert.NoError(t, err)
-	mockCost.AssertNotCalled(t, "GetLastSixMonthsCosts", mock.Anything)
+	m.cost.AssertNotCalled(t, "GetLastSixMonthsCosts", mock.Anything)
 }
 
 func TestDefaultWorkflow_CostServiceError(t *testing.T) {
@@ -244,7 +436,7 @@ func TestDefaultWorkflow_CostServiceError(t *testing.T) {
 			mockOutput.On("StopSpinner").Return().Maybe()
 			mockOutput.On("RenderCostComparison", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
-			svc := NewService(mockSTS, mockCost, mockEC2, mockELB, mockOutput, mockUpdate, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+			svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
 			err := svc.Orchestrate(model.Flags{Output: "json"})
 
 			assert.Error(t, err)
@@ -289,7 +481,7 @@ func TestTrendWorkflow_Error(t *testing.T) {
 			mockOutput.On("StopSpinner").Return().Maybe()
 			mockOutput.On("RenderTrend", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-			svc := NewService(mockSTS, mockCost, mockEC2, mockELB, mockOutput, mockUpdate, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+			svc := NewService(Dependencies{STS: mockSTS, Cost: mockCost, EC2: mockEC2, ELB: mockELB, Output: mockOutput, Update: mockUpdate}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
 			err := svc.Orchestrate(model.Flags{Trend: true, Output: "json"})
 
 			assert.Error(t, err)
@@ -301,77 +493,452 @@ func TestTrendWorkflow_Error(t *testing.T) {
 func TestWasteWorkflow_Error(t *testing.T) {
 	tests := []struct {
 		name        string
-		setupMocks  func(*mocks.MockEC2Service, *mocks.MockELBService, *mocks.MockSTSService)
+		setupMocks  func(*wasteMocks)
 		expectedErr string
 	}{
 		{
 			name: "GetUnusedElasticIpAddressesInfo_fails",
-			setupMocks: func(mockEC2 *mocks.MockEC2Service, mockELB *mocks.MockELBService, mockSTS *mocks.MockSTSService) {
-				mockEC2.On("GetUnusedElasticIPAddressesInfo", mock.Anything).Return(([]types.Address)(nil), errors.New("EIP error"))
-				mockEC2.On("GetUnusedEBSVolumes", mock.Anything).Return([]types.Volume{}, nil)
-				mockEC2.On("GetStoppedInstancesInfo", mock.Anything).Return([]types.Instance{}, []types.Volume{}, nil)
-				mockEC2.On("GetReservedInstanceExpiringOrExpired30DaysWaste", mock.Anything).Return([]model.RiExpirationInfo{}, nil)
-				mockEC2.On("GetUnusedAMIs", mock.Anything, mock.Anything).Return([]model.AMIWasteInfo{}, nil)
-				mockEC2.On("GetOrphanedSnapshots", mock.Anything, mock.Anything).Return([]model.SnapshotWasteInfo{}, nil)
-				mockELB.On("GetUnusedLoadBalancers", mock.Anything).Return([]elbtypes.LoadBalancer{}, nil)
-				mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
-					Account: aws.String("123456789012"),
-				}, nil)
+			setupMocks: func(m *wasteMocks) {
+				m.ec2.On("GetUnusedElasticIPAddressesInfo", mock.Anything).Return(([]types.Address)(nil), errors.New("EIP error"))
 			},
 			expectedErr: "EIP error",
 		},
 		{
 			name: "GetUnusedEBSVolumes_fails",
-			setupMocks: func(mockEC2 *mocks.MockEC2Service, mockELB *mocks.MockELBService, mockSTS *mocks.MockSTSService) {
-				mockEC2.On("GetUnusedElasticIPAddressesInfo", mock.Anything).Return([]types.Address{}, nil)
-				mockEC2.On("GetUnusedEBSVolumes", mock.Anything).Return(([]types.Volume)(nil), errors.New("EBS error"))
-				mockEC2.On("GetStoppedInstancesInfo", mock.Anything).Return([]types.Instance{}, []types.Volume{}, nil)
-				mockEC2.On("GetReservedInstanceExpiringOrExpired30DaysWaste", mock.Anything).Return([]model.RiExpirationInfo{}, nil)
-				mockEC2.On("GetUnusedAMIs", mock.Anything, mock.Anything).Return([]model.AMIWasteInfo{}, nil)
-				mockEC2.On("GetOrphanedSnapshots", mock.Anything, mock.Anything).Return([]model.SnapshotWasteInfo{}, nil)
-				mockELB.On("GetUnusedLoadBalancers", mock.Anything).Return([]elbtypes.LoadBalancer{}, nil)
-				mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
-					Account: aws.String("123456789012"),
-				}, nil)
+			setupMocks: func(m *wasteMocks) {
+				m.ec2.On("GetUnusedEBSVolumes", mock.Anything).Return(([]types.Volume)(nil), errors.New("EBS error"))
 			},
 			expectedErr: "EBS error",
 		},
+		{
+			name: "GetUnusedCapacityReservations_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.ec2.On("GetUnusedCapacityReservations", mock.Anything, mock.Anything).Return(([]model.CapacityReservationWasteInfo)(nil), errors.New("capacity reservation error"))
+			},
+			expectedErr: "capacity reservation error",
+		},
 		{
 			name: "GetUnusedLoadBalancers_fails",
-			setupMocks: func(mockEC2 *mocks.MockEC2Service, mockELB *mocks.MockELBService, mockSTS *mocks.MockSTSService) {
-				mockEC2.On("GetUnusedElasticIPAddressesInfo", mock.Anything).Return([]types.Address{}, nil)
-				mockEC2.On("GetUnusedEBSVolumes", mock.Anything).Return([]types.Volume{}, nil)
-				mockEC2.On("GetStoppedInstancesInfo", mock.Anything).Return([]types.Instance{}, []types.Volume{}, nil)
-				mockEC2.On("GetReservedInstanceExpiringOrExpired30DaysWaste", mock.Anything).Return([]model.RiExpirationInfo{}, nil)
-				mockEC2.On("GetUnusedAMIs", mock.Anything, mock.Anything).Return([]model.AMIWasteInfo{}, nil)
-				mockEC2.On("GetOrphanedSnapshots", mock.Anything, mock.Anything).Return([]model.SnapshotWasteInfo{}, nil)
-				mockELB.On("GetUnusedLoadBalancers", mock.Anything).Return(([]elbtypes.LoadBalancer)(nil), errors.New("ELB error"))
-				mockSTS.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
-					Account: aws.String("123456789012"),
-				}, nil)
+			setupMocks: func(m *wasteMocks) {
+				m.elb.On("GetUnusedLoadBalancers", mock.Anything).Return(([]elbtypes.LoadBalancer)(nil), errors.New("ELB error"))
 			},
 			expectedErr: "ELB error",
 		},
+		{
+			name: "GetUnusedClassicLoadBalancers_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.elb.On("GetUnusedClassicLoadBalancers", mock.Anything).Return(([]model.ClassicLoadBalancerWasteInfo)(nil), errors.New("classic ELB error"))
+			},
+			expectedErr: "classic ELB error",
+		},
+		{
+			name: "GetIdleClusters_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.eks.On("GetIdleClusters", mock.Anything).Return(nil, errors.New("EKS error"))
+			},
+			expectedErr: "EKS error",
+		},
+		{
+			name: "GetIdleServices_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.ecs.On("GetIdleServices", mock.Anything).Return(nil, errors.New("ECS error"))
+			},
+			expectedErr: "ECS error",
+		},
+		{
+			name: "GetIdleEndpoints_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.sagemaker.On("GetIdleEndpoints", mock.Anything, mock.Anything).Return(nil, errors.New("SageMaker endpoint error"))
+			},
+			expectedErr: "SageMaker endpoint error",
+		},
+		{
+			name: "GetLongRunningNotebookInstances_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.sagemaker.On("GetLongRunningNotebookInstances", mock.Anything, mock.Anything).Return(nil, errors.New("SageMaker notebook error"))
+			},
+			expectedErr: "SageMaker notebook error",
+		},
+		{
+			name: "GetIdleRedshiftClusters_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.redshift.On("GetIdleClusters", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("Redshift cluster error"))
+			},
+			expectedErr: "Redshift cluster error",
+		},
+		{
+			name: "GetStaleManualSnapshots_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.redshift.On("GetStaleManualSnapshots", mock.Anything, mock.Anything).Return(nil, errors.New("Redshift snapshot error"))
+			},
+			expectedErr: "Redshift snapshot error",
+		},
+		{
+			name: "GetFileSystemWaste_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.efs.On("GetFileSystemWaste", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("EFS error"))
+			},
+			expectedErr: "EFS error",
+		},
+		{
+			name: "GetRecoveryPointWaste_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.backup.On("GetRecoveryPointWaste", mock.Anything).Return(nil, errors.New("Backup error"))
+			},
+			expectedErr: "Backup error",
+		},
+		{
+			name: "GetUnusedSecrets_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.secrets.On("GetUnusedSecrets", mock.Anything, mock.Anything).Return(nil, errors.New("Secrets Manager error"))
+			},
+			expectedErr: "Secrets Manager error",
+		},
+		{
+			name: "GetUnusedKeys_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.kms.On("GetUnusedKeys", mock.Anything, mock.Anything).Return(nil, errors.New("KMS error"))
+			},
+			expectedErr: "KMS error",
+		},
+		{
+			name: "GetUnusedResources_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.route53.On("GetUnusedResources", mock.Anything).Return(nil, nil, nil, errors.New("Route 53 error"))
+			},
+			expectedErr: "Route 53 error",
+		},
+		{
+			name: "GetStreamWaste_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.kinesis.On("GetStreamWaste", mock.Anything, mock.Anything).Return(nil, errors.New("Kinesis error"))
+			},
+			expectedErr: "Kinesis error",
+		},
+		{
+			name: "GetIdleMSKClusters_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.msk.On("GetIdleClusters", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("MSK error"))
+			},
+			expectedErr: "MSK error",
+		},
+		{
+			name: "GetTrailWaste_fails",
+			setupMocks: func(m *wasteMocks) {
+				m.cloudtrail.On("GetTrailWaste", mock.Anything).Return(nil, errors.New("CloudTrail error"))
+			},
+			expectedErr: "CloudTrail error",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockSTS := new(mocks.MockSTSService)
-			mockCost := new(mocks.MockCostService)
-			mockEC2 := new(mocks.MockEC2Service)
-			mockELB := new(mocks.MockELBService)
-			mockOutput := new(mocks.MockOutputService)
-			mockUpdate := new(mocks.MockUpdateService)
+			m := newWasteMocks()
 
-			tt.setupMocks(mockEC2, mockELB, mockSTS)
-			mockOutput.On("StopSpinner").Return().Maybe()
-			mockOutput.On("RenderWaste", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			// The failing expectation is registered first so it takes precedence over the empty defaults
+			tt.setupMocks(m)
+			m.expectEmptyWaste()
+			m.output.On("StopSpinner").Return().Maybe()
+			m.output.On("RenderWaste", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-			svc := NewService(mockSTS, mockCost, mockEC2, mockELB, mockOutput, mockUpdate, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
-			err := svc.Orchestrate(model.Flags{Waste: true, Output: "json"})
+			err := m.service().Orchestrate(model.Flags{Waste: true, Output: "json"})
 
 			assert.Error(t, err)
 			assert.Contains(t, err.Error(), tt.expectedErr)
 		})
 	}
 }
+
+func TestWasteWorkflow_SkipsDeniedChecks(t *testing.T) {
+	m := newWasteMocks()
+
+	m.eks.On("GetIdleClusters", mock.Anything).Return(nil, &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "not authorized"})
+	m.kms.On("GetUnusedKeys", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("operation error KMS: ListKeys: %w", &net.DNSError{Err: "no such host", IsNotFound: true}))
+	m.ec2.On("GetUnusedAMIs", mock.Anything, mock.Anything).Return([]model.AMIWasteInfo{}, []model.SkippedCheck{{Name: "AMI references in Auto Scaling groups", Reason: "access denied"}}, nil)
+	m.expectEmptyWaste()
+	m.output.On("StopSpinner").Return()
+	m.output.On("RenderWaste", "123456789012", mock.MatchedBy(func(report model.WasteReport) bool {
+		return assert.ObjectsAreEqual([]model.SkippedCheck{
+			{Name: "AMI references in Auto Scaling groups", Reason: "access denied"},
+			{Name: "EKS clusters", Reason: "access denied", Categories: []string{"idle_eks_clusters"}},
+			{Name: "KMS keys", Reason: "not available in this region", Categories: []string{"unused_kms_keys"}},
+		}, report.SkippedChecks)
+	})).Return(nil)
+
+	err := m.service().Orchestrate(model.Flags{Waste: true, Output: "json"})
+
+	assert.NoError(t, err)
+	m.output.AssertExpectations(t)
+}
+
+func TestSkipReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+		skip     bool
+	}{
+		{name: "no error", err: nil},
+		{name: "generic error", err: errors.New("throttled")},
+		{name: "throttling", err: &smithy.GenericAPIError{Code: "ThrottlingException"}},
+		{name: "access denied", err: &smithy.GenericAPIError{Code: "AccessDeniedException"}, expected: "access denied", skip: true},
+		{name: "unauthorized EC2 operation", err: &smithy.GenericAPIError{Code: "UnauthorizedOperation"}, expected: "access denied", skip: true},
+		{name: "opt-in required", err: &smithy.GenericAPIError{Code: "OptInRequired"}, expected: "the account is not subscribed to the service", skip: true},
+		{name: "unsupported operation", err: &smithy.GenericAPIError{Code: "UnsupportedOperation"}, expected: "not supported in this region", skip: true},
+		{name: "unknown host", err: fmt.Errorf("send request: %w", &net.DNSError{IsNotFound: true}), expected: "not available in this region", skip: true},
+		{name: "temporary DNS failure", err: &net.DNSError{IsTemporary: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, skip := skipReason(tt.err)
+
+			assert.Equal(t, tt.expected, reason)
+			assert.Equal(t, tt.skip, skip)
+		})
+	}
+}
+
+// wasteMocks bundles the mocks needed by the waste workflow.
+type wasteMocks struct {
+	sts        *mocks.MockSTSService
+	cost       *mocks.MockCostService
+	ec2        *mocks.MockEC2Service
+	elb        *mocks.MockELBService
+	eks        *mocks.MockEKSService
+	ecs        *mocks.MockECSService
+	sagemaker  *mocks.MockSageMakerService
+	redshift   *mocks.MockRedshiftService
+	efs        *mocks.MockEFSService
+	backup     *mocks.MockBackupService
+	secrets    *mocks.MockSecretsManagerService
+	kms        *mocks.MockKMSService
+	route53    *mocks.MockRoute53Service
+	kinesis    *mocks.MockKinesisService
+	msk        *mocks.MockMSKService
+	cloudtrail *mocks.MockCloudTrailService
+	output     *mocks.MockOutputService
+	metrics    *mocks.MockMetricsService
+	tui        *mocks.MockTUIService
+}
+
+func newWasteMocks() *wasteMocks {
+	return &wasteMocks{
+		sts:        new(mocks.MockSTSService),
+		cost:       new(mocks.MockCostService),
+		ec2:        new(mocks.MockEC2Service),
+		elb:        new(mocks.MockELBService),
+		eks:        new(mocks.MockEKSService),
+		ecs:        new(mocks.MockECSService),
+		sagemaker:  new(mocks.MockSageMakerService),
+		redshift:   new(mocks.MockRedshiftService),
+		efs:        new(mocks.MockEFSService),
+		backup:     new(mocks.MockBackupService),
+		secrets:    new(mocks.MockSecretsManagerService),
+		kms:        new(mocks.MockKMSService),
+		route53:    new(mocks.MockRoute53Service),
+		kinesis:    new(mocks.MockKinesisService),
+		msk:        new(mocks.MockMSKService),
+		cloudtrail: new(mocks.MockCloudTrailService),
+		output:     new(mocks.MockOutputService),
+		metrics:    new(mocks.MockMetricsService),
+		tui:        new(mocks.MockTUIService),
+	}
+}
+
+func (m *wasteMocks) service() Service {
+	return NewService(Dependencies{
+		STS:            m.sts,
+		Cost:           m.cost,
+		EC2:            m.ec2,
+		ELB:            m.elb,
+		EKS:            m.eks,
+		ECS:            m.ecs,
+		SageMaker:      m.sagemaker,
+		Redshift:       m.redshift,
+		EFS:            m.efs,
+		Backup:         m.backup,
+		SecretsManager: m.secrets,
+		KMS:            m.kms,
+		Route53:        m.route53,
+		Kinesis:        m.kinesis,
+		MSK:            m.msk,
+		CloudTrail:     m.cloudtrail,
+		Output:         m.output,
+		Metrics:        m.metrics,
+		TUI:            m.tui,
+		Update:         new(mocks.MockUpdateService),
+	}, model.VersionInfo{Version: "dev", Commit: "none", Date: "unknown"})
+}
+
+// expectEmptyWaste makes every waste check return no findings. testify matches expectations
+// in registration order, so expectations registered before this call take precedence.
+func (m *wasteMocks) expectEmptyWaste() {
+	m.ec2.On("GetUnusedElasticIPAddressesInfo", mock.Anything).Return([]types.Address{}, nil)
+	m.ec2.On("GetUnusedEBSVolumes", mock.Anything).Return([]types.Volume{}, nil)
+	m.ec2.On("GetStoppedInstancesInfo", mock.Anything).Return([]types.Instance{}, []types.Volume{}, nil)
+	m.ec2.On("GetReservedInstanceExpiringOrExpired30DaysWaste", mock.Anything).Return([]model.RiExpirationInfo{}, nil)
+	m.ec2.On("GetUnusedCapacityReservations", mock.Anything, mock.Anything).Return([]model.CapacityReservationWasteInfo{}, nil)
+	m.ec2.On("GetIdleDedicatedHosts", mock.Anything).Return([]model.DedicatedHostWasteInfo{}, nil)
+	m.ec2.On("GetUnusedAMIs", mock.Anything, mock.Anything).Return([]model.AMIWasteInfo{}, nil, nil)
+	m.ec2.On("GetOrphanedSnapshots", mock.Anything, mock.Anything).Return([]model.SnapshotWasteInfo{}, nil)
+	m.elb.On("GetUnusedLoadBalancers", mock.Anything).Return([]elbtypes.LoadBalancer{}, nil)
+	m.elb.On("GetUnusedClassicLoadBalancers", mock.Anything).Return([]model.ClassicLoadBalancerWasteInfo{}, nil)
+	m.eks.On("GetIdleClusters", mock.Anything).Return([]model.EKSClusterWasteInfo{}, nil)
+	m.ecs.On("GetIdleServices", mock.Anything).Return([]model.ECSServiceWasteInfo{}, nil)
+	m.sagemaker.On("GetIdleEndpoints", mock.Anything, mock.Anything).Return([]model.SageMakerEndpointWasteInfo{}, nil)
+	m.sagemaker.On("GetLongRunningNotebookInstances", mock.Anything, mock.Anything).Return([]model.SageMakerNotebookWasteInfo{}, nil)
+	m.redshift.On("GetIdleClusters", mock.Anything, mock.Anything, mock.Anything).Return([]model.RedshiftClusterWasteInfo{}, nil)
+	m.redshift.On("GetStaleManualSnapshots", mock.Anything, mock.Anything).Return([]model.RedshiftSnapshotWasteInfo{}, nil)
+	m.efs.On("GetFileSystemWaste", mock.Anything, mock.Anything, mock.Anything).Return([]model.EFSFileSystemWasteInfo{}, nil)
+	m.backup.On("GetRecoveryPointWaste", mock.Anything).Return([]model.BackupRecoveryPointWasteInfo{}, nil)
+	m.secrets.On("GetUnusedSecrets", mock.Anything, mock.Anything).Return([]model.SecretWasteInfo{}, nil)
+	m.kms.On("GetUnusedKeys", mock.Anything, mock.Anything).Return([]model.KMSKeyWasteInfo{}, nil)
+	m.route53.On("GetUnusedResources", mock.Anything).Return([]model.Route53HostedZoneWasteInfo{}, []model.Route53HealthCheckWasteInfo{}, nil, nil)
+	m.kinesis.On("GetStreamWaste", mock.Anything, mock.Anything).Return([]model.KinesisStreamWasteInfo{}, nil)
+	m.msk.On("GetIdleClusters", mock.Anything, mock.Anything, mock.Anything).Return([]model.MSKClusterWasteInfo{}, nil)
+	m.cloudtrail.On("GetTrailWaste", mock.Anything).Return([]model.CloudTrailWasteInfo{}, nil)
+	m.sts.On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789012"),
+	}, nil)
+}
+
+// expectServeUntilCancelled makes the metrics server run until the serve loop is stopped
+func (m *wasteMocks) expectServeUntilCancelled() {
+	m.metrics.On("Serve", mock.Anything, ":9877").Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil)
+}
+
+func TestServeMetrics(t *testing.T) {
+	m := newWasteMocks()
+	m.expectEmptyWaste()
+	m.expectServeUntilCancelled()
+
+	forecast := &model.CostForecast{Amount: 80, Unit: "USD"}
+
+	m.cost.On("GetCurrentMonthCostsByService", mock.Anything).Return(&model.CostInfo{}, nil)
+	m.cost.On("GetLastMonthCostsByService", mock.Anything).Return(&model.CostInfo{}, nil)
+	m.cost.On("GetCurrentMonthTotalCosts", mock.Anything).Return(aws.String("100.00 USD"), nil)
+	m.cost.On("GetLastMonthTotalCosts", mock.Anything).Return(aws.String("90.00 USD"), nil)
+	m.cost.On("GetCurrentMonthForecast", mock.Anything).Return(forecast, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m.metrics.On("RecordCostComparison", "123456789012", "90.00 USD", "100.00 USD", mock.Anything, mock.Anything, forecast).Return()
+	m.metrics.On("RecordWaste", "123456789012", mock.Anything).Run(func(mock.Arguments) { cancel() }).Return()
+
+	svc, ok := m.service().(*service)
+	if !ok {
+		t.Fatal("NewService did not return *service type")
+	}
+
+	err := svc.serveMetrics(ctx, ":9877", time.Hour)
+
+	assert.NoError(t, err)
+	m.cost.AssertExpectations(t)
+	m.metrics.AssertExpectations(t)
+}
+
+func TestServeMetrics_RecordsFailures(t *testing.T) {
+	m := newWasteMocks()
+	m.expectEmptyWaste()
+	m.expectServeUntilCancelled()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m.cost.On("GetCurrentMonthCostsByService", mock.Anything).Return(nil, errors.New("throttled"))
+	m.metrics.On("RecordFailure", "cost").Return()
+	m.metrics.On("RecordWaste", "123456789012", mock.Anything).Run(func(mock.Arguments) { cancel() }).Return()
+
+	svc, ok := m.service().(*service)
+	if !ok {
+		t.Fatal("NewService did not return *service type")
+	}
+
+	err := svc.serveMetrics(ctx, ":9877", time.Hour)
+
+	assert.NoError(t, err)
+	m.metrics.AssertExpectations(t)
+	m.metrics.AssertNotCalled(t, "RecordCostComparison", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestServeMetrics_ServerError(t *testing.T) {
+	m := newWasteMocks()
+	m.expectEmptyWaste()
+
+	m.metrics.On("Serve", mock.Anything, ":9877").Return(errors.New("address already in use"))
+	m.cost.On("GetCurrentMonthCostsByService", mock.Anything).Return(nil, context.Canceled).Maybe()
+	m.metrics.On("RecordFailure", mock.Anything).Return().Maybe()
+	m.metrics.On("RecordWaste", mock.Anything, mock.Anything).Return().Maybe()
+
+	svc, ok := m.service().(*service)
+	if !ok {
+		t.Fatal("NewService did not return *service type")
+	}
+
+	err := svc.serveMetrics(context.Background(), ":9877", time.Hour)
+
+	assert.ErrorContains(t, err, "address already in use")
+}
+
+func TestOrchestrate_RouteToTUIWorkflow(t *testing.T) {
+	m := newWasteMocks()
+	m.expectEmptyWaste()
+
+	trend := []model.CostInfo{{CostGroup: model.CostGroup{"Total": {Amount: 90, Unit: "USD"}}}}
+
+	m.cost.On("GetCurrentMonthCostsByService", mock.Anything).Return(&model.CostInfo{}, nil)
+	m.cost.On("GetLastMonthCostsByService", mock.Anything).Return(&model.CostInfo{}, nil)
+	m.cost.On("GetCurrentMonthTotalCosts", mock.Anything).Return(aws.String("100.00 USD"), nil)
+	m.cost.On("GetLastMonthTotalCosts", mock.Anything).Return(aws.String("90.00 USD"), nil)
+	m.cost.On("GetLastSixMonthsCosts", mock.Anything).Return(trend, nil)
+	m.output.On("StopSpinner").Return()
+
+	var (
+		data    tui.Data
+		loadErr error
+	)
+
+	m.tui.On("Run", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		load, ok := args.Get(1).(tui.LoadFunc)
+		if !ok {
+			t.Fatal("Run was not given a tui.LoadFunc")
+		}
+
+		data, loadErr = load(context.Background())
+	}).Return(nil)
+
+	err := m.service().Orchestrate(model.Flags{TUI: true})
+
+	assert.NoError(t, err)
+	assert.NoError(t, loadErr)
+	assert.Equal(t, "123456789012", data.AccountID)
+	assert.Equal(t, "90.00 USD", data.LastTotalCost)
+	assert.Equal(t, "100.00 USD", data.CurrentTotalCost)
+	assert.Equal(t, trend, data.Trend)
+	m.output.AssertExpectations(t)
+	m.tui.AssertExpectations(t)
+}
+
+func TestFetchDashboardData_Error(t *testing.T) {
+	m := newWasteMocks()
+	m.expectEmptyWaste()
+
+	m.cost.On("GetCurrentMonthCostsByService", mock.Anything).Return(&model.CostInfo{}, nil)
+	m.cost.On("GetLastMonthCostsByService", mock.Anything).Return(&model.CostInfo{}, nil)
+	m.cost.On("GetCurrentMonthTotalCosts", mock.Anything).Return(aws.String("100.00 USD"), nil)
+	m.cost.On("GetLastMonthTotalCosts", mock.Anything).Return(aws.String("90.00 USD"), nil)
+	m.cost.On("GetLastSixMonthsCosts", mock.Anything).Return(nil, errors.New("throttled"))
+
+	svc, ok := m.service().(*service)
+	if !ok {
+		t.Fatal("NewService did not return *service type")
+	}
+
+	_, err := svc.fetchDashboardData(context.Background())
+
+	assert.ErrorContains(t, err, "throttled")
+}
@@ -2,22 +2,78 @@ package orchestrator
 
 import (
 	"github.com/elC0mpa/aws-doctor/model"
+	awsbackup "github.com/elC0mpa/aws-doctor/service/backup"
+	awscloudtrail "github.com/elC0mpa/aws-doctor/service/cloudtrail"
+	"github.com/elC0mpa/aws-doctor/service/computeoptimizer"
 	awscostexplorer "github.com/elC0mpa/aws-doctor/service/costexplorer"
 	awsec2 "github.com/elC0mpa/aws-doctor/service/ec2"
+	awsecs "github.com/elC0mpa/aws-doctor/service/ecs"
+	awsefs "github.com/elC0mpa/aws-doctor/service/efs"
+	awseks "github.com/elC0mpa/aws-doctor/service/eks"
 	"github.com/elC0mpa/aws-doctor/service/elb"
+	awskafka "github.com/elC0mpa/aws-doctor/service/kafka"
+	awskinesis "github.com/elC0mpa/aws-doctor/service/kinesis"
+	awskms "github.com/elC0mpa/aws-doctor/service/kms"
+	"github.com/elC0mpa/aws-doctor/service/metrics"
 	"github.com/elC0mpa/aws-doctor/service/output"
+	awsredshift "github.com/elC0mpa/aws-doctor/service/redshift"
+	awsroute53 "github.com/elC0mpa/aws-doctor/service/route53"
+	awssagemaker "github.com/elC0mpa/aws-doctor/service/sagemaker"
+	awssecretsmanager "github.com/elC0mpa/aws-doctor/service/secretsmanager"
 	awssts "github.com/elC0mpa/aws-doctor/service/sts"
+	"github.com/elC0mpa/aws-doctor/service/tui"
 	"github.com/elC0mpa/aws-doctor/service/update"
 )
 
 type service struct {
-	stsService    awssts.Service
-	costService   awscostexplorer.Service
-	ec2Service    awsec2.Service
-	elbService    elb.Service
-	outputService output.Service
-	updateService update.Service
-	versionInfo   model.VersionInfo
+	stsService              awssts.Service
+	costService             awscostexplorer.Service
+	ec2Service              awsec2.Service
+	elbService              elb.Service
+	computeOptimizerService computeoptimizer.Service
+	eksService              awseks.Service
+	ecsService              awsecs.Service
+	sageMakerService        awssagemaker.Service
+	redshiftService         awsredshift.Service
+	efsService              awsefs.Service
+	backupService           awsbackup.Service
+	secretsManagerService   awssecretsmanager.Service
+	kmsService              awskms.Service
+	route53Service          awsroute53.Service
+	kinesisService          awskinesis.Service
+	mskService              awskafka.Service
+	cloudTrailService       awscloudtrail.Service
+	outputService           output.Service
+	metricsService          metrics.Service
+	tuiService              tui.Service
+	updateService           update.Service
+	versionInfo             model.VersionInfo
+}
+
+// Dependencies groups the services the orchestrator coordinates.
+// AWS services may be left nil for workflows that do not call AWS, such as version and update.
+type Dependencies struct {
+	STS              awssts.Service
+	Cost             awscostexplorer.Service
+	EC2              awsec2.Service
+	ELB              elb.Service
+	ComputeOptimizer computeoptimizer.Service
+	EKS              awseks.Service
+	ECS              awsecs.Service
+	SageMaker        awssagemaker.Service
+	Redshift         awsredshift.Service
+	EFS              awsefs.Service
+	Backup           awsbackup.Service
+	SecretsManager   awssecretsmanager.Service
+	KMS              awskms.Service
+	Route53          awsroute53.Service
+	Kinesis          awskinesis.Service
+	MSK              awskafka.Service
+	CloudTrail       awscloudtrail.Service
+	Output           output.Service
+	Metrics          metrics.Service // Only used by the serve workflow
+	TUI              tui.Service     // Only used by the tui workflow
+	Update           update.Service
 }
 
 // Service is the interface for orchestrator service.
@@ -1,59 +1,247 @@
-// Package output provides a service for rendering results to the console.
+// Package output provides a service for rendering results to the console and to files.
 package output
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
 	"github.com/elC0mpa/aws-doctor/model"
 	"github.com/elC0mpa/aws-doctor/utils"
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
-// NewService creates a new output service with the specified format
-func NewService(format string) Service {
-	f := FormatTable
-	if format == "json" {
-		f = FormatJSON
+// NewService creates a new output service with the specified options
+func NewService(opts Options) Service {
+	stdout := opts.Stdout
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+
+	targets := make([]target, 0, len(opts.Targets))
+	for _, t := range opts.Targets {
+		targets = append(targets, target{format: parseFormat(t.Format), path: t.Path})
 	}
 
-	return &service{format: f}
+	if len(targets) == 0 {
+		targets = append(targets, target{format: FormatTable})
+	}
+
+	return &service{targets: targets, templatePath: opts.TemplatePath, stdout: stdout, region: opts.Region}
 }
 
-func (s *service) RenderCostComparison(accountID, lastTotalCost, currentTotalCost string, lastMonth, currentMonth *model.CostInfo) error {
-	if s.format == FormatJSON {
-		return utils.OutputCostComparisonJSON(
-			accountID,
-			utils.ParseCostString(lastTotalCost),
-			utils.ParseCostString(currentTotalCost),
-			lastMonth,
-			currentMonth,
-		)
+func parseFormat(format string) Format {
+	switch f := Format(format); f {
+	case FormatJSON, FormatCSV, FormatMarkdown, FormatPDF, FormatHTML, FormatTemplate, FormatOpenMetrics:
+		return f
 	}
 
-	utils.DrawCostTable(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth, "UnblendedCost")
+	return FormatTable
+}
+
+// render writes a report once per target, so the data fetched for a run feeds every requested
+// format. It stops at the first failing target.
+func (s *service) render(renderTarget func(w io.Writer, format Format) error) error {
+	for _, t := range s.targets {
+		if err := s.renderTo(t, renderTarget); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
-func (s *service) RenderTrend(accountID string, costInfo []model.CostInfo) error {
-	if s.format == FormatJSON {
-		return utils.OutputTrendJSON(accountID, costInfo)
+// reportFileMode is the permission of the report files, readable by collectors such as the
+// node_exporter textfile collector
+const reportFileMode = 0o644
+
+func (s *service) renderTo(t target, renderTarget func(w io.Writer, format Format) error) error {
+	if t.path == "" {
+		return renderTarget(s.stdout, t.format)
 	}
 
-	utils.DrawTrendChart(accountID, costInfo)
+	// Terminal colours would end up as escape sequences in the file. They are only turned back
+	// on if they were on, since NO_COLOR or a non-terminal stdout may have turned them off.
+	if t.format == FormatTable && colorsEnabled() {
+		text.DisableColors()
+		defer text.EnableColors()
+	}
 
-	return nil
+	return writeFileAtomic(t.path, func(w io.Writer) error {
+		return renderTarget(w, t.format)
+	})
 }
 
-func (s *service) RenderWaste(accountID string, elasticIPs []types.Address, unusedVolumes []types.Volume, stoppedVolumes []types.Volume, ris []model.RiExpirationInfo, stoppedInstances []types.Instance, loadBalancers []elbtypes.LoadBalancer, unusedAMIs []model.AMIWasteInfo, orphanedSnapshots []model.SnapshotWasteInfo) error {
-	if s.format == FormatJSON {
-		return utils.OutputWasteJSON(accountID, elasticIPs, unusedVolumes, stoppedVolumes, ris, stoppedInstances, loadBalancers, unusedAMIs, orphanedSnapshots)
+// writeFileAtomic writes to a temporary file in the directory of path and renames it over path once
+// complete, so a reader never sees a partial report and a failed run leaves the previous one intact.
+func writeFileAtomic(path string, write func(w io.Writer) error) (err error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
 	}
 
-	utils.DrawWasteTable(accountID, elasticIPs, unusedVolumes, stoppedVolumes, ris, stoppedInstances, loadBalancers, unusedAMIs, orphanedSnapshots)
+	defer func() {
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	if err = write(file); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err = file.Chmod(reportFileMode); err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	if err = os.Rename(file.Name(), path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
 
 	return nil
 }
 
+// colorsEnabled reports whether go-pretty currently colours text, a global it does not expose
+func colorsEnabled() bool {
+	return text.FgRed.Sprint("x") != "x"
+}
+
+func (s *service) RenderCostComparison(accountID, lastTotalCost, currentTotalCost string, lastMonth, currentMonth *model.CostInfo) error {
+	last := utils.ParseCostString(lastTotalCost)
+	current := utils.ParseCostString(currentTotalCost)
+
+	return s.render(func(w io.Writer, format Format) error {
+		switch format {
+		case FormatJSON:
+			return utils.OutputCostComparisonJSON(w, accountID, last, current, lastMonth, currentMonth)
+		case FormatCSV:
+			return utils.OutputCostComparisonCSV(w, accountID, last, current, lastMonth, currentMonth)
+		case FormatMarkdown:
+			return utils.OutputCostComparisonMarkdown(w, accountID, last, current, lastMonth, currentMonth)
+		case FormatPDF:
+			return utils.OutputCostComparisonPDF(w, accountID, last, current, lastMonth, currentMonth)
+		case FormatHTML:
+			return utils.OutputCostComparisonHTML(w, accountID, last, current, lastMonth, currentMonth)
+		case FormatTemplate:
+			return utils.OutputCostComparisonTemplate(w, s.templatePath, accountID, last, current, lastMonth, currentMonth)
+		case FormatOpenMetrics:
+			return utils.OutputCostComparisonOpenMetrics(w, accountID, last, current, lastMonth, currentMonth)
+		}
+
+		utils.DrawCostTable(w, accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth, "UnblendedCost")
+
+		return nil
+	})
+}
+
+func (s *service) RenderTrend(accountID string, costInfo []model.CostInfo) error {
+	return s.render(func(w io.Writer, format Format) error {
+		switch format {
+		case FormatJSON:
+			return utils.OutputTrendJSON(w, accountID, costInfo)
+		case FormatCSV:
+			return utils.OutputTrendCSV(w, accountID, costInfo)
+		case FormatMarkdown:
+			return utils.OutputTrendMarkdown(w, accountID, costInfo)
+		case FormatPDF:
+			return utils.OutputTrendPDF(w, accountID, costInfo)
+		case FormatHTML:
+			return utils.OutputTrendHTML(w, accountID, costInfo)
+		case FormatTemplate:
+			return utils.OutputTrendTemplate(w, s.templatePath, accountID, costInfo)
+		case FormatOpenMetrics:
+			return fmt.Errorf("%s output is not supported for the trend report", format)
+		}
+
+		utils.DrawTrendChart(w, accountID, costInfo)
+
+		return nil
+	})
+}
+
+func (s *service) RenderWaste(accountID string, report model.WasteReport) error {
+	return s.render(func(w io.Writer, format Format) error {
+		switch format {
+		case FormatJSON:
+			return utils.OutputWasteJSON(w, accountID, report)
+		case FormatCSV:
+			return utils.OutputWasteCSV(w, accountID, report)
+		case FormatMarkdown:
+			return utils.OutputWasteMarkdown(w, accountID, report)
+		case FormatPDF:
+			return utils.OutputWastePDF(w, accountID, report)
+		case FormatHTML:
+			return utils.OutputWasteHTML(w, accountID, report)
+		case FormatTemplate:
+			return utils.OutputWasteTemplate(w, s.templatePath, accountID, report)
+		case FormatOpenMetrics:
+			return utils.OutputWasteOpenMetrics(w, accountID, s.region, report)
+		}
+
+		utils.DrawWasteTable(w, accountID, report)
+
+		return nil
+	})
+}
+
+func (s *service) RenderCommitments(accountID string, report model.CommitmentReport) error {
+	return s.render(func(w io.Writer, format Format) error {
+		switch format {
+		case FormatJSON:
+			return utils.OutputCommitmentsJSON(w, accountID, report)
+		case FormatCSV, FormatMarkdown, FormatPDF, FormatHTML, FormatTemplate, FormatOpenMetrics:
+			return fmt.Errorf("%s output is not supported for the commitments report", format)
+		}
+
+		utils.DrawCommitmentsTable(w, accountID, report)
+
+		return nil
+	})
+}
+
+func (s *service) RenderRecommendations(accountID string, report model.RecommendationReport) error {
+	return s.render(func(w io.Writer, format Format) error {
+		switch format {
+		case FormatJSON:
+			return utils.OutputRecommendationsJSON(w, accountID, report)
+		case FormatCSV, FormatMarkdown, FormatPDF, FormatHTML, FormatTemplate, FormatOpenMetrics:
+			return fmt.Errorf("%s output is not supported for the recommendations report", format)
+		}
+
+		utils.DrawRecommendationsTable(w, accountID, report)
+
+		return nil
+	})
+}
+
+func (s *service) RenderRightsizing(accountID string, report model.RightsizingReport) error {
+	return s.render(func(w io.Writer, format Format) error {
+		switch format {
+		case FormatJSON:
+			return utils.OutputRightsizingJSON(w, accountID, report)
+		case FormatCSV, FormatMarkdown, FormatPDF, FormatHTML, FormatTemplate, FormatOpenMetrics:
+			return fmt.Errorf("%s output is not supported for the rightsizing report", format)
+		}
+
+		utils.DrawRightsizingTable(w, accountID, report)
+
+		return nil
+	})
+}
+
+func (s *service) RenderSchema() error {
+	return utils.OutputJSONSchema(s.stdout)
+}
+
 func (s *service) StopSpinner() {
 	utils.StopSpinner()
 }
@@ -1,7 +1,17 @@
 package output
 
 import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 func TestNewService(t *testing.T) {
@@ -15,6 +25,36 @@ func TestNewService(t *testing.T) {
 			inputFormat:    "json",
 			expectedFormat: FormatJSON,
 		},
+		{
+			name:           "csv format",
+			inputFormat:    "csv",
+			expectedFormat: FormatCSV,
+		},
+		{
+			name:           "markdown format",
+			inputFormat:    "markdown",
+			expectedFormat: FormatMarkdown,
+		},
+		{
+			name:           "pdf format",
+			inputFormat:    "pdf",
+			expectedFormat: FormatPDF,
+		},
+		{
+			name:           "html format",
+			inputFormat:    "html",
+			expectedFormat: FormatHTML,
+		},
+		{
+			name:           "template format",
+			inputFormat:    "template",
+			expectedFormat: FormatTemplate,
+		},
+		{
+			name:           "openmetrics format",
+			inputFormat:    "openmetrics",
+			expectedFormat: FormatOpenMetrics,
+		},
 		{
 			name:           "table format explicit",
 			inputFormat:    "table",
@@ -34,7 +74,10 @@ func TestNewService(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			svc := NewService(tt.inputFormat)
+			svc := NewService(Options{
+				Targets:      []model.OutputTarget{{Format: tt.inputFormat, Path: "report.out"}},
+				TemplatePath: "report.tmpl",
+			})
 
 			// Type assert to access internal format field
 			s, ok := svc.(*service)
@@ -42,13 +85,172 @@ func TestNewService(t *testing.T) {
 				t.Fatal("NewService did not return *service type")
 			}
 
-			if s.format != tt.expectedFormat {
-				t.Errorf("expected format %q, got %q", tt.expectedFormat, s.format)
+			if len(s.targets) != 1 || s.targets[0].format != tt.expectedFormat {
+				t.Fatalf("expected a single %q target, got %+v", tt.expectedFormat, s.targets)
+			}
+
+			if s.targets[0].path != "report.out" || s.templatePath != "report.tmpl" {
+				t.Errorf("expected path and templatePath to be kept, got %q and %q", s.targets[0].path, s.templatePath)
+			}
+
+			if s.stdout != os.Stdout {
+				t.Error("expected stdout to default to os.Stdout")
 			}
 		})
 	}
 }
 
+func TestNewService_NoTargets(t *testing.T) {
+	s, ok := NewService(Options{}).(*service)
+	if !ok {
+		t.Fatal("NewService did not return *service type")
+	}
+
+	if len(s.targets) != 1 || s.targets[0] != (target{format: FormatTable}) {
+		t.Errorf("expected a single table target on stdout, got %+v", s.targets)
+	}
+}
+
+func TestRenderTrend_MultipleTargets(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "trend.json")
+	csvPath := filepath.Join(dir, "trend.csv")
+
+	var stdout bytes.Buffer
+
+	svc := NewService(Options{
+		Targets: []model.OutputTarget{
+			{Format: "markdown"},
+			{Format: "json", Path: jsonPath},
+			{Format: "csv", Path: csvPath},
+		},
+		Stdout: &stdout,
+	})
+
+	costInfo := []model.CostInfo{{CostGroup: model.CostGroup{"Total": {Amount: 42.5, Unit: "USD"}}}}
+	costInfo[0].Start = aws.String("2024-01-01")
+	costInfo[0].End = aws.String("2024-01-31")
+
+	if err := svc.RenderTrend("123456789012", costInfo); err != nil {
+		t.Fatalf("RenderTrend() error = %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "AWS Doctor Trend") {
+		t.Errorf("expected the Markdown report on stdout, got %q", stdout.String())
+	}
+
+	jsonData, err := os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", jsonPath, err)
+	}
+
+	if !strings.Contains(string(jsonData), `"account_id": "123456789012"`) {
+		t.Errorf("unexpected JSON report: %s", jsonData)
+	}
+
+	csvData, err := os.ReadFile(csvPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", csvPath, err)
+	}
+
+	if !strings.Contains(string(csvData), "123456789012,2024-01-01,2024-01-31,42.50,USD") {
+		t.Errorf("unexpected CSV report: %s", csvData)
+	}
+}
+
+func TestRenderCommitments_UnsupportedFormat(t *testing.T) {
+	var stdout bytes.Buffer
+
+	svc := NewService(Options{Targets: []model.OutputTarget{{Format: "csv"}}, Stdout: &stdout})
+
+	err := svc.RenderCommitments("123456789012", model.CommitmentReport{})
+	if err == nil || !strings.Contains(err.Error(), "csv output is not supported") {
+		t.Errorf("expected an unsupported format error, got %v", err)
+	}
+}
+
+func TestRenderWaste_OpenMetrics(t *testing.T) {
+	var stdout bytes.Buffer
+
+	svc := NewService(Options{Targets: []model.OutputTarget{{Format: "openmetrics"}}, Stdout: &stdout, Region: "eu-west-1"})
+
+	if err := svc.RenderWaste("123456789012", model.WasteReport{}); err != nil {
+		t.Fatalf("RenderWaste() error = %v", err)
+	}
+
+	want := `aws_doctor_waste_resources{account_id="123456789012",region="eu-west-1",category="unused_ebs_volumes"} 0`
+	if !strings.Contains(stdout.String(), want) || !strings.HasSuffix(stdout.String(), "# EOF\n") {
+		t.Errorf("unexpected OpenMetrics output: %s", stdout.String())
+	}
+
+	err := svc.RenderTrend("123456789012", nil)
+	if err == nil || !strings.Contains(err.Error(), "openmetrics output is not supported") {
+		t.Errorf("expected an unsupported format error for the trend report, got %v", err)
+	}
+}
+
+func TestRender_CreateFileError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "report.json")
+
+	svc := NewService(Options{Targets: []model.OutputTarget{{Format: "json", Path: path}}})
+
+	err := svc.RenderWaste("123456789012", model.WasteReport{})
+	if err == nil || !strings.Contains(err.Error(), "failed to create") {
+		t.Errorf("expected a file creation error, got %v", err)
+	}
+}
+
+func TestWriteFileAtomic_KeepsPreviousFileOnError(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "aws_doctor_waste.prom")
+
+	if err := os.WriteFile(path, []byte("previous"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := writeFileAtomic(path, func(w io.Writer) error {
+		_, _ = io.WriteString(w, "partial")
+
+		return errors.New("render failed")
+	})
+	if err == nil {
+		t.Fatal("writeFileAtomic() error = nil, want the render error")
+	}
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "previous" {
+		t.Errorf("file content = %q, want the previous report", data)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("directory has %d entries, want the temporary file removed", len(entries))
+	}
+}
+
+func TestRenderTo_RestoresColorState(t *testing.T) {
+	defer text.EnableColors()
+
+	path := filepath.Join(t.TempDir(), "trend.txt")
+	svc := NewService(Options{Targets: []model.OutputTarget{{Format: "table", Path: path}}})
+
+	for _, enabled := range []bool{false, true} {
+		if enabled {
+			text.EnableColors()
+		} else {
+			text.DisableColors()
+		}
+
+		if err := svc.RenderTrend("123456789012", nil); err != nil {
+			t.Fatalf("RenderTrend() error = %v", err)
+		}
+
+		if colorsEnabled() != enabled {
+			t.Errorf("colours enabled = %v after rendering to a file, want %v", colorsEnabled(), enabled)
+		}
+	}
+}
+
 func TestFormatConstants(t *testing.T) {
 	if FormatTable != "table" {
 		t.Errorf("FormatTable should be 'table', got %q", FormatTable)
@@ -57,4 +259,28 @@ func TestFormatConstants(t *testing.T) {
 	if FormatJSON != "json" {
 		t.Errorf("FormatJSON should be 'json', got %q", FormatJSON)
 	}
+
+	if FormatCSV != "csv" {
+		t.Errorf("FormatCSV should be 'csv', got %q", FormatCSV)
+	}
+
+	if FormatMarkdown != "markdown" {
+		t.Errorf("FormatMarkdown should be 'markdown', got %q", FormatMarkdown)
+	}
+
+	if FormatPDF != "pdf" {
+		t.Errorf("FormatPDF should be 'pdf', got %q", FormatPDF)
+	}
+
+	if FormatHTML != "html" {
+		t.Errorf("FormatHTML should be 'html', got %q", FormatHTML)
+	}
+
+	if FormatTemplate != "template" {
+		t.Errorf("FormatTemplate should be 'template', got %q", FormatTemplate)
+	}
+
+	if FormatOpenMetrics != "openmetrics" {
+		t.Errorf("FormatOpenMetrics should be 'openmetrics', got %q", FormatOpenMetrics)
+	}
 }
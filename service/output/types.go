@@ -1,8 +1,8 @@
 package output
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"io"
+
 	"github.com/elC0mpa/aws-doctor/model"
 )
 
@@ -11,25 +11,62 @@ type Format string
 
 // FormatTable represents the table output format.
 const (
-	FormatTable Format = "table"
-	FormatJSON  Format = "json"
+	FormatTable       Format = "table"
+	FormatJSON        Format = "json"
+	FormatCSV         Format = "csv"
+	FormatMarkdown    Format = "markdown"
+	FormatPDF         Format = "pdf"
+	FormatHTML        Format = "html"
+	FormatTemplate    Format = "template"
+	FormatOpenMetrics Format = "openmetrics"
 )
 
+// Options configures the output service
+type Options struct {
+	// Targets lists every format a report is rendered in; unknown formats fall back to FormatTable
+	// and no targets means a single table on standard output
+	Targets      []model.OutputTarget
+	TemplatePath string    // User-defined text/template executed by FormatTemplate
+	Stdout       io.Writer // Destination of targets without a path; defaults to os.Stdout
+	Region       string    // Region label of the FormatOpenMetrics waste summary
+}
+
+// target is a single destination a report is rendered to
+type target struct {
+	format Format
+	path   string // Empty for standard output
+}
+
 // service is the internal implementation
 type service struct {
-	format Format
+	targets      []target
+	templatePath string
+	stdout       io.Writer
+	region       string
 }
 
 // Service defines the interface for output operations
 type Service interface {
-	// RenderCostComparison outputs cost comparison data in the configured format
+	// RenderCostComparison outputs cost comparison data in the configured formats
 	RenderCostComparison(accountID, lastTotalCost, currentTotalCost string, lastMonth, currentMonth *model.CostInfo) error
 
-	// RenderTrend outputs trend data in the configured format
+	// RenderTrend outputs trend data in the configured formats
 	RenderTrend(accountID string, costInfo []model.CostInfo) error
 
-	// RenderWaste outputs waste report data in the configured format
-	RenderWaste(accountID string, elasticIPs []types.Address, unusedVolumes []types.Volume, stoppedVolumes []types.Volume, ris []model.RiExpirationInfo, stoppedInstances []types.Instance, loadBalancers []elbtypes.LoadBalancer, unusedAMIs []model.AMIWasteInfo, orphanedSnapshots []model.SnapshotWasteInfo) error
+	// RenderWaste outputs waste report data in the configured formats
+	RenderWaste(accountID string, report model.WasteReport) error
+
+	// RenderCommitments outputs Reserved Instance and Savings Plans report data in the configured formats
+	RenderCommitments(accountID string, report model.CommitmentReport) error
+
+	// RenderRecommendations outputs Savings Plans and Reserved Instance purchase recommendations in the configured formats
+	RenderRecommendations(accountID string, report model.RecommendationReport) error
+
+	// RenderRightsizing outputs EC2, EBS and Lambda rightsizing recommendations in the configured formats
+	RenderRightsizing(accountID string, report model.RightsizingReport) error
+
+	// RenderSchema outputs the JSON Schema describing the JSON reports to standard output
+	RenderSchema() error
 
 	// StopSpinner stops the loading spinner before rendering output
 	StopSpinner()
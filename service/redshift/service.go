@@ -0,0 +1,205 @@
+// Package redshift provides a service for interacting with Amazon Redshift.
+package redshift
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Approximate on-demand hourly prices (us-east-1) per Redshift node.
+// Node types missing from this table are reported without a cost estimate.
+var nodeHourlyCost = map[string]float64{
+	"dc2.large":    0.25,
+	"dc2.8xlarge":  4.80,
+	"ds2.xlarge":   0.85,
+	"ds2.8xlarge":  6.80,
+	"ra3.large":    0.543,
+	"ra3.xlplus":   1.086,
+	"ra3.4xlarge":  3.26,
+	"ra3.16xlarge": 13.04,
+}
+
+// Redshift backup storage beyond the free allocation is billed at ~$0.024 per GB-month
+const (
+	snapshotCostPerGBMonth = 0.024
+	hoursPerMonth          = 730
+	secondsPerDay          = 24 * 60 * 60
+)
+
+// Recommendations attached to idle clusters
+const (
+	noConnectionsRecommendation = "Pause the cluster or delete it after a final snapshot"
+	lowCPURecommendation        = "Schedule pause/resume or migrate to Redshift Serverless"
+)
+
+// NewService creates a new Redshift service.
+func NewService(awsconfig aws.Config) Service {
+	client := redshift.NewFromConfig(awsconfig)
+	cloudwatchClient := cloudwatch.NewFromConfig(awsconfig)
+
+	return &service{
+		client:           client,
+		cloudwatchClient: cloudwatchClient,
+	}
+}
+
+// GetIdleClusters returns available provisioned clusters that had no database connections, or whose
+// average CPU utilization stayed below cpuThreshold, during the last observationDays days. Paused
+// clusters and clusters created within the observation window are skipped.
+func (s *service) GetIdleClusters(ctx context.Context, observationDays int, cpuThreshold float64) ([]model.RedshiftClusterWasteInfo, error) {
+	var results []model.RedshiftClusterWasteInfo
+
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -observationDays)
+
+	paginator := redshift.NewDescribeClustersPaginator(s.client, &redshift.DescribeClustersInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range output.Clusters {
+			if aws.ToString(cluster.ClusterStatus) != "available" || aws.ToTime(cluster.ClusterCreateTime).After(startTime) {
+				continue
+			}
+
+			clusterID := aws.ToString(cluster.ClusterIdentifier)
+
+			connections, err := s.getDailyStatistics(ctx, "DatabaseConnections", cwtypes.StatisticMaximum, clusterID, startTime, endTime)
+			if err != nil {
+				return nil, err
+			}
+
+			cpu, err := s.getDailyStatistics(ctx, "CPUUtilization", cwtypes.StatisticAverage, clusterID, startTime, endTime)
+			if err != nil {
+				return nil, err
+			}
+
+			if info, isWaste := classifyCluster(cluster, connections, cpu, observationDays, cpuThreshold); isWaste {
+				results = append(results, info)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// GetStaleManualSnapshots returns available manual snapshots that were created more than staleDays days ago.
+func (s *service) GetStaleManualSnapshots(ctx context.Context, staleDays int) ([]model.RedshiftSnapshotWasteInfo, error) {
+	var results []model.RedshiftSnapshotWasteInfo
+
+	now := time.Now()
+
+	paginator := redshift.NewDescribeClusterSnapshotsPaginator(s.client, &redshift.DescribeClusterSnapshotsInput{
+		SnapshotType: aws.String("manual"),
+		EndTime:      aws.Time(now.AddDate(0, 0, -staleDays)),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, snapshot := range output.Snapshots {
+			if aws.ToString(snapshot.Status) != "available" {
+				continue
+			}
+
+			results = append(results, snapshotToInfo(snapshot, now))
+		}
+	}
+
+	return results, nil
+}
+
+// getDailyStatistics returns one datapoint per day for a cluster-level AWS/Redshift metric.
+func (s *service) getDailyStatistics(ctx context.Context, metricName string, statistic cwtypes.Statistic, clusterID string, startTime, endTime time.Time) ([]cwtypes.Datapoint, error) {
+	output, err := s.cloudwatchClient.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/Redshift"),
+		MetricName: aws.String(metricName),
+		Dimensions: []cwtypes.Dimension{
+			{Name: aws.String("ClusterIdentifier"), Value: aws.String(clusterID)},
+		},
+		StartTime:  aws.Time(startTime),
+		EndTime:    aws.Time(endTime),
+		Period:     aws.Int32(secondsPerDay),
+		Statistics: []cwtypes.Statistic{statistic},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Datapoints, nil
+}
+
+// classifyCluster decides whether a cluster is idle. Connection datapoints are reduced to their
+// maximum and CPU datapoints to their mean; clusters without any CPU datapoints are not classified.
+func classifyCluster(cluster types.Cluster, connections, cpu []cwtypes.Datapoint, observationDays int, cpuThreshold float64) (model.RedshiftClusterWasteInfo, bool) {
+	if len(cpu) == 0 {
+		return model.RedshiftClusterWasteInfo{}, false
+	}
+
+	var maxConnections float64
+
+	for _, datapoint := range connections {
+		maxConnections = max(maxConnections, aws.ToFloat64(datapoint.Maximum))
+	}
+
+	var totalCPU float64
+
+	for _, datapoint := range cpu {
+		totalCPU += aws.ToFloat64(datapoint.Average)
+	}
+
+	nodeType := aws.ToString(cluster.NodeType)
+	nodeCount := aws.ToInt32(cluster.NumberOfNodes)
+
+	info := model.RedshiftClusterWasteInfo{
+		ClusterIdentifier:    aws.ToString(cluster.ClusterIdentifier),
+		NodeType:             nodeType,
+		NodeCount:            nodeCount,
+		MaxConnections:       maxConnections,
+		AverageCPUPercent:    totalCPU / float64(len(cpu)),
+		ObservationDays:      observationDays,
+		EstimatedMonthlyCost: nodeHourlyCost[nodeType] * float64(nodeCount) * hoursPerMonth,
+	}
+
+	switch {
+	case maxConnections == 0:
+		info.Status = model.RedshiftClusterNoConnections
+		info.Recommendation = noConnectionsRecommendation
+	case info.AverageCPUPercent < cpuThreshold:
+		info.Status = model.RedshiftClusterLowCPU
+		info.Recommendation = lowCPURecommendation
+	default:
+		return info, false
+	}
+
+	return info, true
+}
+
+func snapshotToInfo(snapshot types.Snapshot, now time.Time) model.RedshiftSnapshotWasteInfo {
+	createTime := aws.ToTime(snapshot.SnapshotCreateTime)
+	sizeGB := aws.ToFloat64(snapshot.TotalBackupSizeInMegaBytes) / 1024
+
+	return model.RedshiftSnapshotWasteInfo{
+		SnapshotIdentifier:   aws.ToString(snapshot.SnapshotIdentifier),
+		ClusterIdentifier:    aws.ToString(snapshot.ClusterIdentifier),
+		NodeType:             aws.ToString(snapshot.NodeType),
+		NodeCount:            aws.ToInt32(snapshot.NumberOfNodes),
+		SizeGB:               sizeGB,
+		CreateTime:           createTime,
+		DaysSinceCreate:      int(now.Sub(createTime).Hours() / 24),
+		EstimatedMonthlyCost: sizeGB * snapshotCostPerGBMonth,
+	}
+}
@@ -0,0 +1,153 @@
+package redshift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func connectionDatapoints(values ...float64) []cwtypes.Datapoint {
+	var datapoints []cwtypes.Datapoint
+	for _, value := range values {
+		datapoints = append(datapoints, cwtypes.Datapoint{Maximum: aws.Float64(value)})
+	}
+
+	return datapoints
+}
+
+func cpuDatapoints(values ...float64) []cwtypes.Datapoint {
+	var datapoints []cwtypes.Datapoint
+	for _, value := range values {
+		datapoints = append(datapoints, cwtypes.Datapoint{Average: aws.Float64(value)})
+	}
+
+	return datapoints
+}
+
+func TestClassifyCluster(t *testing.T) {
+	cluster := types.Cluster{
+		ClusterIdentifier: aws.String("analytics"),
+		NodeType:          aws.String("dc2.large"),
+		NumberOfNodes:     aws.Int32(2),
+	}
+
+	tests := []struct {
+		name        string
+		connections []cwtypes.Datapoint
+		cpu         []cwtypes.Datapoint
+		wantWaste   bool
+		wantStatus  string
+	}{
+		{
+			name:        "no_connections",
+			connections: connectionDatapoints(0, 0),
+			cpu:         cpuDatapoints(2, 3),
+			wantWaste:   true,
+			wantStatus:  model.RedshiftClusterNoConnections,
+		},
+		{
+			name:        "no_connection_datapoints",
+			connections: nil,
+			cpu:         cpuDatapoints(40),
+			wantWaste:   true,
+			wantStatus:  model.RedshiftClusterNoConnections,
+		},
+		{
+			name:        "low_cpu",
+			connections: connectionDatapoints(0, 4),
+			cpu:         cpuDatapoints(2, 4),
+			wantWaste:   true,
+			wantStatus:  model.RedshiftClusterLowCPU,
+		},
+		{
+			name:        "busy",
+			connections: connectionDatapoints(12),
+			cpu:         cpuDatapoints(35, 60),
+			wantWaste:   false,
+		},
+		{
+			name:        "no_metrics",
+			connections: nil,
+			cpu:         nil,
+			wantWaste:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, isWaste := classifyCluster(cluster, tt.connections, tt.cpu, 14, 5)
+
+			if isWaste != tt.wantWaste {
+				t.Fatalf("classifyCluster() waste = %v, want %v", isWaste, tt.wantWaste)
+			}
+
+			if !tt.wantWaste {
+				return
+			}
+
+			if info.Status != tt.wantStatus {
+				t.Errorf("classifyCluster() status = %q, want %q", info.Status, tt.wantStatus)
+			}
+
+			if info.Recommendation == "" {
+				t.Error("classifyCluster() recommendation is empty")
+			}
+
+			if diff := info.EstimatedMonthlyCost - 365; diff > 0.001 || diff < -0.001 {
+				t.Errorf("classifyCluster() cost = %.2f, want 365.00", info.EstimatedMonthlyCost)
+			}
+
+			if info.NodeCount != 2 || info.NodeType != "dc2.large" || info.ObservationDays != 14 {
+				t.Errorf("classifyCluster() = %+v, want 2 dc2.large nodes observed for 14 days", info)
+			}
+		})
+	}
+}
+
+func TestClassifyCluster_AverageCPU(t *testing.T) {
+	cluster := types.Cluster{ClusterIdentifier: aws.String("analytics"), NodeType: aws.String("ra3.unknown")}
+
+	info, isWaste := classifyCluster(cluster, connectionDatapoints(3), cpuDatapoints(1, 2, 6), 14, 5)
+
+	if !isWaste || info.AverageCPUPercent != 3 || info.MaxConnections != 3 {
+		t.Errorf("classifyCluster() = %+v, %v, want low CPU at 3%% with 3 connections", info, isWaste)
+	}
+
+	if info.EstimatedMonthlyCost != 0 {
+		t.Errorf("classifyCluster() cost = %.2f, want 0 for an unpriced node type", info.EstimatedMonthlyCost)
+	}
+}
+
+func TestSnapshotToInfo(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	created := now.AddDate(0, 0, -120)
+
+	info := snapshotToInfo(types.Snapshot{
+		SnapshotIdentifier:         aws.String("before-migration"),
+		ClusterIdentifier:          aws.String("analytics"),
+		NodeType:                   aws.String("ra3.xlplus"),
+		NumberOfNodes:              aws.Int32(2),
+		SnapshotCreateTime:         aws.Time(created),
+		TotalBackupSizeInMegaBytes: aws.Float64(512000),
+	}, now)
+
+	if info.DaysSinceCreate != 120 {
+		t.Errorf("DaysSinceCreate = %d, want 120", info.DaysSinceCreate)
+	}
+
+	if info.SizeGB != 500 {
+		t.Errorf("SizeGB = %v, want 500", info.SizeGB)
+	}
+
+	if diff := info.EstimatedMonthlyCost - 12; diff > 0.001 || diff < -0.001 {
+		t.Errorf("EstimatedMonthlyCost = %.2f, want 12.00", info.EstimatedMonthlyCost)
+	}
+
+	if info.NodeType != "ra3.xlplus" || info.NodeCount != 2 {
+		t.Errorf("node configuration = %s x%d, want ra3.xlplus x2", info.NodeType, info.NodeCount)
+	}
+}
@@ -0,0 +1,20 @@
+package redshift
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client           *redshift.Client
+	cloudwatchClient *cloudwatch.Client
+}
+
+// Service defines the interface for Amazon Redshift service.
+type Service interface {
+	GetIdleClusters(ctx context.Context, observationDays int, cpuThreshold float64) ([]model.RedshiftClusterWasteInfo, error)
+	GetStaleManualSnapshots(ctx context.Context, staleDays int) ([]model.RedshiftSnapshotWasteInfo, error)
+}
@@ -0,0 +1,590 @@
+// Package route53 provides a service for interacting with Amazon Route 53.
+package route53
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	elbclassic "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Route 53 pricing: $0.50 per hosted zone per month for the first 25 zones, and $0.50 per health
+// check per month for AWS endpoints ($0.75 for non-AWS endpoints)
+const (
+	hostedZoneMonthlyCost  = 0.50
+	healthCheckMonthlyCost = 0.50
+)
+
+// ipRangesURL publishes the public IP address ranges of AWS, used to tell whether the address
+// of an A record is an EC2 address
+const ipRangesURL = "https://ip-ranges.amazonaws.com/ip-ranges.json"
+
+// ipRangesTimeout bounds the download of ip-ranges.json, and dnsLookupTimeout the lookup of a
+// load balancer DNS name
+const (
+	ipRangesTimeout  = 10 * time.Second
+	dnsLookupTimeout = 5 * time.Second
+)
+
+// NewService creates a new Route 53 service. Unless checkIPRanges is false, the AWS IP address
+// ranges are downloaded to check the addresses of A records.
+func NewService(awsconfig aws.Config, checkIPRanges bool) Service {
+	client := route53.NewFromConfig(awsconfig)
+	elbClient := elb.NewFromConfig(awsconfig)
+	classicClient := elbclassic.NewFromConfig(awsconfig)
+	ec2Client := ec2.NewFromConfig(awsconfig)
+
+	return &service{
+		client:        client,
+		elbClient:     elbClient,
+		classicClient: classicClient,
+		ec2Client:     ec2Client,
+		httpClient:    &http.Client{Timeout: ipRangesTimeout},
+		resolver:      net.DefaultResolver,
+		region:        awsconfig.Region,
+		checkIPRanges: checkIPRanges,
+	}
+}
+
+// GetUnusedResources returns hosted zones holding only their SOA and NS records, hosted zones with
+// records pointing at load balancers that no longer exist or at targets the account does not own,
+// and health checks not referenced by any record set. Only targets in the configured region can be
+// checked: plain A records are only reported when their address is in the region's EC2 address
+// ranges. When those ranges cannot be downloaded, the A record check is returned as skipped.
+func (s *service) GetUnusedResources(ctx context.Context) ([]model.Route53HostedZoneWasteInfo, []model.Route53HealthCheckWasteInfo, []model.SkippedCheck, error) {
+	zones, err := s.listHostedZones(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		targets liveTargets
+		skipped []model.SkippedCheck
+	)
+
+	if len(zones) > 0 {
+		targets.lbDNSNames, err = s.getLoadBalancerDNSNames(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		targets.publicIPs, err = s.getPublicIPs(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if s.checkIPRanges {
+			targets.ec2Ranges, err = s.getEC2Ranges(ctx)
+			if err != nil {
+				skipped = append(skipped, model.SkippedCheck{
+					Name:   "Route 53 A records",
+					Reason: "could not download the AWS IP address ranges: " + err.Error(),
+				})
+			}
+		}
+
+		targets.deletedLBNames = make(map[string]bool)
+	}
+
+	var zoneResults []model.Route53HostedZoneWasteInfo
+
+	referencedHealthChecks := make(map[string]bool)
+	lookedUp := make(map[string]bool)
+
+	for _, zone := range zones {
+		records, err := s.listRecords(ctx, aws.ToString(zone.Id))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		s.lookUpLoadBalancers(ctx, records, targets, lookedUp)
+
+		var dangling []model.Route53DanglingRecord
+
+		for _, record := range records {
+			if record.HealthCheckId != nil {
+				referencedHealthChecks[aws.ToString(record.HealthCheckId)] = true
+			}
+
+			if danglingRecord, isDangling := findDanglingRecord(record, s.region, targets); isDangling {
+				dangling = append(dangling, danglingRecord)
+			}
+		}
+
+		info := model.Route53HostedZoneWasteInfo{
+			ZoneID:      strings.TrimPrefix(aws.ToString(zone.Id), "/hostedzone/"),
+			Name:        normalizeDNSName(aws.ToString(zone.Name)),
+			RecordCount: aws.ToInt64(zone.ResourceRecordSetCount),
+		}
+
+		if zone.Config != nil {
+			info.PrivateZone = zone.Config.PrivateZone
+		}
+
+		switch {
+		case isEmptyZone(aws.ToString(zone.Name), records):
+			info.Status = model.Route53ZoneEmpty
+			info.MonthlyCost = hostedZoneMonthlyCost
+		case len(dangling) > 0:
+			info.Status = zoneRecordsStatus(dangling)
+			info.DanglingRecords = dangling
+		default:
+			continue
+		}
+
+		zoneResults = append(zoneResults, info)
+	}
+
+	healthChecks, err := s.listHealthChecks(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return zoneResults, unreferencedHealthChecks(healthChecks, referencedHealthChecks), skipped, nil
+}
+
+// listHostedZones returns the hosted zones owned by the account, skipping zones managed by other
+// AWS services such as Cloud Map.
+func (s *service) listHostedZones(ctx context.Context) ([]types.HostedZone, error) {
+	var zones []types.HostedZone
+
+	paginator := route53.NewListHostedZonesPaginator(s.client, &route53.ListHostedZonesInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, zone := range output.HostedZones {
+			if zone.LinkedService != nil {
+				continue
+			}
+
+			zones = append(zones, zone)
+		}
+	}
+
+	return zones, nil
+}
+
+func (s *service) listRecords(ctx context.Context, zoneID string) ([]types.ResourceRecordSet, error) {
+	var records []types.ResourceRecordSet
+
+	paginator := route53.NewListResourceRecordSetsPaginator(s.client, &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, output.ResourceRecordSets...)
+	}
+
+	return records, nil
+}
+
+func (s *service) listHealthChecks(ctx context.Context) ([]types.HealthCheck, error) {
+	var healthChecks []types.HealthCheck
+
+	paginator := route53.NewListHealthChecksPaginator(s.client, &route53.ListHealthChecksInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		healthChecks = append(healthChecks, output.HealthChecks...)
+	}
+
+	return healthChecks, nil
+}
+
+// getLoadBalancerDNSNames returns the normalized DNS names of every Application, Network, Gateway
+// and Classic Load Balancer in the configured region.
+func (s *service) getLoadBalancerDNSNames(ctx context.Context) (map[string]bool, error) {
+	dnsNames := make(map[string]bool)
+
+	paginator := elb.NewDescribeLoadBalancersPaginator(s.elbClient, &elb.DescribeLoadBalancersInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lb := range output.LoadBalancers {
+			dnsNames[normalizeDNSName(aws.ToString(lb.DNSName))] = true
+		}
+	}
+
+	classicPaginator := elbclassic.NewDescribeLoadBalancersPaginator(s.classicClient, &elbclassic.DescribeLoadBalancersInput{})
+
+	for classicPaginator.HasMorePages() {
+		output, err := classicPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lb := range output.LoadBalancerDescriptions {
+			dnsNames[normalizeDNSName(aws.ToString(lb.DNSName))] = true
+		}
+	}
+
+	return dnsNames, nil
+}
+
+// getPublicIPs returns every Elastic IP address and instance public IP address in the configured region.
+func (s *service) getPublicIPs(ctx context.Context) (map[string]bool, error) {
+	ips := make(map[string]bool)
+
+	addresses, err := s.ec2Client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range addresses.Addresses {
+		ips[aws.ToString(address.PublicIp)] = true
+	}
+
+	paginator := ec2.NewDescribeInstancesPaginator(s.ec2Client, &ec2.DescribeInstancesInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.PublicIpAddress != nil {
+					ips[aws.ToString(instance.PublicIpAddress)] = true
+				}
+			}
+		}
+	}
+
+	return ips, nil
+}
+
+// getEC2Ranges downloads the EC2 public address ranges of the configured region. A records cannot
+// be checked without them, so a failed download only disables that check.
+func (s *service) getEC2Ranges(ctx context.Context) ([]netip.Prefix, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, ipRangesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", response.Status)
+	}
+
+	return parseEC2Ranges(response.Body, s.region)
+}
+
+// lookUpLoadBalancers resolves the DNS names of the load balancers in the configured region that
+// the records point at but the account does not own, and records in live.deletedLBNames those that
+// no longer exist. lookedUp holds the names already resolved for previous zones.
+func (s *service) lookUpLoadBalancers(ctx context.Context, records []types.ResourceRecordSet, live liveTargets, lookedUp map[string]bool) {
+	for _, record := range records {
+		for _, name := range recordTargets(record) {
+			lbRegion, isLoadBalancer := elbRegion(name)
+			if !isLoadBalancer || lbRegion != s.region || live.lbDNSNames[name] || lookedUp[name] {
+				continue
+			}
+
+			lookedUp[name] = true
+
+			if s.isDeletedHost(ctx, name) {
+				live.deletedLBNames[name] = true
+			}
+		}
+	}
+}
+
+// isDeletedHost reports whether the DNS name no longer exists. The DNS names of deleted load
+// balancers stop resolving, whichever account owned them; lookups failing for any other reason
+// leave the record unverifiable.
+func (s *service) isDeletedHost(ctx context.Context, name string) bool {
+	ctx, cancel := context.WithTimeout(ctx, dnsLookupTimeout)
+	defer cancel()
+
+	_, err := s.resolver.LookupHost(ctx, name)
+
+	var dnsErr *net.DNSError
+
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// parseEC2Ranges reads the EC2 IPv4 ranges of a region from the AWS ip-ranges.json document.
+func parseEC2Ranges(r io.Reader, region string) ([]netip.Prefix, error) {
+	var document struct {
+		Prefixes []struct {
+			IPPrefix string `json:"ip_prefix"`
+			Region   string `json:"region"`
+			Service  string `json:"service"`
+		} `json:"prefixes"`
+	}
+
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, err
+	}
+
+	var ranges []netip.Prefix
+
+	for _, prefix := range document.Prefixes {
+		if prefix.Service != "EC2" || prefix.Region != region {
+			continue
+		}
+
+		if parsed, err := netip.ParsePrefix(prefix.IPPrefix); err == nil {
+			ranges = append(ranges, parsed)
+		}
+	}
+
+	return ranges, nil
+}
+
+// isEmptyZone reports whether a hosted zone only contains the SOA record and the NS record at its apex.
+func isEmptyZone(zoneName string, records []types.ResourceRecordSet) bool {
+	apex := normalizeDNSName(zoneName)
+
+	for _, record := range records {
+		switch {
+		case record.Type == types.RRTypeSoa:
+		case record.Type == types.RRTypeNs && normalizeDNSName(aws.ToString(record.Name)) == apex:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// liveTargets holds the record targets that currently exist in the configured region.
+type liveTargets struct {
+	lbDNSNames     map[string]bool // Normalized load balancer DNS names of the account
+	deletedLBNames map[string]bool // Normalized load balancer DNS names that no longer resolve
+	publicIPs      map[string]bool // Elastic IP and instance public addresses of the account
+	ec2Ranges      []netip.Prefix  // EC2 public address ranges of the region
+}
+
+// findDanglingRecord checks the alias target, CNAME values and A record addresses of a record
+// against the load balancers and public IP addresses of the account. A record pointing at a load
+// balancer whose DNS name no longer resolves is dangling. Load balancers, EC2 host names and EC2
+// addresses the account does not own are unverifiable, since they may belong to another account.
+// Targets in other regions, and addresses outside the region's EC2 ranges, are ignored.
+func findDanglingRecord(record types.ResourceRecordSet, region string, live liveTargets) (model.Route53DanglingRecord, bool) {
+	if record.AliasTarget == nil && record.Type == types.RRTypeA {
+		return findUnownedAddress(record, live)
+	}
+
+	var (
+		found model.Route53DanglingRecord
+		ok    bool
+	)
+
+	for _, name := range recordTargets(record) {
+		if lbRegion, isLoadBalancer := elbRegion(name); isLoadBalancer {
+			switch {
+			case lbRegion != region || live.lbDNSNames[name]:
+				continue
+			case live.deletedLBNames[name]:
+				return danglingRecord(record, name, model.Route53RecordDangling), true
+			case !ok:
+				found, ok = danglingRecord(record, name, model.Route53RecordUnverifiable), true
+			}
+
+			continue
+		}
+
+		if ip, ipRegion, isEC2 := ec2HostnameAddress(name); isEC2 && ipRegion == region && !live.publicIPs[ip] && !ok {
+			found, ok = danglingRecord(record, name, model.Route53RecordUnverifiable), true
+		}
+	}
+
+	return found, ok
+}
+
+// recordTargets returns the normalized alias target and CNAME values of a record.
+func recordTargets(record types.ResourceRecordSet) []string {
+	var targets []string
+
+	if record.AliasTarget != nil {
+		targets = append(targets, normalizeDNSName(aws.ToString(record.AliasTarget.DNSName)))
+	}
+
+	if record.Type == types.RRTypeCname {
+		for _, value := range record.ResourceRecords {
+			targets = append(targets, normalizeDNSName(aws.ToString(value.Value)))
+		}
+	}
+
+	return targets
+}
+
+// findUnownedAddress returns the first address of an A record that lies in the region's EC2
+// ranges without belonging to the account. It may be a released Elastic IP or the address of
+// another account, so the record is unverifiable.
+func findUnownedAddress(record types.ResourceRecordSet, live liveTargets) (model.Route53DanglingRecord, bool) {
+	for _, value := range record.ResourceRecords {
+		addr, err := netip.ParseAddr(aws.ToString(value.Value))
+		if err != nil || live.publicIPs[addr.String()] {
+			continue
+		}
+
+		for _, prefix := range live.ec2Ranges {
+			if prefix.Contains(addr) {
+				return danglingRecord(record, addr.String(), model.Route53RecordUnverifiable), true
+			}
+		}
+	}
+
+	return model.Route53DanglingRecord{}, false
+}
+
+func danglingRecord(record types.ResourceRecordSet, target, status string) model.Route53DanglingRecord {
+	return model.Route53DanglingRecord{
+		Name:   normalizeDNSName(aws.ToString(record.Name)),
+		Type:   string(record.Type),
+		Target: target,
+		Status: status,
+	}
+}
+
+// zoneRecordsStatus returns DANGLING_RECORDS when any of the records is dangling, and
+// UNVERIFIABLE_RECORDS when they are all unverifiable.
+func zoneRecordsStatus(records []model.Route53DanglingRecord) string {
+	for _, record := range records {
+		if record.Status == model.Route53RecordDangling {
+			return model.Route53ZoneDanglingRecords
+		}
+	}
+
+	return model.Route53ZoneUnverifiableRecords
+}
+
+// normalizeDNSName lowercases a DNS name and strips the trailing dot and the "dualstack." prefix
+// Route 53 adds to load balancer alias targets.
+func normalizeDNSName(name string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+
+	return strings.TrimPrefix(name, "dualstack.")
+}
+
+// elbRegion returns the region of a load balancer DNS name. Application and Classic Load Balancers
+// use "<name>.<region>.elb.amazonaws.com" while Network and Gateway Load Balancers use
+// "<name>.elb.<region>.amazonaws.com".
+func elbRegion(name string) (string, bool) {
+	labels := strings.Split(name, ".")
+	if len(labels) < 5 || !strings.HasSuffix(name, ".amazonaws.com") {
+		return "", false
+	}
+
+	switch {
+	case labels[len(labels)-3] == "elb":
+		return labels[len(labels)-4], true
+	case labels[len(labels)-4] == "elb":
+		return labels[len(labels)-3], true
+	default:
+		return "", false
+	}
+}
+
+// ec2HostnameAddress extracts the IP address and region from an EC2 public DNS name, such as
+// "ec2-203-0-113-25.compute-1.amazonaws.com" in us-east-1 or
+// "ec2-203-0-113-25.eu-west-1.compute.amazonaws.com" elsewhere.
+func ec2HostnameAddress(name string) (string, string, bool) {
+	labels := strings.Split(name, ".")
+	if len(labels) < 4 || !strings.HasPrefix(labels[0], "ec2-") || !strings.HasSuffix(name, ".amazonaws.com") {
+		return "", "", false
+	}
+
+	octets := strings.Split(strings.TrimPrefix(labels[0], "ec2-"), "-")
+	if len(octets) != 4 {
+		return "", "", false
+	}
+
+	ip := strings.Join(octets, ".")
+
+	switch {
+	case len(labels) == 4 && labels[1] == "compute-1":
+		return ip, "us-east-1", true
+	case len(labels) == 5 && labels[2] == "compute":
+		return ip, labels[1], true
+	default:
+		return "", "", false
+	}
+}
+
+// unreferencedHealthChecks returns the health checks that are neither attached to a record set nor
+// a child of a calculated health check. Health checks created by other AWS services are skipped.
+func unreferencedHealthChecks(healthChecks []types.HealthCheck, referenced map[string]bool) []model.Route53HealthCheckWasteInfo {
+	inUse := make(map[string]bool, len(referenced))
+
+	for id := range referenced {
+		inUse[id] = true
+	}
+
+	for _, healthCheck := range healthChecks {
+		if healthCheck.HealthCheckConfig == nil {
+			continue
+		}
+
+		for _, child := range healthCheck.HealthCheckConfig.ChildHealthChecks {
+			inUse[child] = true
+		}
+	}
+
+	var results []model.Route53HealthCheckWasteInfo
+
+	for _, healthCheck := range healthChecks {
+		id := aws.ToString(healthCheck.Id)
+		if inUse[id] || healthCheck.LinkedService != nil {
+			continue
+		}
+
+		info := model.Route53HealthCheckWasteInfo{
+			HealthCheckID: id,
+			MonthlyCost:   healthCheckMonthlyCost,
+		}
+
+		if config := healthCheck.HealthCheckConfig; config != nil {
+			info.Type = string(config.Type)
+			info.Endpoint = aws.ToString(config.FullyQualifiedDomainName)
+
+			if info.Endpoint == "" {
+				info.Endpoint = aws.ToString(config.IPAddress)
+			}
+		}
+
+		results = append(results, info)
+	}
+
+	return results
+}
@@ -0,0 +1,283 @@
+package route53
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestIsEmptyZone(t *testing.T) {
+	soa := types.ResourceRecordSet{Name: aws.String("example.com."), Type: types.RRTypeSoa}
+	apexNS := types.ResourceRecordSet{Name: aws.String("example.com."), Type: types.RRTypeNs}
+
+	tests := []struct {
+		name    string
+		records []types.ResourceRecordSet
+		want    bool
+	}{
+		{
+			name:    "soa_and_ns_only",
+			records: []types.ResourceRecordSet{soa, apexNS},
+			want:    true,
+		},
+		{
+			name:    "delegated_subdomain",
+			records: []types.ResourceRecordSet{soa, apexNS, {Name: aws.String("dev.example.com."), Type: types.RRTypeNs}},
+			want:    false,
+		},
+		{
+			name:    "with_a_record",
+			records: []types.ResourceRecordSet{soa, apexNS, {Name: aws.String("www.example.com."), Type: types.RRTypeA}},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isEmptyZone("Example.com.", tt.records); got != tt.want {
+				t.Errorf("isEmptyZone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindDanglingRecord(t *testing.T) {
+	lbDNSNames := map[string]bool{
+		"my-alb-123.us-east-1.elb.amazonaws.com": true,
+		"my-nlb-abc.elb.us-east-1.amazonaws.com": true,
+	}
+	live := liveTargets{
+		lbDNSNames: lbDNSNames,
+		deletedLBNames: map[string]bool{
+			"old-alb-456.us-east-1.elb.amazonaws.com": true,
+			"old-nlb-def.elb.us-east-1.amazonaws.com": true,
+		},
+		publicIPs: map[string]bool{"203.0.113.25": true},
+		ec2Ranges: []netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")},
+	}
+
+	alias := func(target string) types.ResourceRecordSet {
+		return types.ResourceRecordSet{
+			Name:        aws.String("app.example.com."),
+			Type:        types.RRTypeA,
+			AliasTarget: &types.AliasTarget{DNSName: aws.String(target)},
+		}
+	}
+	address := func(values ...string) types.ResourceRecordSet {
+		record := types.ResourceRecordSet{Name: aws.String("app.example.com."), Type: types.RRTypeA}
+		for _, value := range values {
+			record.ResourceRecords = append(record.ResourceRecords, types.ResourceRecord{Value: aws.String(value)})
+		}
+
+		return record
+	}
+	cname := func(target string) types.ResourceRecordSet {
+		return types.ResourceRecordSet{
+			Name:            aws.String("app.example.com."),
+			Type:            types.RRTypeCname,
+			ResourceRecords: []types.ResourceRecord{{Value: aws.String(target)}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		record     types.ResourceRecordSet
+		wantTarget string
+		wantStatus string
+		wantFound  bool
+	}{
+		{
+			name:   "alias_to_existing_alb",
+			record: alias("dualstack.My-ALB-123.us-east-1.elb.amazonaws.com."),
+		},
+		{
+			name:       "alias_to_deleted_alb",
+			record:     alias("dualstack.old-alb-456.us-east-1.elb.amazonaws.com."),
+			wantTarget: "old-alb-456.us-east-1.elb.amazonaws.com",
+			wantStatus: model.Route53RecordDangling,
+			wantFound:  true,
+		},
+		{
+			name:       "alias_to_alb_of_other_account",
+			record:     alias("dualstack.shared-alb-999.us-east-1.elb.amazonaws.com."),
+			wantTarget: "shared-alb-999.us-east-1.elb.amazonaws.com",
+			wantStatus: model.Route53RecordUnverifiable,
+			wantFound:  true,
+		},
+		{
+			name:   "cname_to_existing_nlb",
+			record: cname("my-nlb-abc.elb.us-east-1.amazonaws.com"),
+		},
+		{
+			name:       "cname_to_deleted_nlb",
+			record:     cname("old-nlb-def.elb.us-east-1.amazonaws.com"),
+			wantTarget: "old-nlb-def.elb.us-east-1.amazonaws.com",
+			wantStatus: model.Route53RecordDangling,
+			wantFound:  true,
+		},
+		{
+			name:   "load_balancer_in_other_region",
+			record: alias("other-alb-789.eu-west-1.elb.amazonaws.com."),
+		},
+		{
+			name:   "cname_to_existing_ec2_address",
+			record: cname("ec2-203-0-113-25.compute-1.amazonaws.com"),
+		},
+		{
+			name:       "cname_to_released_ec2_address",
+			record:     cname("ec2-198-51-100-7.compute-1.amazonaws.com"),
+			wantTarget: "ec2-198-51-100-7.compute-1.amazonaws.com",
+			wantStatus: model.Route53RecordUnverifiable,
+			wantFound:  true,
+		},
+		{
+			name:   "cname_to_external_host",
+			record: cname("example.net"),
+		},
+		{
+			name:   "alias_to_cloudfront",
+			record: alias("d111111abcdef8.cloudfront.net."),
+		},
+		{
+			name:   "a_record_to_owned_address",
+			record: address("203.0.113.25"),
+		},
+		{
+			name:       "a_record_to_unowned_ec2_address",
+			record:     address("192.0.2.1", "203.0.113.99"),
+			wantTarget: "203.0.113.99",
+			wantStatus: model.Route53RecordUnverifiable,
+			wantFound:  true,
+		},
+		{
+			name:   "a_record_outside_ec2_ranges",
+			record: address("192.0.2.1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := findDanglingRecord(tt.record, "us-east-1", live)
+			if found != tt.wantFound {
+				t.Fatalf("findDanglingRecord() found = %v, want %v", found, tt.wantFound)
+			}
+
+			if found && (got.Target != tt.wantTarget || got.Status != tt.wantStatus) {
+				t.Errorf("findDanglingRecord() = %s (%s), want %s (%s)", got.Target, got.Status, tt.wantTarget, tt.wantStatus)
+			}
+
+			if found && got.Name != "app.example.com" {
+				t.Errorf("Name = %q, want %q", got.Name, "app.example.com")
+			}
+		})
+	}
+}
+
+func TestZoneRecordsStatus(t *testing.T) {
+	dangling := model.Route53DanglingRecord{Status: model.Route53RecordDangling}
+	unverifiable := model.Route53DanglingRecord{Status: model.Route53RecordUnverifiable}
+
+	if got := zoneRecordsStatus([]model.Route53DanglingRecord{unverifiable, dangling}); got != model.Route53ZoneDanglingRecords {
+		t.Errorf("zoneRecordsStatus() with a dangling record = %q, want %q", got, model.Route53ZoneDanglingRecords)
+	}
+
+	if got := zoneRecordsStatus([]model.Route53DanglingRecord{unverifiable}); got != model.Route53ZoneUnverifiableRecords {
+		t.Errorf("zoneRecordsStatus() with only unverifiable records = %q, want %q", got, model.Route53ZoneUnverifiableRecords)
+	}
+}
+
+func TestParseEC2Ranges(t *testing.T) {
+	document := `{"prefixes": [
+		{"ip_prefix": "203.0.113.0/24", "region": "us-east-1", "service": "EC2"},
+		{"ip_prefix": "198.51.100.0/24", "region": "us-east-1", "service": "AMAZON"},
+		{"ip_prefix": "192.0.2.0/24", "region": "eu-west-1", "service": "EC2"}
+	]}`
+
+	ranges, err := parseEC2Ranges(strings.NewReader(document), "us-east-1")
+	if err != nil {
+		t.Fatalf("parseEC2Ranges() error = %v", err)
+	}
+
+	if len(ranges) != 1 || ranges[0] != netip.MustParsePrefix("203.0.113.0/24") {
+		t.Errorf("parseEC2Ranges() = %v, want [203.0.113.0/24]", ranges)
+	}
+
+	if _, err := parseEC2Ranges(strings.NewReader("not json"), "us-east-1"); err == nil {
+		t.Error("parseEC2Ranges() expected an error for invalid JSON")
+	}
+}
+
+func TestElbRegion(t *testing.T) {
+	tests := []struct {
+		name       string
+		dnsName    string
+		wantRegion string
+		wantOK     bool
+	}{
+		{name: "application", dnsName: "my-alb-123.us-west-2.elb.amazonaws.com", wantRegion: "us-west-2", wantOK: true},
+		{name: "internal_classic", dnsName: "internal-my-clb-123.eu-west-1.elb.amazonaws.com", wantRegion: "eu-west-1", wantOK: true},
+		{name: "network", dnsName: "my-nlb-abc.elb.ap-south-1.amazonaws.com", wantRegion: "ap-south-1", wantOK: true},
+		{name: "s3_website", dnsName: "s3-website-us-east-1.amazonaws.com", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, ok := elbRegion(tt.dnsName)
+			if ok != tt.wantOK || region != tt.wantRegion {
+				t.Errorf("elbRegion() = (%q, %v), want (%q, %v)", region, ok, tt.wantRegion, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestEC2HostnameAddress(t *testing.T) {
+	tests := []struct {
+		name       string
+		hostname   string
+		wantIP     string
+		wantRegion string
+		wantOK     bool
+	}{
+		{name: "us_east_1", hostname: "ec2-203-0-113-25.compute-1.amazonaws.com", wantIP: "203.0.113.25", wantRegion: "us-east-1", wantOK: true},
+		{name: "other_region", hostname: "ec2-203-0-113-25.eu-west-1.compute.amazonaws.com", wantIP: "203.0.113.25", wantRegion: "eu-west-1", wantOK: true},
+		{name: "private_name", hostname: "ip-10-0-0-1.ec2.internal", wantOK: false},
+		{name: "malformed_address", hostname: "ec2-203-0-113.compute-1.amazonaws.com", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, region, ok := ec2HostnameAddress(tt.hostname)
+			if ok != tt.wantOK || ip != tt.wantIP || region != tt.wantRegion {
+				t.Errorf("ec2HostnameAddress() = (%q, %q, %v), want (%q, %q, %v)", ip, region, ok, tt.wantIP, tt.wantRegion, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestUnreferencedHealthChecks(t *testing.T) {
+	healthChecks := []types.HealthCheck{
+		{Id: aws.String("attached"), HealthCheckConfig: &types.HealthCheckConfig{Type: types.HealthCheckTypeHttps, FullyQualifiedDomainName: aws.String("app.example.com")}},
+		{Id: aws.String("parent"), HealthCheckConfig: &types.HealthCheckConfig{Type: types.HealthCheckTypeCalculated, ChildHealthChecks: []string{"child"}}},
+		{Id: aws.String("child"), HealthCheckConfig: &types.HealthCheckConfig{Type: types.HealthCheckTypeHttp, IPAddress: aws.String("203.0.113.25")}},
+		{Id: aws.String("orphan"), HealthCheckConfig: &types.HealthCheckConfig{Type: types.HealthCheckTypeTcp, IPAddress: aws.String("198.51.100.7")}},
+		{Id: aws.String("linked"), HealthCheckConfig: &types.HealthCheckConfig{Type: types.HealthCheckTypeHttp}, LinkedService: &types.LinkedService{}},
+	}
+
+	got := unreferencedHealthChecks(healthChecks, map[string]bool{"attached": true, "parent": true})
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 unreferenced health check, got %d", len(got))
+	}
+
+	if got[0].HealthCheckID != "orphan" || got[0].Endpoint != "198.51.100.7" || got[0].Type != "TCP" {
+		t.Errorf("unexpected health check: %+v", got[0])
+	}
+
+	if got[0].MonthlyCost != healthCheckMonthlyCost {
+		t.Errorf("MonthlyCost = %v, want %v", got[0].MonthlyCost, healthCheckMonthlyCost)
+	}
+}
@@ -0,0 +1,29 @@
+package route53
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	elbclassic "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client        *route53.Client
+	elbClient     *elb.Client
+	classicClient *elbclassic.Client
+	ec2Client     *ec2.Client
+	httpClient    *http.Client
+	resolver      *net.Resolver
+	region        string
+	checkIPRanges bool // Download the AWS IP address ranges to check A records
+}
+
+// Service defines the interface for Amazon Route 53 service.
+type Service interface {
+	GetUnusedResources(ctx context.Context) ([]model.Route53HostedZoneWasteInfo, []model.Route53HealthCheckWasteInfo, []model.SkippedCheck, error)
+}
@@ -0,0 +1,214 @@
+// Package sagemaker provides a service for interacting with Amazon SageMaker.
+package sagemaker
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Approximate on-demand hourly prices (us-east-1) for common SageMaker hosting and notebook instances.
+// Instance types missing from this table are reported without a cost estimate.
+var instanceHourlyCost = map[string]float64{
+	"ml.t2.medium":    0.0464,
+	"ml.t2.large":     0.0928,
+	"ml.t2.xlarge":    0.1856,
+	"ml.t3.medium":    0.05,
+	"ml.t3.large":     0.10,
+	"ml.t3.xlarge":    0.20,
+	"ml.t3.2xlarge":   0.399,
+	"ml.m5.large":     0.115,
+	"ml.m5.xlarge":    0.23,
+	"ml.m5.2xlarge":   0.461,
+	"ml.m5.4xlarge":   0.922,
+	"ml.m5.12xlarge":  2.765,
+	"ml.c5.large":     0.102,
+	"ml.c5.xlarge":    0.204,
+	"ml.c5.2xlarge":   0.408,
+	"ml.c5.4xlarge":   0.816,
+	"ml.r5.large":     0.151,
+	"ml.r5.xlarge":    0.302,
+	"ml.r5.2xlarge":   0.605,
+	"ml.g4dn.xlarge":  0.7364,
+	"ml.g4dn.2xlarge": 1.0528,
+	"ml.g4dn.4xlarge": 1.6856,
+	"ml.g5.xlarge":    1.408,
+	"ml.g5.2xlarge":   1.515,
+	"ml.g5.4xlarge":   2.03,
+	"ml.g5.12xlarge":  7.09,
+	"ml.p3.2xlarge":   3.825,
+	"ml.inf1.xlarge":  0.297,
+	"ml.inf2.xlarge":  0.99,
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// NewService creates a new SageMaker service.
+func NewService(awsconfig aws.Config) Service {
+	client := sagemaker.NewFromConfig(awsconfig)
+	cloudwatchClient := cloudwatch.NewFromConfig(awsconfig)
+
+	return &service{
+		client:           client,
+		cloudwatchClient: cloudwatchClient,
+	}
+}
+
+// GetIdleEndpoints returns the instance-backed production variants of InService endpoints that
+// received no invocations in the last idleDays days. Serverless variants are billed per request
+// and endpoints created within the idle period are skipped.
+func (s *service) GetIdleEndpoints(ctx context.Context, idleDays int) ([]model.SageMakerEndpointWasteInfo, error) {
+	var results []model.SageMakerEndpointWasteInfo
+
+	now := time.Now()
+	startTime := now.AddDate(0, 0, -idleDays)
+
+	paginator := sagemaker.NewListEndpointsPaginator(s.client, &sagemaker.ListEndpointsInput{
+		StatusEquals:       types.EndpointStatusInService,
+		CreationTimeBefore: aws.Time(startTime),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range output.Endpoints {
+			endpoint, err := s.client.DescribeEndpoint(ctx, &sagemaker.DescribeEndpointInput{EndpointName: summary.EndpointName})
+			if err != nil {
+				return nil, err
+			}
+
+			instanceTypes, err := s.getVariantInstanceTypes(ctx, aws.ToString(endpoint.EndpointConfigName))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, variant := range endpoint.ProductionVariants {
+				if variant.CurrentServerlessConfig != nil {
+					continue
+				}
+
+				invocations, err := s.sumInvocations(ctx, aws.ToString(endpoint.EndpointName), aws.ToString(variant.VariantName), startTime, now, idleDays)
+				if err != nil {
+					return nil, err
+				}
+
+				if invocations > 0 {
+					continue
+				}
+
+				results = append(results, endpointVariantToInfo(aws.ToString(endpoint.EndpointName), variant, instanceTypes, idleDays))
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// GetLongRunningNotebookInstances returns InService notebook instances that have not been
+// started, stopped or updated in the given number of days. SageMaker does not report notebook usage,
+// so these instances are running without restart rather than known to be idle.
+func (s *service) GetLongRunningNotebookInstances(ctx context.Context, days int) ([]model.SageMakerNotebookWasteInfo, error) {
+	var results []model.SageMakerNotebookWasteInfo
+
+	now := time.Now()
+
+	paginator := sagemaker.NewListNotebookInstancesPaginator(s.client, &sagemaker.ListNotebookInstancesInput{
+		StatusEquals:           types.NotebookInstanceStatusInService,
+		LastModifiedTimeBefore: aws.Time(now.AddDate(0, 0, -days)),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, notebook := range output.NotebookInstances {
+			results = append(results, notebookToInfo(notebook, now))
+		}
+	}
+
+	return results, nil
+}
+
+// getVariantInstanceTypes maps the variant names of an endpoint configuration to their instance types.
+func (s *service) getVariantInstanceTypes(ctx context.Context, endpointConfigName string) (map[string]string, error) {
+	output, err := s.client.DescribeEndpointConfig(ctx, &sagemaker.DescribeEndpointConfigInput{
+		EndpointConfigName: aws.String(endpointConfigName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instanceTypes := make(map[string]string, len(output.ProductionVariants))
+
+	for _, variant := range output.ProductionVariants {
+		instanceTypes[aws.ToString(variant.VariantName)] = string(variant.InstanceType)
+	}
+
+	return instanceTypes, nil
+}
+
+// sumInvocations returns the total number of invocations of an endpoint variant between startTime and endTime.
+func (s *service) sumInvocations(ctx context.Context, endpointName, variantName string, startTime, endTime time.Time, days int) (float64, error) {
+	output, err := s.cloudwatchClient.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/SageMaker"),
+		MetricName: aws.String("Invocations"),
+		Dimensions: []cwtypes.Dimension{
+			{Name: aws.String("EndpointName"), Value: aws.String(endpointName)},
+			{Name: aws.String("VariantName"), Value: aws.String(variantName)},
+		},
+		StartTime:  aws.Time(startTime),
+		EndTime:    aws.Time(endTime),
+		Period:     aws.Int32(int32(days * secondsPerDay)),
+		Statistics: []cwtypes.Statistic{cwtypes.StatisticSum},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total float64
+
+	for _, datapoint := range output.Datapoints {
+		total += aws.ToFloat64(datapoint.Sum)
+	}
+
+	return total, nil
+}
+
+func endpointVariantToInfo(endpointName string, variant types.ProductionVariantSummary, instanceTypes map[string]string, idleDays int) model.SageMakerEndpointWasteInfo {
+	variantName := aws.ToString(variant.VariantName)
+	instanceType := instanceTypes[variantName]
+	instanceCount := aws.ToInt32(variant.CurrentInstanceCount)
+
+	return model.SageMakerEndpointWasteInfo{
+		EndpointName:  endpointName,
+		VariantName:   variantName,
+		InstanceType:  instanceType,
+		InstanceCount: instanceCount,
+		HourlyCost:    instanceHourlyCost[instanceType] * float64(instanceCount),
+		IdleDays:      idleDays,
+	}
+}
+
+func notebookToInfo(notebook types.NotebookInstanceSummary, now time.Time) model.SageMakerNotebookWasteInfo {
+	instanceType := string(notebook.InstanceType)
+	lastModified := aws.ToTime(notebook.LastModifiedTime)
+
+	return model.SageMakerNotebookWasteInfo{
+		NotebookInstanceName: aws.ToString(notebook.NotebookInstanceName),
+		InstanceType:         instanceType,
+		LastModifiedTime:     lastModified,
+		DaysSinceModified:    int(now.Sub(lastModified).Hours() / 24),
+		HourlyCost:           instanceHourlyCost[instanceType],
+	}
+}
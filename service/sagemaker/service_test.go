@@ -0,0 +1,96 @@
+package sagemaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+)
+
+func TestEndpointVariantToInfo(t *testing.T) {
+	instanceTypes := map[string]string{
+		"AllTraffic": "ml.m5.xlarge",
+		"Canary":     "ml.x99.unknown",
+	}
+
+	tests := []struct {
+		name       string
+		variant    types.ProductionVariantSummary
+		wantType   string
+		wantCount  int32
+		wantHourly float64
+	}{
+		{
+			name:       "priced_instance_type",
+			variant:    types.ProductionVariantSummary{VariantName: aws.String("AllTraffic"), CurrentInstanceCount: aws.Int32(2)},
+			wantType:   "ml.m5.xlarge",
+			wantCount:  2,
+			wantHourly: 0.46,
+		},
+		{
+			name:       "unpriced_instance_type",
+			variant:    types.ProductionVariantSummary{VariantName: aws.String("Canary"), CurrentInstanceCount: aws.Int32(1)},
+			wantType:   "ml.x99.unknown",
+			wantCount:  1,
+			wantHourly: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := endpointVariantToInfo("my-endpoint", tt.variant, instanceTypes, 14)
+
+			if info.EndpointName != "my-endpoint" {
+				t.Errorf("EndpointName = %q, want %q", info.EndpointName, "my-endpoint")
+			}
+
+			if info.InstanceType != tt.wantType {
+				t.Errorf("InstanceType = %q, want %q", info.InstanceType, tt.wantType)
+			}
+
+			if info.InstanceCount != tt.wantCount {
+				t.Errorf("InstanceCount = %d, want %d", info.InstanceCount, tt.wantCount)
+			}
+
+			if info.HourlyCost != tt.wantHourly {
+				t.Errorf("HourlyCost = %v, want %v", info.HourlyCost, tt.wantHourly)
+			}
+
+			if info.IdleDays != 14 {
+				t.Errorf("IdleDays = %d, want 14", info.IdleDays)
+			}
+		})
+	}
+}
+
+func TestNotebookToInfo(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	lastModified := now.AddDate(0, 0, -21)
+
+	info := notebookToInfo(types.NotebookInstanceSummary{
+		NotebookInstanceName: aws.String("research"),
+		InstanceType:         types.InstanceTypeMlT3Medium,
+		LastModifiedTime:     aws.Time(lastModified),
+	}, now)
+
+	if info.NotebookInstanceName != "research" {
+		t.Errorf("NotebookInstanceName = %q, want %q", info.NotebookInstanceName, "research")
+	}
+
+	if info.InstanceType != "ml.t3.medium" {
+		t.Errorf("InstanceType = %q, want %q", info.InstanceType, "ml.t3.medium")
+	}
+
+	if info.DaysSinceModified != 21 {
+		t.Errorf("DaysSinceModified = %d, want 21", info.DaysSinceModified)
+	}
+
+	if info.HourlyCost != 0.05 {
+		t.Errorf("HourlyCost = %v, want 0.05", info.HourlyCost)
+	}
+
+	if !info.LastModifiedTime.Equal(lastModified) {
+		t.Errorf("LastModifiedTime = %v, want %v", info.LastModifiedTime, lastModified)
+	}
+}
@@ -0,0 +1,20 @@
+package sagemaker
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client           *sagemaker.Client
+	cloudwatchClient *cloudwatch.Client
+}
+
+// Service defines the interface for AWS SageMaker service.
+type Service interface {
+	GetIdleEndpoints(ctx context.Context, idleDays int) ([]model.SageMakerEndpointWasteInfo, error)
+	GetLongRunningNotebookInstances(ctx context.Context, days int) ([]model.SageMakerNotebookWasteInfo, error)
+}
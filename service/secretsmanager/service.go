@@ -0,0 +1,72 @@
+// Package secretsmanager provides a service for interacting with AWS Secrets Manager.
+package secretsmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Secrets Manager pricing: $0.40 per secret per month
+const secretMonthlyCost = 0.40
+
+// NewService creates a new Secrets Manager service.
+func NewService(awsconfig aws.Config) Service {
+	client := secretsmanager.NewFromConfig(awsconfig)
+
+	return &service{
+		client: client,
+	}
+}
+
+// GetUnusedSecrets returns secrets that have not been accessed in the last unusedDays days,
+// including secrets older than that which have never been accessed.
+func (s *service) GetUnusedSecrets(ctx context.Context, unusedDays int) ([]model.SecretWasteInfo, error) {
+	var results []model.SecretWasteInfo
+
+	now := time.Now()
+
+	paginator := secretsmanager.NewListSecretsPaginator(s.client, &secretsmanager.ListSecretsInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, secret := range output.SecretList {
+			if info, isWaste := classifySecret(secret, unusedDays, now); isWaste {
+				results = append(results, info)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// classifySecret decides whether a secret is unused. Secrets Manager records LastAccessedDate
+// with day granularity, so access is measured in whole days.
+func classifySecret(secret types.SecretListEntry, unusedDays int, now time.Time) (model.SecretWasteInfo, bool) {
+	lastUsed := aws.ToTime(secret.LastAccessedDate)
+	if lastUsed.IsZero() {
+		lastUsed = aws.ToTime(secret.CreatedDate)
+	}
+
+	daysSinceAccess := int(now.Sub(lastUsed).Hours() / 24)
+	if secret.DeletedDate != nil || daysSinceAccess < unusedDays {
+		return model.SecretWasteInfo{}, false
+	}
+
+	return model.SecretWasteInfo{
+		Name:             aws.ToString(secret.Name),
+		ARN:              aws.ToString(secret.ARN),
+		LastAccessedDate: aws.ToTime(secret.LastAccessedDate),
+		DaysSinceAccess:  daysSinceAccess,
+		OwningService:    aws.ToString(secret.OwningService),
+		MonthlyCost:      secretMonthlyCost,
+	}, true
+}
@@ -0,0 +1,85 @@
+package secretsmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+func TestClassifySecret(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		secret        types.SecretListEntry
+		wantWaste     bool
+		wantDays      int
+		wantNeverUsed bool
+	}{
+		{
+			name: "not_accessed_recently",
+			secret: types.SecretListEntry{
+				Name:             aws.String("legacy/api-key"),
+				CreatedDate:      aws.Time(now.AddDate(-1, 0, 0)),
+				LastAccessedDate: aws.Time(now.AddDate(0, 0, -120)),
+			},
+			wantWaste: true,
+			wantDays:  120,
+		},
+		{
+			name: "never_accessed",
+			secret: types.SecretListEntry{
+				Name:        aws.String("forgotten"),
+				CreatedDate: aws.Time(now.AddDate(0, 0, -95)),
+			},
+			wantWaste:     true,
+			wantDays:      95,
+			wantNeverUsed: true,
+		},
+		{
+			name: "recently_accessed",
+			secret: types.SecretListEntry{
+				Name:             aws.String("db/password"),
+				CreatedDate:      aws.Time(now.AddDate(-1, 0, 0)),
+				LastAccessedDate: aws.Time(now.AddDate(0, 0, -1)),
+			},
+			wantWaste: false,
+		},
+		{
+			name: "new_and_never_accessed",
+			secret: types.SecretListEntry{
+				Name:        aws.String("new"),
+				CreatedDate: aws.Time(now.AddDate(0, 0, -3)),
+			},
+			wantWaste: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, isWaste := classifySecret(tt.secret, 90, now)
+
+			if isWaste != tt.wantWaste {
+				t.Fatalf("classifySecret() waste = %v, want %v", isWaste, tt.wantWaste)
+			}
+
+			if !tt.wantWaste {
+				return
+			}
+
+			if info.DaysSinceAccess != tt.wantDays {
+				t.Errorf("classifySecret() days = %d, want %d", info.DaysSinceAccess, tt.wantDays)
+			}
+
+			if info.LastAccessedDate.IsZero() != tt.wantNeverUsed {
+				t.Errorf("classifySecret() last accessed = %v, never used = %v", info.LastAccessedDate, tt.wantNeverUsed)
+			}
+
+			if info.MonthlyCost != 0.40 {
+				t.Errorf("classifySecret() cost = %.2f, want 0.40", info.MonthlyCost)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+type service struct {
+	client *secretsmanager.Client
+}
+
+// Service defines the interface for AWS Secrets Manager service.
+type Service interface {
+	GetUnusedSecrets(ctx context.Context, unusedDays int) ([]model.SecretWasteInfo, error)
+}
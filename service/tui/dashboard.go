@@ -0,0 +1,429 @@
+package tui
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/elC0mpa/aws-doctor/utils"
+)
+
+// Size used until the terminal reports its dimensions
+const (
+	defaultWidth  = 100
+	defaultHeight = 30
+)
+
+// chromeHeight is the number of lines taken by the header and footer around the active tab
+const chromeHeight = 8
+
+type tab int
+
+const (
+	costTab tab = iota
+	trendTab
+	wasteTab
+)
+
+var tabTitles = []string{"💰 Cost", "📈 Trend", "🏥 Waste"}
+
+// wasteSort is the order of the waste findings, cycled with the sort key
+type wasteSort int
+
+const (
+	sortByCost wasteSort = iota
+	sortByCategory
+	sortByResource
+	sortByAge
+)
+
+var wasteSortTitles = []string{"highest cost", "category", "resource ID", "oldest"}
+
+// dataMsg carries the result of a LoadFunc call
+type dataMsg struct {
+	data Data
+	err  error
+}
+
+// dashboard is the Bubble Tea model of the dashboard
+type dashboard struct {
+	ctx  context.Context
+	load LoadFunc
+	now  func() time.Time
+
+	keys       keyMap
+	help       help.Model
+	spinner    spinner.Model
+	filter     textinput.Model
+	costTable  table.Model
+	wasteTable table.Model
+
+	width  int
+	height int
+	tab    tab
+
+	data     Data
+	loaded   bool // Whether data holds the result of a successful load
+	loading  bool
+	err      error // Error of the latest load; the previous data stays on screen
+	loadedAt time.Time
+
+	cost      model.CostComparisonJSON
+	trend     model.TrendJSON
+	colors    []string             // Bar color of every month of trend
+	findings  []model.WasteFinding // Every waste finding, in report order
+	visible   []model.WasteFinding // Findings matching the filter, in the selected order
+	sortOrder wasteSort
+	filtering bool                // Whether the filter input has the focus
+	selected  *model.WasteFinding // Finding whose details are shown, nil in the list view
+}
+
+func newDashboard(ctx context.Context, load LoadFunc) *dashboard {
+	filter := textinput.New()
+	filter.Prompt = "/ "
+	filter.Placeholder = "category, ID, name, status or tag"
+
+	d := &dashboard{
+		ctx:     ctx,
+		load:    load,
+		now:     time.Now,
+		keys:    newKeyMap(),
+		help:    help.New(),
+		spinner: spinner.New(spinner.WithSpinner(spinner.Dot)),
+		filter:  filter,
+		costTable: table.New(table.WithColumns([]table.Column{
+			{Title: "Service"}, {Title: "Last Month"}, {Title: "Current Month"}, {Title: "Difference"},
+		}), table.WithFocused(true)),
+		wasteTable: table.New(table.WithColumns([]table.Column{
+			{Title: "Category"}, {Title: "Resource ID"}, {Title: "Name"}, {Title: "Status"}, {Title: "Est. Monthly Cost"},
+		}), table.WithFocused(true)),
+		width:   defaultWidth,
+		height:  defaultHeight,
+		loading: true,
+	}
+
+	d.resize()
+
+	return d
+}
+
+func (d *dashboard) Init() tea.Cmd {
+	return tea.Batch(d.loadData, d.spinner.Tick)
+}
+
+// loadData runs the LoadFunc; Bubble Tea calls it outside of the update loop
+func (d *dashboard) loadData() tea.Msg {
+	data, err := d.load(d.ctx)
+
+	return dataMsg{data: data, err: err}
+}
+
+func (d *dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		d.width, d.height = msg.Width, msg.Height
+		d.help.Width = msg.Width
+		d.resize()
+
+		return d, nil
+	case spinner.TickMsg:
+		if !d.loading {
+			return d, nil
+		}
+
+		var cmd tea.Cmd
+		d.spinner, cmd = d.spinner.Update(msg)
+
+		return d, cmd
+	case dataMsg:
+		d.loading = false
+		d.err = msg.err
+
+		if msg.err == nil {
+			d.setData(msg.data)
+		}
+
+		return d, nil
+	case tea.KeyMsg:
+		return d.handleKey(msg)
+	}
+
+	return d, nil
+}
+
+func (d *dashboard) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, d.keys.ForceQuit) {
+		return d, tea.Quit
+	}
+
+	if d.filtering {
+		return d.handleFilterKey(msg)
+	}
+
+	if d.selected != nil {
+		switch {
+		case key.Matches(msg, d.keys.Back), key.Matches(msg, d.keys.Details):
+			d.selected = nil
+		case key.Matches(msg, d.keys.Quit):
+			return d, tea.Quit
+		}
+
+		return d, nil
+	}
+
+	switch {
+	case key.Matches(msg, d.keys.Quit):
+		return d, tea.Quit
+	case key.Matches(msg, d.keys.Help):
+		d.help.ShowAll = !d.help.ShowAll
+		d.resize()
+	case key.Matches(msg, d.keys.Refresh):
+		return d, d.refresh()
+	case key.Matches(msg, d.keys.NextTab):
+		d.tab = (d.tab + 1) % tab(len(tabTitles))
+	case key.Matches(msg, d.keys.PrevTab):
+		d.tab = (d.tab + tab(len(tabTitles)) - 1) % tab(len(tabTitles))
+	case key.Matches(msg, d.keys.Cost):
+		d.tab = costTab
+	case key.Matches(msg, d.keys.Trend):
+		d.tab = trendTab
+	case key.Matches(msg, d.keys.Waste):
+		d.tab = wasteTab
+	case d.tab == wasteTab:
+		return d.handleWasteKey(msg)
+	case d.tab == costTab:
+		var cmd tea.Cmd
+		d.costTable, cmd = d.costTable.Update(msg)
+
+		return d, cmd
+	}
+
+	return d, nil
+}
+
+func (d *dashboard) handleWasteKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, d.keys.Sort):
+		d.sortOrder = (d.sortOrder + 1) % wasteSort(len(wasteSortTitles))
+		d.applyFilter()
+	case key.Matches(msg, d.keys.Filter):
+		d.filtering = true
+		d.resize()
+
+		return d, d.filter.Focus()
+	case key.Matches(msg, d.keys.Back):
+		d.filter.SetValue("")
+		d.applyFilter()
+	case key.Matches(msg, d.keys.Details):
+		if cursor := d.wasteTable.Cursor(); cursor >= 0 && cursor < len(d.visible) {
+			finding := d.visible[cursor]
+			d.selected = &finding
+		}
+	default:
+		var cmd tea.Cmd
+		d.wasteTable, cmd = d.wasteTable.Update(msg)
+
+		return d, cmd
+	}
+
+	return d, nil
+}
+
+// handleFilterKey edits the filter while it has the focus: enter keeps it, esc clears it
+func (d *dashboard) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter, tea.KeyEsc:
+		if msg.Type == tea.KeyEsc {
+			d.filter.SetValue("")
+		}
+
+		d.filtering = false
+		d.filter.Blur()
+		d.applyFilter()
+		d.resize()
+
+		return d, nil
+	}
+
+	var cmd tea.Cmd
+	d.filter, cmd = d.filter.Update(msg)
+	d.applyFilter()
+
+	return d, cmd
+}
+
+// refresh reloads the data unless a load is already running
+func (d *dashboard) refresh() tea.Cmd {
+	if d.loading {
+		return nil
+	}
+
+	d.loading = true
+
+	return tea.Batch(d.loadData, d.spinner.Tick)
+}
+
+func (d *dashboard) setData(data Data) {
+	d.data = data
+	d.loaded = true
+	d.loadedAt = d.now()
+
+	d.cost = utils.BuildCostComparisonJSON(
+		data.AccountID,
+		utils.ParseCostString(data.LastTotalCost),
+		utils.ParseCostString(data.CurrentTotalCost),
+		data.LastMonth,
+		data.CurrentMonth,
+	)
+	d.trend = utils.BuildTrendJSON(data.AccountID, data.Trend)
+	d.colors = utils.TrendBarColors(data.Trend)
+	d.findings = utils.BuildWasteFindings(data.Waste)
+
+	rows := make([]table.Row, 0, len(d.cost.ServiceBreakdown))
+	for _, service := range d.cost.ServiceBreakdown {
+		rows = append(rows, table.Row{
+			service.Service,
+			formatAmount(service.LastCost, service.Unit),
+			formatAmount(service.CurrentCost, service.Unit),
+			formatDifference(service.Difference, service.Unit),
+		})
+	}
+
+	d.costTable.SetRows(rows)
+	d.applyFilter()
+}
+
+// applyFilter rebuilds the visible findings from the filter and the sort order
+func (d *dashboard) applyFilter() {
+	query := strings.ToLower(strings.TrimSpace(d.filter.Value()))
+
+	d.visible = d.visible[:0]
+	for _, finding := range d.findings {
+		if query == "" || strings.Contains(findingSearchText(finding), query) {
+			d.visible = append(d.visible, finding)
+		}
+	}
+
+	sortFindings(d.visible, d.sortOrder)
+
+	rows := make([]table.Row, 0, len(d.visible))
+	for _, finding := range d.visible {
+		rows = append(rows, table.Row{
+			finding.CategoryTitle,
+			finding.ResourceID,
+			finding.ResourceName,
+			finding.Status,
+			formatMonthlyCost(finding.MonthlyCost),
+		})
+	}
+
+	d.wasteTable.SetRows(rows)
+
+	if d.wasteTable.Cursor() >= len(rows) {
+		d.wasteTable.SetCursor(max(len(rows)-1, 0))
+	}
+}
+
+// findingSearchText is the lower-case text the waste filter matches against
+func findingSearchText(finding model.WasteFinding) string {
+	fields := []string{
+		finding.Category,
+		finding.CategoryTitle,
+		finding.ResourceID,
+		finding.ResourceName,
+		finding.Status,
+		finding.Details,
+	}
+
+	for name, value := range finding.Tags {
+		fields = append(fields, name+"="+value)
+	}
+
+	return strings.ToLower(strings.Join(fields, "\n"))
+}
+
+func sortFindings(findings []model.WasteFinding, order wasteSort) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+
+		switch order {
+		case sortByCategory:
+			if a.CategoryTitle != b.CategoryTitle {
+				return a.CategoryTitle < b.CategoryTitle
+			}
+
+			return a.MonthlyCost > b.MonthlyCost
+		case sortByResource:
+			return a.ResourceID < b.ResourceID
+		case sortByAge:
+			// Findings without a creation date go last
+			if a.CreatedAt.IsZero() != b.CreatedAt.IsZero() {
+				return b.CreatedAt.IsZero()
+			}
+
+			return a.CreatedAt.Before(b.CreatedAt)
+		default:
+			return a.MonthlyCost > b.MonthlyCost
+		}
+	})
+}
+
+// resize fits the tables to the terminal, sharing the width between a flexible first column and fixed ones
+func (d *dashboard) resize() {
+	bodyHeight := d.bodyHeight()
+
+	d.costTable.SetColumns(fitColumns(d.width, d.costTable.Columns(), 0, 14, 14, 14))
+	d.costTable.SetHeight(bodyHeight - 2) // Summary line above the table
+
+	d.wasteTable.SetColumns(fitColumns(d.width, d.wasteTable.Columns(), 28, 0, 24, 16, 17))
+	d.wasteTable.SetHeight(bodyHeight - 2) // Summary or filter line above the table
+}
+
+func (d *dashboard) bodyHeight() int {
+	height := d.height - chromeHeight
+	if d.help.ShowAll {
+		height -= 4
+	}
+
+	return max(height, 5)
+}
+
+// fitColumns sets the column widths; the column given a zero width takes the remaining space
+func fitColumns(totalWidth int, columns []table.Column, widths ...int) []table.Column {
+	flexible := 0
+
+	for i, width := range widths {
+		if width == 0 {
+			flexible = i
+		}
+	}
+
+	// Every cell is padded by one character on each side
+	remaining := totalWidth - 2*len(columns) - 2
+
+	for i, width := range widths {
+		if i != flexible {
+			remaining -= width
+		}
+	}
+
+	fitted := make([]table.Column, len(columns))
+	for i, column := range columns {
+		column.Width = widths[i]
+		if i == flexible {
+			column.Width = max(remaining, 12)
+		}
+
+		fitted[i] = column
+	}
+
+	return fitted
+}
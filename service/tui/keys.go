@@ -0,0 +1,57 @@
+package tui
+
+import "github.com/charmbracelet/bubbles/key"
+
+// keyMap lists the dashboard key bindings; navigation inside tables uses the table's own bindings
+type keyMap struct {
+	NextTab   key.Binding
+	PrevTab   key.Binding
+	Cost      key.Binding
+	Trend     key.Binding
+	Waste     key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	Sort      key.Binding
+	Filter    key.Binding
+	Details   key.Binding
+	Back      key.Binding
+	Refresh   key.Binding
+	Help      key.Binding
+	Quit      key.Binding
+	ForceQuit key.Binding
+}
+
+func newKeyMap() keyMap {
+	return keyMap{
+		NextTab:   key.NewBinding(key.WithKeys("tab", "right", "l"), key.WithHelp("tab/→", "next tab")),
+		PrevTab:   key.NewBinding(key.WithKeys("shift+tab", "left", "h"), key.WithHelp("shift+tab/←", "previous tab")),
+		Cost:      key.NewBinding(key.WithKeys("1"), key.WithHelp("1", "cost")),
+		Trend:     key.NewBinding(key.WithKeys("2"), key.WithHelp("2", "trend")),
+		Waste:     key.NewBinding(key.WithKeys("3"), key.WithHelp("3", "waste")),
+		Up:        key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		Down:      key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		Sort:      key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort findings")),
+		Filter:    key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter findings")),
+		Details:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "details")),
+		Back:      key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back / clear filter")),
+		Refresh:   key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
+		Help:      key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "more keys")),
+		Quit:      key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
+		ForceQuit: key.NewBinding(key.WithKeys("ctrl+c")),
+	}
+}
+
+// ShortHelp implements help.KeyMap
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.NextTab, k.Details, k.Sort, k.Filter, k.Refresh, k.Help, k.Quit}
+}
+
+// FullHelp implements help.KeyMap
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.NextTab, k.PrevTab, k.Cost, k.Trend, k.Waste},
+		{k.Up, k.Down, k.Details, k.Back},
+		{k.Sort, k.Filter, k.Refresh},
+		{k.Help, k.Quit},
+	}
+}
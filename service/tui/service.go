@@ -0,0 +1,26 @@
+// Package tui provides an interactive full-screen dashboard of the cost, trend and waste reports.
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// NewService creates a new dashboard service running in the terminal's alternate screen
+func NewService() Service {
+	return &service{programOptions: []tea.ProgramOption{tea.WithAltScreen()}}
+}
+
+func (s *service) Run(ctx context.Context, load LoadFunc) error {
+	options := append([]tea.ProgramOption{tea.WithContext(ctx)}, s.programOptions...)
+
+	_, err := tea.NewProgram(newDashboard(ctx, load), options...).Run()
+	if err != nil && !(errors.Is(err, tea.ErrProgramKilled) && ctx.Err() != nil) {
+		return fmt.Errorf("failed to run dashboard: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func testData() Data {
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 100, Unit: "USD"}}}
+	lastMonth.Start = aws.String("2024-05-01")
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{
+		"Amazon EC2": {Amount: 120, Unit: "USD"},
+		"Amazon S3":  {Amount: 45, Unit: "USD"},
+	}}
+	currentMonth.Start = aws.String("2024-06-01")
+
+	trend := []model.CostInfo{
+		{CostGroup: model.CostGroup{"Total": {Amount: 150, Unit: "USD"}}},
+		{CostGroup: model.CostGroup{"Total": {Amount: 165, Unit: "USD"}}},
+	}
+	trend[0].Start = aws.String("2024-04-01")
+	trend[1].Start = aws.String("2024-05-01")
+
+	return Data{
+		AccountID:        "123456789012",
+		LastTotalCost:    "100.00 USD",
+		CurrentTotalCost: "165.00 USD",
+		LastMonth:        lastMonth,
+		CurrentMonth:     currentMonth,
+		Trend:            trend,
+		Waste: model.WasteReport{
+			UnusedVolumes: []types.Volume{{
+				VolumeId:   aws.String("vol-123"),
+				Size:       aws.Int32(100),
+				CreateTime: aws.Time(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Tags:       []types.Tag{{Key: aws.String("team"), Value: aws.String("data")}},
+			}},
+			ECSServices: []model.ECSServiceWasteInfo{{ClusterName: "prod", ServiceName: "api", EstimatedMonthlyCost: 16.2}},
+			KMSKeys: []model.KMSKeyWasteInfo{{
+				KeyID:        "key-1",
+				Status:       model.KMSKeyDisabled,
+				CreationDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				MonthlyCost:  1,
+			}},
+		},
+	}
+}
+
+// newLoadedDashboard returns a dashboard that has received testData
+func newLoadedDashboard(t *testing.T) *dashboard {
+	t.Helper()
+
+	d := newDashboard(context.Background(), func(context.Context) (Data, error) { return testData(), nil })
+	d.now = func() time.Time { return time.Date(2024, 6, 15, 10, 30, 0, 0, time.UTC) }
+
+	d.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	d.Update(d.loadData())
+
+	return d
+}
+
+func press(d *dashboard, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+
+	for _, k := range keys {
+		var msg tea.KeyMsg
+
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "tab":
+			msg = tea.KeyMsg{Type: tea.KeyTab}
+		case "shift+tab":
+			msg = tea.KeyMsg{Type: tea.KeyShiftTab}
+		case "up":
+			msg = tea.KeyMsg{Type: tea.KeyUp}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+
+		_, cmd = d.Update(msg)
+	}
+
+	return cmd
+}
+
+func visibleIDs(d *dashboard) []string {
+	ids := make([]string, len(d.visible))
+	for i, finding := range d.visible {
+		ids[i] = finding.ResourceID
+	}
+
+	return ids
+}
+
+func TestDashboard_Loading(t *testing.T) {
+	d := newDashboard(context.Background(), func(context.Context) (Data, error) { return Data{}, errors.New("expired token") })
+
+	assert.NotNil(t, d.Init())
+	assert.Contains(t, d.View(), "Loading cost, trend and waste data")
+
+	d.Update(d.loadData())
+
+	assert.False(t, d.loading)
+	assert.Contains(t, d.View(), "Failed to load data: expired token")
+}
+
+func TestDashboard_CostTab(t *testing.T) {
+	d := newLoadedDashboard(t)
+	view := d.View()
+
+	assert.Contains(t, view, "123456789012")
+	assert.Contains(t, view, "Current month (2024-06-01): 165.00 USD")
+	assert.Contains(t, view, "+20.00 USD")
+	assert.Less(t, strings.Index(view, "Amazon EC2"), strings.Index(view, "Amazon S3"), "services should be sorted by current cost")
+	assert.Contains(t, view, "Updated at 10:30:00")
+}
+
+func TestDashboard_TabNavigation(t *testing.T) {
+	d := newLoadedDashboard(t)
+
+	press(d, "tab")
+	assert.Equal(t, trendTab, d.tab)
+	assert.Contains(t, d.View(), "Apr 2024: 150.00 USD")
+
+	press(d, "tab", "tab")
+	assert.Equal(t, costTab, d.tab)
+
+	press(d, "shift+tab")
+	assert.Equal(t, wasteTab, d.tab)
+
+	press(d, "2")
+	assert.Equal(t, trendTab, d.tab)
+}
+
+func TestDashboard_WasteSortAndFilter(t *testing.T) {
+	d := newLoadedDashboard(t)
+	press(d, "3")
+
+	assert.Equal(t, []string{"prod/api", "key-1", "vol-123"}, visibleIDs(d), "findings start sorted by cost")
+	assert.Contains(t, d.View(), "3 of 3 findings · $17.20 per month · sorted by highest cost")
+
+	press(d, "s", "s")
+	assert.Equal(t, []string{"key-1", "prod/api", "vol-123"}, visibleIDs(d), "sorted by resource ID")
+
+	press(d, "s")
+	assert.Equal(t, []string{"key-1", "vol-123", "prod/api"}, visibleIDs(d), "oldest first, unknown dates last")
+
+	press(d, "/", "t", "e", "a", "m", "=", "d")
+	assert.True(t, d.filtering)
+	assert.Equal(t, []string{"vol-123"}, visibleIDs(d), "filter matches tags")
+
+	press(d, "enter")
+	assert.False(t, d.filtering)
+	assert.Contains(t, d.View(), `1 of 3 findings`)
+	assert.Contains(t, d.View(), `filter "team=d"`)
+
+	press(d, "esc")
+	assert.Len(t, d.visible, 3)
+}
+
+func TestDashboard_FilterKeysAreNotShortcuts(t *testing.T) {
+	d := newLoadedDashboard(t)
+	press(d, "3", "/", "q", "r", "1")
+
+	assert.Equal(t, wasteTab, d.tab)
+	assert.False(t, d.loading)
+	assert.Equal(t, "qr1", d.filter.Value())
+
+	press(d, "esc")
+	assert.Empty(t, d.filter.Value())
+	assert.False(t, d.filtering)
+}
+
+func TestDashboard_Details(t *testing.T) {
+	d := newLoadedDashboard(t)
+	press(d, "3", "s", "s", "s", "down", "enter")
+
+	if assert.NotNil(t, d.selected) {
+		assert.Equal(t, "vol-123", d.selected.ResourceID)
+	}
+
+	view := d.View()
+	assert.Contains(t, view, "Unused EBS Volumes")
+	assert.Contains(t, view, "2024-01-01 (166 days ago)")
+	assert.Contains(t, view, "team = data")
+	assert.Contains(t, view, "no price estimate")
+
+	press(d, "3")
+	assert.NotNil(t, d.selected, "tab keys are ignored in the details view")
+
+	press(d, "esc")
+	assert.Nil(t, d.selected)
+
+	press(d, "up", "enter")
+	assert.Equal(t, "key-1", d.selected.ResourceID)
+	assert.Contains(t, d.View(), "not collected for this resource type")
+}
+
+func TestDashboard_Refresh(t *testing.T) {
+	calls := 0
+	d := newDashboard(context.Background(), func(context.Context) (Data, error) {
+		calls++
+		if calls > 1 {
+			return Data{}, errors.New("throttled")
+		}
+
+		return testData(), nil
+	})
+	d.Update(d.loadData())
+
+	assert.NotNil(t, press(d, "r"))
+	assert.True(t, d.loading)
+	assert.Contains(t, d.View(), "Refreshing...")
+	assert.Nil(t, press(d, "r"), "a refresh is already running")
+
+	d.Update(d.loadData())
+
+	assert.False(t, d.loading)
+	assert.Contains(t, d.View(), "Refresh failed: throttled")
+	assert.Contains(t, d.View(), "Amazon EC2", "the previous data stays on screen")
+}
+
+func TestDashboard_SkippedChecks(t *testing.T) {
+	d := newDashboard(context.Background(), func(context.Context) (Data, error) {
+		data := testData()
+		data.Waste.SkippedChecks = []model.SkippedCheck{{Name: "EKS clusters", Reason: "access denied"}}
+
+		return data, nil
+	})
+	d.Update(d.loadData())
+
+	assert.Contains(t, d.View(), "Skipped checks: EKS clusters (access denied)")
+}
+
+func TestDashboard_Quit(t *testing.T) {
+	d := newLoadedDashboard(t)
+
+	cmd := press(d, "q")
+	if assert.NotNil(t, cmd) {
+		assert.Equal(t, tea.QuitMsg{}, cmd())
+	}
+}
+
+func TestRun_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	svc := &service{programOptions: []tea.ProgramOption{tea.WithInput(nil), tea.WithOutput(&strings.Builder{})}}
+
+	err := svc.Run(ctx, func(context.Context) (Data, error) { return testData(), nil })
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Data is everything the dashboard displays, in the same form the output service renders it
+type Data struct {
+	AccountID        string
+	LastTotalCost    string
+	CurrentTotalCost string
+	LastMonth        *model.CostInfo
+	CurrentMonth     *model.CostInfo
+	Trend            []model.CostInfo
+	Waste            model.WasteReport
+}
+
+// LoadFunc fetches fresh dashboard data. It is called when the dashboard starts and on every refresh.
+type LoadFunc func(ctx context.Context) (Data, error)
+
+// service is the internal implementation
+type service struct {
+	programOptions []tea.ProgramOption
+}
+
+// Service defines the interface for the interactive terminal dashboard
+type Service interface {
+	// Run shows the Cost, Trend and Waste dashboard until the user quits or ctx is cancelled
+	Run(ctx context.Context, load LoadFunc) error
+}
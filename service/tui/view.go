@@ -0,0 +1,274 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NimbleMarkets/ntcharts/barchart"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/elC0mpa/aws-doctor/utils"
+)
+
+// Width of and gap between the monthly bars of the trend chart
+const (
+	trendBarWidth = 8
+	trendBarGap   = 4
+)
+
+var (
+	titleStyle       = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#F4D060"))
+	accountStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#5fafff"))
+	activeTabStyle   = lipgloss.NewStyle().Bold(true).Padding(0, 2).Foreground(lipgloss.Color("#1c1c1c")).Background(lipgloss.Color("#F4D060"))
+	inactiveTabStyle = lipgloss.NewStyle().Padding(0, 2).Foreground(lipgloss.Color("#a8a8a8"))
+	mutedStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("#8a8a8a"))
+	errorStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color(utils.ColorRank1))
+	increaseStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color(utils.ColorRank1))
+	decreaseStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color(utils.ColorRank6))
+	labelStyle       = lipgloss.NewStyle().Bold(true).Width(14)
+	detailsStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("#F4D060")).Padding(0, 1)
+)
+
+func (d *dashboard) View() string {
+	body := lipgloss.NewStyle().
+		Height(d.bodyHeight()).
+		MaxHeight(d.bodyHeight()).
+		Render(d.bodyView())
+
+	return lipgloss.JoinVertical(lipgloss.Left, d.headerView(), body, d.footerView())
+}
+
+func (d *dashboard) headerView() string {
+	title := titleStyle.Render("🩺 AWS Doctor")
+	if d.data.AccountID != "" {
+		title += "  Account ID: " + accountStyle.Render(d.data.AccountID)
+	}
+
+	tabs := make([]string, len(tabTitles))
+	for i, name := range tabTitles {
+		style := inactiveTabStyle
+		if tab(i) == d.tab {
+			style = activeTabStyle
+		}
+
+		tabs[i] = style.Render(name)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", lipgloss.JoinHorizontal(lipgloss.Top, tabs...), "")
+}
+
+func (d *dashboard) bodyView() string {
+	if !d.loaded {
+		if d.loading {
+			return d.spinner.View() + " Loading cost, trend and waste data from AWS..."
+		}
+
+		return errorStyle.Render(fmt.Sprintf("Failed to load data: %v", d.err)) + "\n\nPress r to try again."
+	}
+
+	switch d.tab {
+	case trendTab:
+		return d.trendView()
+	case wasteTab:
+		if d.selected != nil {
+			return d.detailsView(*d.selected)
+		}
+
+		return d.wasteView()
+	default:
+		return d.costView()
+	}
+}
+
+func (d *dashboard) footerView() string {
+	var status string
+
+	switch {
+	case d.loading && d.loaded:
+		status = d.spinner.View() + " Refreshing..."
+	case d.err != nil && d.loaded:
+		status = errorStyle.Render(fmt.Sprintf("Refresh failed: %v", d.err))
+	case d.loaded:
+		status = mutedStyle.Render("Updated at " + d.loadedAt.Format("15:04:05"))
+		if skipped := d.data.Waste.SkippedChecks; len(skipped) > 0 {
+			status += " · " + errorStyle.Render(skippedChecksText(skipped))
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, "", status, d.help.View(d.keys))
+}
+
+func (d *dashboard) costView() string {
+	difference := d.cost.CurrentMonth.Total - d.cost.LastMonth.Total
+
+	summary := fmt.Sprintf("Last month (%s): %s · Current month (%s): %s · Difference: %s",
+		d.cost.LastMonth.Start,
+		formatAmount(d.cost.LastMonth.Total, d.cost.LastMonth.Unit),
+		d.cost.CurrentMonth.Start,
+		formatAmount(d.cost.CurrentMonth.Total, d.cost.CurrentMonth.Unit),
+		styleDifference(difference, formatDifference(difference, d.cost.CurrentMonth.Unit)),
+	)
+
+	return summary + "\n\n" + d.costTable.View()
+}
+
+func (d *dashboard) trendView() string {
+	if len(d.trend.Months) == 0 {
+		return "No cost data available for the last six months."
+	}
+
+	chart := barchart.New(max(d.width-2, 20), max(d.bodyHeight()-3, 6),
+		barchart.WithNoAutoBarWidth(), barchart.WithBarWidth(trendBarWidth), barchart.WithBarGap(trendBarGap))
+
+	amounts := make([]string, len(d.trend.Months))
+
+	for i, month := range d.trend.Months {
+		label := utils.FormatMonthLabel(month.Start)
+
+		// The bar is labelled with the month name only
+		short := label
+		if fields := strings.Fields(label); len(fields) > 0 {
+			short = fields[0]
+		}
+
+		color := ""
+		if i < len(d.colors) {
+			color = d.colors[i]
+		}
+
+		chart.Push(barchart.BarData{
+			Label: short,
+			Values: []barchart.BarValue{{
+				Name:  label,
+				Value: month.Total,
+				Style: lipgloss.NewStyle().Foreground(lipgloss.Color(color)),
+			}},
+		})
+
+		amounts[i] = fmt.Sprintf("%s: %s", label, formatAmount(month.Total, month.Unit))
+	}
+
+	chart.Draw()
+
+	return chart.View() + "\n\n" + mutedStyle.Render(strings.Join(amounts, " · "))
+}
+
+func (d *dashboard) wasteView() string {
+	if len(d.findings) == 0 {
+		return "✅ Your account is healthy! No waste found."
+	}
+
+	var total float64
+	for _, finding := range d.visible {
+		total += finding.MonthlyCost
+	}
+
+	summary := fmt.Sprintf("%d of %d findings · %s per month · sorted by %s",
+		len(d.visible), len(d.findings), formatMonthlyCost(total), wasteSortTitles[d.sortOrder])
+
+	switch {
+	case d.filtering:
+		summary = d.filter.View()
+	case d.filter.Value() != "":
+		summary += fmt.Sprintf(" · filter %q", d.filter.Value())
+	}
+
+	return summary + "\n\n" + d.wasteTable.View()
+}
+
+func (d *dashboard) detailsView(finding model.WasteFinding) string {
+	savings := "no price estimate"
+	if finding.MonthlyCost > 0 {
+		savings = formatMonthlyCost(finding.MonthlyCost) + " per month"
+	}
+
+	created := "unknown"
+	if !finding.CreatedAt.IsZero() {
+		days := int(d.now().Sub(finding.CreatedAt).Hours() / 24)
+		created = fmt.Sprintf("%s (%d days ago)", finding.CreatedAt.Format("2006-01-02"), days)
+	}
+
+	tags := "not collected for this resource type"
+
+	if len(finding.Tags) > 0 {
+		names := make([]string, 0, len(finding.Tags))
+		for name := range finding.Tags {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		lines := make([]string, len(names))
+		for i, name := range names {
+			lines[i] = name + " = " + finding.Tags[name]
+		}
+
+		tags = strings.Join(lines, "\n")
+	}
+
+	rows := [][2]string{
+		{"Category", finding.CategoryTitle},
+		{"Resource ID", finding.ResourceID},
+		{"Name", orDash(finding.ResourceName)},
+		{"Status", orDash(finding.Status)},
+		{"Details", orDash(finding.Details)},
+		{"Est. savings", savings},
+		{"Created", created},
+		{"Tags", tags},
+	}
+
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		value := lipgloss.NewStyle().Width(max(d.width-22, 20)).Render(row[1])
+		lines[i] = lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render(row[0]), value)
+	}
+
+	return detailsStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...)) + "\n" + mutedStyle.Render("esc: back to findings")
+}
+
+// skippedChecksText lists the waste checks that could not run, whose categories show no findings
+func skippedChecksText(checks []model.SkippedCheck) string {
+	names := make([]string, len(checks))
+	for i, check := range checks {
+		names[i] = fmt.Sprintf("%s (%s)", check.Name, check.Reason)
+	}
+
+	return "Skipped checks: " + strings.Join(names, ", ")
+}
+
+func formatAmount(amount float64, unit string) string {
+	return strings.TrimSpace(fmt.Sprintf("%.2f %s", amount, unit))
+}
+
+func formatDifference(difference float64, unit string) string {
+	return strings.TrimSpace(fmt.Sprintf("%+.2f %s", difference, unit))
+}
+
+// formatMonthlyCost formats an estimated monthly cost, showing "n/a" when no estimate is available
+func formatMonthlyCost(cost float64) string {
+	if cost == 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("$%.2f", cost)
+}
+
+func styleDifference(difference float64, text string) string {
+	switch {
+	case difference > 0:
+		return increaseStyle.Render(text)
+	case difference < 0:
+		return decreaseStyle.Render(text)
+	default:
+		return text
+	}
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "—"
+	}
+
+	return value
+}
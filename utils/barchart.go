@@ -2,6 +2,7 @@ package utils //nolint:revive
 
 import (
 	"fmt"
+	"io"
 	"sort"
 	"time"
 
@@ -26,10 +27,10 @@ var defaultStyle = lipgloss.NewStyle().
 	BorderForeground(lipgloss.Color("#F4D060"))
 
 // DrawTrendChart draws a bar chart of monthly costs.
-func DrawTrendChart(accountID string, monthlyCosts []model.CostInfo) {
-	fmt.Printf("\n%s\n", text.FgHiWhite.Sprint(" 📈 AWS DOCTOR TREND"))
-	fmt.Printf(" Account ID: %s\n", text.FgBlue.Sprint(accountID))
-	fmt.Println(text.FgHiBlue.Sprint(" ------------------------------------------------"))
+func DrawTrendChart(w io.Writer, accountID string, monthlyCosts []model.CostInfo) {
+	fmt.Fprintf(w, "\n%s\n", text.FgHiWhite.Sprint(" 📈 AWS DOCTOR TREND"))
+	fmt.Fprintf(w, " Account ID: %s\n", text.FgBlue.Sprint(accountID))
+	fmt.Fprintln(w, text.FgHiBlue.Sprint(" ------------------------------------------------"))
 
 	bc := barchart.New(130, 20)
 
@@ -49,15 +50,25 @@ func DrawTrendChart(accountID string, monthlyCosts []model.CostInfo) {
 		bc.Push(data)
 	}
 
-	fmt.Println()
-	fmt.Println()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w)
 
 	bc.Draw()
 	s := lipgloss.JoinHorizontal(lipgloss.Top,
 		defaultStyle.Render(bc.View()),
 	)
 
-	fmt.Println(s)
+	fmt.Fprintln(w, s)
+}
+
+// TrendBarColors returns the bar color of every month with a total, ranked by cost like the trend chart
+func TrendBarColors(costInfo []model.CostInfo) []string {
+	return assignRankedColors(costInfoWithTotals(costInfo))
+}
+
+// FormatMonthLabel formats a YYYY-MM-DD date as "Jan 2024", returning other values unchanged
+func FormatMonthLabel(date string) string {
+	return monthLabel(date)
 }
 
 func getBarLabel(date string, monthlyCost model.CostInfo) string {
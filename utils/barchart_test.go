@@ -317,7 +317,7 @@ func TestDrawTrendChart(t *testing.T) {
 	monthlyCosts[5].Start = aws.String("2024-06-01")
 
 	output := captureOutput(func() {
-		DrawTrendChart("123456789012", monthlyCosts)
+		DrawTrendChart(os.Stdout, "123456789012", monthlyCosts)
 	})
 
 	// Verify output contains expected elements
@@ -337,7 +337,7 @@ func TestDrawTrendChart(t *testing.T) {
 
 func TestDrawTrendChart_EmptyCosts(t *testing.T) {
 	output := captureOutput(func() {
-		DrawTrendChart("123456789012", []model.CostInfo{})
+		DrawTrendChart(os.Stdout, "123456789012", []model.CostInfo{})
 	})
 
 	// Should still produce header output
@@ -353,7 +353,7 @@ func TestDrawTrendChart_SingleMonth(t *testing.T) {
 	monthlyCosts[0].Start = aws.String("2024-01-01")
 
 	output := captureOutput(func() {
-		DrawTrendChart("123456789012", monthlyCosts)
+		DrawTrendChart(os.Stdout, "123456789012", monthlyCosts)
 	})
 
 	if len(output) == 0 {
@@ -382,6 +382,6 @@ func BenchmarkDrawTrendChart(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		DrawTrendChart("123456789012", monthlyCosts)
+		DrawTrendChart(os.Stdout, "123456789012", monthlyCosts)
 	}
 }
@@ -0,0 +1,182 @@
+package utils //nolint:revive
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// DrawCommitmentsTable renders Reserved Instance and Savings Plans utilization, coverage and expiry.
+func DrawCommitmentsTable(w io.Writer, accountID string, report model.CommitmentReport) {
+	fmt.Fprintf(w, "\n%s\n", text.FgHiWhite.Sprint(" 🤝 AWS DOCTOR COMMITMENTS"))
+	fmt.Fprintf(w, " Account ID: %s\n", text.FgBlue.Sprint(accountID))
+	fmt.Fprintf(w, " Period: last %d days\n", report.LookbackDays)
+	fmt.Fprintln(w, text.FgHiBlue.Sprint(" ------------------------------------------------"))
+
+	if report.Reservations == nil && report.SavingsPlans == nil {
+		fmt.Fprintln(w, "\n"+text.FgHiGreen.Sprint(" ✅  No active Reserved Instances or Savings Plans found."))
+		return
+	}
+
+	drawCommitmentSummaryTable(w, report.Reservations, report.SavingsPlans)
+
+	if len(report.UnderutilizedReservations) > 0 || len(report.UnderutilizedSavingsPlans) > 0 {
+		drawUnderutilizedCommitmentsTable(w, report.UnderutilizedReservations, report.UnderutilizedSavingsPlans)
+	}
+
+	if len(report.ExpiringSavingsPlans) > 0 {
+		drawExpiringSavingsPlansTable(w, report.ExpiringSavingsPlans)
+	}
+}
+
+func drawCommitmentSummaryTable(w io.Writer, reservations, savingsPlans *model.CommitmentSummaryInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Commitment Utilization & Coverage")
+
+	t.AppendHeader(table.Row{"Commitment", "Utilization", "Unused Commitment", "Coverage", "Uncovered On-Demand"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 2, Align: text.AlignRight},
+		{Number: 3, Align: text.AlignRight},
+		{Number: 4, Align: text.AlignRight},
+		{Number: 5, Align: text.AlignRight},
+	})
+
+	if reservations != nil {
+		t.AppendRow(populateCommitmentSummaryRow("Reserved Instances", reservations))
+	}
+
+	if savingsPlans != nil {
+		t.AppendRow(populateCommitmentSummaryRow("Savings Plans", savingsPlans))
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateCommitmentSummaryRow(label string, summary *model.CommitmentSummaryInfo) table.Row {
+	utilization := text.FgHiGreen.Sprintf("%.1f%%", summary.UtilizationPercent)
+	if summary.UtilizationPercent < 100 && summary.UnusedCommitment > 0 {
+		utilization = text.FgHiYellow.Sprintf("%.1f%%", summary.UtilizationPercent)
+	}
+
+	unused := fmt.Sprintf("$%.2f", summary.UnusedCommitment)
+	if summary.UnusedHours > 0 {
+		unused = fmt.Sprintf("$%.2f (%.0f h)", summary.UnusedCommitment, summary.UnusedHours)
+	}
+
+	return table.Row{
+		label,
+		utilization,
+		unused,
+		fmt.Sprintf("%.1f%%", summary.CoveragePercent),
+		fmt.Sprintf("$%.2f", summary.OnDemandCost),
+	}
+}
+
+func drawUnderutilizedCommitmentsTable(w io.Writer, reservations, savingsPlans []model.CommitmentUtilizationInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Underutilized Commitments")
+
+	t.AppendHeader(table.Row{"Status", "ID", "Description", "Utilization", "Unused Cost"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+		{Number: 5, Align: text.AlignRight},
+	})
+
+	var hasPreviousRows bool
+
+	if len(reservations) > 0 {
+		statusLabel := "Reserved Instance\n(Underutilized)"
+		rows := populateCommitmentRows(reservations)
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiRed.Sprint(statusLabel)
+
+		t.AppendRows(rows)
+
+		hasPreviousRows = true
+	}
+
+	if len(savingsPlans) > 0 {
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		statusLabel := "Savings Plan\n(Underutilized)"
+		rows := populateCommitmentRows(savingsPlans)
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiRed.Sprint(statusLabel)
+
+		t.AppendRows(rows)
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func drawExpiringSavingsPlansTable(w io.Writer, savingsPlans []model.CommitmentUtilizationInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Savings Plans Expiring Soon")
+
+	t.AppendHeader(table.Row{"Status", "ID", "Description", "Expires"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+	})
+
+	var rows []table.Row
+
+	for _, sp := range savingsPlans {
+		rows = append(rows, table.Row{
+			"",
+			shortCommitmentID(sp.ID),
+			sp.Description,
+			fmt.Sprintf("In %d days", sp.DaysUntilExpiry),
+		})
+	}
+
+	halfRow := len(rows) / 2
+	rows[halfRow][0] = text.FgHiYellow.Sprint("Expiring Soon")
+
+	t.AppendRows(rows)
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateCommitmentRows(commitments []model.CommitmentUtilizationInfo) []table.Row {
+	var rows []table.Row
+
+	for _, c := range commitments {
+		rows = append(rows, table.Row{
+			"",
+			shortCommitmentID(c.ID),
+			c.Description,
+			fmt.Sprintf("%.1f%%", c.UtilizationPercent),
+			fmt.Sprintf("$%.2f", c.UnusedCommitment),
+		})
+	}
+
+	return rows
+}
+
+// shortCommitmentID trims Savings Plan ARNs down to the plan ID so tables stay readable.
+func shortCommitmentID(id string) string {
+	if idx := strings.LastIndex(id, "/"); idx >= 0 && strings.HasPrefix(id, "arn:") {
+		return id[idx+1:]
+	}
+
+	return id
+}
@@ -0,0 +1,138 @@
+package utils //nolint:revive
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestShortCommitmentID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want string
+	}{
+		{
+			name: "savings_plan_arn",
+			id:   "arn:aws:savingsplans::123456789012:savingsplan/abcd-1234",
+			want: "abcd-1234",
+		},
+		{
+			name: "reserved_instance_id",
+			id:   "4b2c1a9e-1111-2222-3333-444455556666",
+			want: "4b2c1a9e-1111-2222-3333-444455556666",
+		},
+		{
+			name: "empty",
+			id:   "",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shortCommitmentID(tt.id); got != tt.want {
+				t.Errorf("shortCommitmentID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPopulateCommitmentRows(t *testing.T) {
+	commitments := []model.CommitmentUtilizationInfo{
+		{ID: "ri-111", Description: "m5.large / us-east-1", UtilizationPercent: 42.5, UnusedCommitment: 12.3},
+		{ID: "arn:aws:savingsplans::123456789012:savingsplan/sp-222", UtilizationPercent: 10, UnusedCommitment: 99},
+	}
+
+	rows := populateCommitmentRows(commitments)
+
+	if len(rows) != 2 {
+		t.Fatalf("populateCommitmentRows() returned %d rows, want 2", len(rows))
+	}
+
+	if rows[0][3] != "42.5%" {
+		t.Errorf("Row 0 utilization = %v, want '42.5%%'", rows[0][3])
+	}
+
+	if rows[0][4] != "$12.30" {
+		t.Errorf("Row 0 unused cost = %v, want '$12.30'", rows[0][4])
+	}
+
+	if rows[1][1] != "sp-222" {
+		t.Errorf("Row 1 ID = %v, want 'sp-222'", rows[1][1])
+	}
+}
+
+func TestPopulateCommitmentSummaryRow(t *testing.T) {
+	row := populateCommitmentSummaryRow("Reserved Instances", &model.CommitmentSummaryInfo{
+		UtilizationPercent: 75,
+		UnusedHours:        120,
+		UnusedCommitment:   15.5,
+		CoveragePercent:    60,
+		OnDemandCost:       250,
+	})
+
+	if len(row) != 5 {
+		t.Fatalf("populateCommitmentSummaryRow() has %d columns, want 5", len(row))
+	}
+
+	if row[2] != "$15.50 (120 h)" {
+		t.Errorf("unused column = %v, want '$15.50 (120 h)'", row[2])
+	}
+
+	if row[4] != "$250.00" {
+		t.Errorf("uncovered column = %v, want '$250.00'", row[4])
+	}
+}
+
+func TestDrawCommitmentsTable_NoCommitments(t *testing.T) {
+	output := captureStdout(func() {
+		DrawCommitmentsTable(os.Stdout, "123456789012", model.CommitmentReport{LookbackDays: 30})
+	})
+
+	if !strings.Contains(output, "123456789012") {
+		t.Error("DrawCommitmentsTable() missing account ID")
+	}
+
+	if !strings.Contains(output, "No active Reserved Instances or Savings Plans") {
+		t.Error("DrawCommitmentsTable() without commitments should say so")
+	}
+}
+
+func TestDrawCommitmentsTable_AllSections(t *testing.T) {
+	report := model.CommitmentReport{
+		LookbackDays: 30,
+		Reservations: &model.CommitmentSummaryInfo{UtilizationPercent: 70, UnusedCommitment: 10},
+		SavingsPlans: &model.CommitmentSummaryInfo{UtilizationPercent: 100, CoveragePercent: 55},
+		UnderutilizedReservations: []model.CommitmentUtilizationInfo{
+			{ID: "ri-111", UtilizationPercent: 50},
+		},
+		UnderutilizedSavingsPlans: []model.CommitmentUtilizationInfo{
+			{ID: "sp-222", UtilizationPercent: 20},
+		},
+		ExpiringSavingsPlans: []model.CommitmentUtilizationInfo{
+			{ID: "sp-333", DaysUntilExpiry: 12},
+		},
+	}
+
+	output := captureStdout(func() {
+		DrawCommitmentsTable(os.Stdout, "123456789012", report)
+	})
+
+	for _, want := range []string{
+		"Commitment Utilization & Coverage",
+		"Reserved Instances",
+		"Savings Plans",
+		"Underutilized Commitments",
+		"ri-111",
+		"sp-222",
+		"Savings Plans Expiring Soon",
+		"In 12 days",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("DrawCommitmentsTable() missing %q", want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package utils //nolint:revive
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
@@ -16,3 +17,9 @@ func ParseCostString(costStr string) float64 {
 
 	return amount
 }
+
+// formatAmount formats an amount with two decimals followed by its unit, such as "42.50 USD", for
+// the PDF, HTML and Markdown reports
+func formatAmount(amount float64, unit string) string {
+	return strings.TrimSpace(fmt.Sprintf("%.2f %s", amount, unit))
+}
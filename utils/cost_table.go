@@ -2,7 +2,7 @@ package utils //nolint:revive
 
 import (
 	"fmt"
-	"os"
+	"io"
 	"sort"
 	"strconv"
 	"strings"
@@ -13,10 +13,10 @@ import (
 )
 
 // DrawCostTable renders a table comparing costs between months.
-func DrawCostTable(accountID string, lastTotalCost, currenttotalCost string, lastMonthGroups, currentMonthGroups *model.CostInfo, _ string) {
-	fmt.Printf("\n%s\n", text.FgHiWhite.Sprint(" 💰 AWS COST DIAGNOSIS"))
-	fmt.Printf(" Account ID: %s\n", text.FgBlue.Sprint(accountID))
-	fmt.Println(text.FgHiBlue.Sprint(" ------------------------------------------------"))
+func DrawCostTable(w io.Writer, accountID string, lastTotalCost, currenttotalCost string, lastMonthGroups, currentMonthGroups *model.CostInfo, _ string) {
+	fmt.Fprintf(w, "\n%s\n", text.FgHiWhite.Sprint(" 💰 AWS COST DIAGNOSIS"))
+	fmt.Fprintf(w, " Account ID: %s\n", text.FgBlue.Sprint(accountID))
+	fmt.Fprintln(w, text.FgHiBlue.Sprint(" ------------------------------------------------"))
 
 	currentMonthHeader := fmt.Sprintf("Current Month\n(%s\n%s)", *currentMonthGroups.Start, *currentMonthGroups.End)
 	lastMonthHeader := fmt.Sprintf("Last Month\n(%s\n%s)", *lastMonthGroups.Start, *lastMonthGroups.End)
@@ -29,7 +29,7 @@ func DrawCostTable(accountID string, lastTotalCost, currenttotalCost string, las
 	}
 
 	tw := table.NewWriter()
-	tw.SetOutputMirror(os.Stdout)
+	tw.SetOutputMirror(w)
 	tw.AppendHeader(rowHeader)
 
 	var rows []table.Row
@@ -341,7 +341,7 @@ func TestDrawCostTable(t *testing.T) {
 	currentMonthGroups.End = aws.String("2024-02-29")
 
 	output := captureTableOutput(func() {
-		DrawCostTable("123456789012", "150.00 USD", "165.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
+		DrawCostTable(os.Stdout, "123456789012", "150.00 USD", "165.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
 	})
 
 	// Verify output contains expected elements
@@ -382,7 +382,7 @@ func TestDrawCostTable_CostsIncreased(t *testing.T) {
 	currentMonthGroups.End = aws.String("2024-02-29")
 
 	output := captureTableOutput(func() {
-		DrawCostTable("123456789012", "100.00 USD", "200.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
+		DrawCostTable(os.Stdout, "123456789012", "100.00 USD", "200.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
 	})
 
 	// Should have output (table with red colors for increases)
@@ -409,7 +409,7 @@ func TestDrawCostTable_CostsDecreased(t *testing.T) {
 	currentMonthGroups.End = aws.String("2024-02-29")
 
 	output := captureTableOutput(func() {
-		DrawCostTable("123456789012", "200.00 USD", "100.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
+		DrawCostTable(os.Stdout, "123456789012", "200.00 USD", "100.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
 	})
 
 	// Should have output (table with green colors for decreases)
@@ -432,7 +432,7 @@ func TestDrawCostTable_EmptyServices(t *testing.T) {
 	currentMonthGroups.End = aws.String("2024-02-29")
 
 	output := captureTableOutput(func() {
-		DrawCostTable("123456789012", "0.00 USD", "0.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
+		DrawCostTable(os.Stdout, "123456789012", "0.00 USD", "0.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
 	})
 
 	// Should still produce header and table structure
@@ -471,6 +471,6 @@ func BenchmarkDrawCostTable(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		DrawCostTable("123456789012", "175.00 USD", "195.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
+		DrawCostTable(os.Stdout, "123456789012", "175.00 USD", "195.00 USD", lastMonthGroups, currentMonthGroups, "UnblendedCost")
 	}
 }
@@ -102,3 +102,23 @@ func BenchmarkParseCostString(b *testing.B) {
 		}
 	}
 }
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount float64
+		unit   string
+		want   string
+	}{
+		{name: "with_unit", amount: 42.5, unit: "USD", want: "42.50 USD"},
+		{name: "without_unit", amount: 1, unit: "", want: "1.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatAmount(tt.amount, tt.unit); got != tt.want {
+				t.Errorf("formatAmount(%v, %q) = %q, want %q", tt.amount, tt.unit, got, tt.want)
+			}
+		})
+	}
+}
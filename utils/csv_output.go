@@ -0,0 +1,342 @@
+package utils //nolint:revive
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// hoursPerMonth converts hourly cost estimates into monthly ones in flat waste rows
+const hoursPerMonth = 730
+
+// CSV column layouts
+var (
+	costComparisonCSVHeader = []string{"account_id", "service", "last_month_start", "last_month_cost", "current_month_start", "current_month_cost", "difference", "unit"}
+	trendCSVHeader          = []string{"account_id", "month_start", "month_end", "total", "unit"}
+	wasteCSVHeader          = []string{"account_id", "category", "resource_id", "resource_name", "status", "details", "estimated_monthly_cost"}
+)
+
+// OutputCostComparisonCSV outputs cost comparison data as CSV, one row per service ordered by
+// current month cost followed by a Total row
+func OutputCostComparisonCSV(w io.Writer, accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) error {
+	output := BuildCostComparisonJSON(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth)
+
+	records := [][]string{costComparisonCSVHeader}
+
+	for _, service := range output.ServiceBreakdown {
+		records = append(records, []string{
+			accountID,
+			service.Service,
+			output.LastMonth.Start,
+			formatCSVAmount(service.LastCost),
+			output.CurrentMonth.Start,
+			formatCSVAmount(service.CurrentCost),
+			formatCSVAmount(service.Difference),
+			service.Unit,
+		})
+	}
+
+	records = append(records, []string{
+		accountID,
+		"Total",
+		output.LastMonth.Start,
+		formatCSVAmount(lastTotalCost),
+		output.CurrentMonth.Start,
+		formatCSVAmount(currentTotalCost),
+		formatCSVAmount(currentTotalCost - lastTotalCost),
+		output.CurrentMonth.Unit,
+	})
+
+	return printCSV(w, records)
+}
+
+// OutputTrendCSV outputs trend data as CSV, one row per month
+func OutputTrendCSV(w io.Writer, accountID string, costInfo []model.CostInfo) error {
+	output := BuildTrendJSON(accountID, costInfo)
+
+	records := [][]string{trendCSVHeader}
+
+	for _, month := range output.Months {
+		records = append(records, []string{
+			accountID,
+			month.Start,
+			month.End,
+			formatCSVAmount(month.Total),
+			month.Unit,
+		})
+	}
+
+	return printCSV(w, records)
+}
+
+// OutputWasteCSV outputs waste detection data as a single long-form CSV with one row per resource.
+// The category column holds the matching JSON field name; estimated_monthly_cost is empty when
+// no estimate is available. Categories whose check was skipped get a row with the "skipped"
+// status, no resource and the reason in details.
+func OutputWasteCSV(w io.Writer, accountID string, report model.WasteReport) error {
+	records := [][]string{wasteCSVHeader}
+	output := BuildWasteReportJSON(accountID, report)
+
+	for _, row := range buildWasteRows(output) {
+		records = append(records, []string{
+			accountID,
+			row.Category,
+			row.ResourceID,
+			row.ResourceName,
+			row.Status,
+			row.Details,
+			formatCSVCost(row.MonthlyCost),
+		})
+	}
+
+	for _, check := range output.SkippedChecks {
+		details := check.Name + ": " + check.Reason
+
+		// A partly skipped check has no empty category to point at
+		if len(check.Categories) == 0 {
+			records = append(records, []string{accountID, "", "", "", "skipped", details, ""})
+		}
+
+		for _, category := range check.Categories {
+			records = append(records, []string{accountID, category, "", "", "skipped", details, ""})
+		}
+	}
+
+	return printCSV(w, records)
+}
+
+// wasteRow is a single wasted resource in the flat layout shared by the CSV and PDF reports
+type wasteRow struct {
+	Category     string // JSON field name of the waste category, such as "unused_ebs_volumes"
+	ResourceID   string
+	ResourceName string
+	Status       string
+	Details      string
+	MonthlyCost  float64 // 0 when no estimate is available
+}
+
+// buildWasteRows flattens every waste category into rows, in the order of the JSON report.
+// Hourly cost estimates are converted to monthly ones.
+func buildWasteRows(output model.WasteReportJSON) []wasteRow {
+	var rows wasteRows
+
+	rows.addComputeRows(output)
+	rows.addManagedServiceRows(output)
+	rows.addImageRows(output)
+
+	return rows
+}
+
+// wasteRows accumulates the rows of buildWasteRows
+type wasteRows []wasteRow
+
+func (rows *wasteRows) add(category, resourceID, resourceName, status, details string, monthlyCost float64) {
+	*rows = append(*rows, wasteRow{
+		Category:     category,
+		ResourceID:   resourceID,
+		ResourceName: resourceName,
+		Status:       status,
+		Details:      details,
+		MonthlyCost:  monthlyCost,
+	})
+}
+
+// addComputeRows adds the EC2, load balancer and container findings
+func (rows *wasteRows) addComputeRows(output model.WasteReportJSON) {
+	for _, ip := range output.UnusedElasticIPs {
+		rows.add("unused_elastic_ips", ip.AllocationID, ip.PublicIP, "unassociated", "", 0)
+	}
+
+	for _, vol := range output.UnusedEBSVolumes {
+		rows.add("unused_ebs_volumes", vol.VolumeID, "", vol.Status, fmt.Sprintf("%d GiB", vol.Size), 0)
+	}
+
+	for _, vol := range output.StoppedVolumes {
+		rows.add("stopped_instance_volumes", vol.VolumeID, "", vol.Status, fmt.Sprintf("%d GiB", vol.Size), 0)
+	}
+
+	for _, instance := range output.StoppedInstances {
+		rows.add("stopped_instances", instance.InstanceID, "", "stopped", fmt.Sprintf("stopped %d days ago", instance.DaysAgo), 0)
+	}
+
+	for _, ri := range output.ReservedInstances {
+		rows.add("reserved_instances", ri.ReservedInstanceID, ri.InstanceType, ri.Status,
+			fmt.Sprintf("expires %s (%d days)", ri.ExpirationDate, ri.DaysUntilExpiry), 0)
+	}
+
+	for _, reservation := range output.CapacityReservations {
+		rows.add("unused_capacity_reservations", reservation.CapacityReservationID, reservation.InstanceType, reservation.Status,
+			fmt.Sprintf("%s; %d of %d available; %.1f%% utilized", reservation.AvailabilityZone,
+				reservation.AvailableInstanceCount, reservation.TotalInstanceCount, reservation.UtilizationPercent), 0)
+	}
+
+	for _, host := range output.DedicatedHosts {
+		rows.add("idle_dedicated_hosts", host.HostID, firstNonEmpty(host.InstanceType, host.InstanceFamily), host.State,
+			fmt.Sprintf("%s; allocated %d days ago", host.AvailabilityZone, host.DaysSinceAllocation), 0)
+	}
+
+	for _, lb := range output.UnusedLoadBalancers {
+		rows.add("unused_load_balancers", lb.ARN, lb.Name, "no_targets", lb.Type, 0)
+	}
+
+	for _, lb := range output.UnusedClassicLBs {
+		rows.add("unused_classic_load_balancers", lb.DNSName, lb.Name, lb.Status, fmt.Sprintf("%d instances", lb.InstanceCount), 0)
+	}
+
+	for _, cluster := range output.IdleEKSClusters {
+		details := fmt.Sprintf("Kubernetes %s; %d node groups", cluster.KubernetesVersion, cluster.NodeGroupCount)
+		if cluster.ExtendedSupport {
+			details += "; extended support"
+		}
+
+		rows.add("idle_eks_clusters", cluster.ClusterName, cluster.ClusterName, cluster.Status, details, cluster.EstimatedMonthlyCost)
+	}
+
+	for _, service := range output.IdleECSServices {
+		rows.add("idle_ecs_services", service.ClusterName+"/"+service.ServiceName, service.ServiceName, "scaled_to_zero",
+			fmt.Sprintf("%d target groups", len(service.TargetGroupArns)), service.EstimatedMonthlyCost)
+	}
+}
+
+// addManagedServiceRows adds the findings of managed services such as SageMaker, Redshift and Route 53
+func (rows *wasteRows) addManagedServiceRows(output model.WasteReportJSON) {
+	for _, endpoint := range output.IdleSageMakerEndpoints {
+		rows.add("idle_sagemaker_endpoints", endpoint.EndpointName+"/"+endpoint.VariantName, endpoint.EndpointName, "no_invocations",
+			fmt.Sprintf("%d x %s; idle %d days", endpoint.InstanceCount, endpoint.InstanceType, endpoint.IdleDays),
+			endpoint.HourlyCost*hoursPerMonth)
+	}
+
+	for _, notebook := range output.LongRunningSageMakerNotebooks {
+		rows.add("long_running_sagemaker_notebooks", notebook.NotebookInstanceName, notebook.NotebookInstanceName, "long_running",
+			fmt.Sprintf("%s; running for %d days without restart", notebook.InstanceType, notebook.DaysSinceModified),
+			notebook.HourlyCost*hoursPerMonth)
+	}
+
+	for _, cluster := range output.IdleRedshiftClusters {
+		rows.add("idle_redshift_clusters", cluster.ClusterIdentifier, cluster.ClusterIdentifier, cluster.Status,
+			fmt.Sprintf("%d x %s; %.1f%% average CPU", cluster.NodeCount, cluster.NodeType, cluster.AverageCPUPercent),
+			cluster.EstimatedMonthlyCost)
+	}
+
+	for _, snapshot := range output.StaleRedshiftSnapshots {
+		rows.add("stale_redshift_snapshots", snapshot.SnapshotIdentifier, snapshot.ClusterIdentifier, "stale",
+			fmt.Sprintf("%.1f GB; created %d days ago", snapshot.SizeGB, snapshot.DaysSinceCreate), snapshot.EstimatedMonthlyCost)
+	}
+
+	for _, fileSystem := range output.EFSFileSystems {
+		// A file system missing only an IA lifecycle policy is in use, so the waste is what
+		// moving its Standard data to Infrequent Access would save, not its whole storage cost.
+		monthlyCost := fileSystem.EstimatedMonthlyCost
+		if fileSystem.Status == model.EFSFileSystemNoIALifecycle {
+			monthlyCost = fileSystem.PotentialIASavings
+		}
+
+		rows.add("efs_file_systems", fileSystem.FileSystemID, fileSystem.Name, fileSystem.Status,
+			fmt.Sprintf("%.1f GB total; %d mount targets", fileSystem.TotalSizeGB, fileSystem.MountTargetCount),
+			monthlyCost)
+	}
+
+	for _, recoveryPoint := range output.BackupRecoveryPoints {
+		rows.add("backup_recovery_points", recoveryPoint.RecoveryPointArn, firstNonEmpty(recoveryPoint.ResourceName, recoveryPoint.ResourceArn),
+			recoveryPoint.Status, fmt.Sprintf("%s in %s; %.1f GB", recoveryPoint.ResourceType, recoveryPoint.BackupVaultName, recoveryPoint.SizeGB),
+			recoveryPoint.EstimatedMonthlyCost)
+	}
+
+	for _, secret := range output.UnusedSecrets {
+		rows.add("unused_secrets", secret.ARN, secret.Name, "unused", fmt.Sprintf("last accessed %d days ago", secret.DaysSinceAccess), secret.MonthlyCost)
+	}
+
+	for _, key := range output.UnusedKMSKeys {
+		rows.add("unused_kms_keys", key.KeyID, key.Description, key.Status, key.KeyState, key.MonthlyCost)
+	}
+
+	for _, zone := range output.Route53HostedZones {
+		targets := make([]string, 0, len(zone.DanglingRecords))
+		for _, record := range zone.DanglingRecords {
+			targets = append(targets, fmt.Sprintf("%s %s -> %s (%s)", record.Name, record.Type, record.Target, strings.ToLower(record.Status)))
+		}
+
+		rows.add("route53_hosted_zones", zone.ZoneID, zone.Name, zone.Status, strings.Join(targets, "; "), zone.MonthlyCost)
+	}
+
+	for _, check := range output.UnusedRoute53Checks {
+		rows.add("unused_route53_health_checks", check.HealthCheckID, check.Endpoint, "unreferenced", check.Type, check.MonthlyCost)
+	}
+
+	for _, stream := range output.KinesisStreams {
+		rows.add("kinesis_streams", stream.StreamName, stream.StreamName, stream.Status, stream.Recommendation, stream.HourlyCost*hoursPerMonth)
+	}
+
+	for _, cluster := range output.IdleMSKClusters {
+		rows.add("idle_msk_clusters", cluster.ClusterArn, cluster.ClusterName, "idle",
+			fmt.Sprintf("%d x %s; %.0f bytes/sec in", cluster.BrokerCount, cluster.InstanceType, cluster.AverageBytesInPerSec),
+			cluster.HourlyCost*hoursPerMonth)
+	}
+
+	for _, trail := range output.CloudTrailTrails {
+		details := trail.HomeRegion
+		if trail.DuplicateOf != "" {
+			details += "; duplicates " + trail.DuplicateOf
+		}
+
+		rows.add("cloudtrail_trails", trail.TrailARN, trail.TrailName, trail.Status, details, trail.EstimatedMonthlyCost)
+	}
+}
+
+// addImageRows adds the AMI and EBS snapshot findings
+func (rows *wasteRows) addImageRows(output model.WasteReportJSON) {
+	for _, ami := range output.UnusedAMIs {
+		details := fmt.Sprintf("%d GB of snapshots", ami.SnapshotSizeGB)
+		if ami.SafetyWarning != "" {
+			details += "; " + ami.SafetyWarning
+		}
+
+		rows.add("unused_amis", ami.ImageID, ami.Name, "unused", details, ami.MaxPotentialSaving)
+	}
+
+	for _, snapshot := range output.OrphanedSnapshots {
+		rows.add("orphaned_snapshots", snapshot.SnapshotID, snapshot.VolumeID, snapshot.Category, snapshot.Reason, snapshot.MaxPotentialSavings)
+	}
+
+	for _, snapshot := range output.StaleSnapshots {
+		rows.add("stale_snapshots", snapshot.SnapshotID, snapshot.VolumeID, snapshot.Category, snapshot.Reason, snapshot.MaxPotentialSavings)
+	}
+}
+
+// formatCSVAmount formats a cost amount with two decimals and no currency symbol
+func formatCSVAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// formatCSVCost formats an estimated cost, leaving the cell empty when no estimate is available
+func formatCSVCost(cost float64) string {
+	if cost == 0 {
+		return ""
+	}
+
+	return formatCSVAmount(cost)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func printCSV(w io.Writer, records [][]string) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
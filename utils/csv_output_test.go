@@ -0,0 +1,222 @@
+package utils //nolint:revive
+
+import (
+	"encoding/csv"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func parseCSVOutput(t *testing.T, output string) [][]string {
+	t.Helper()
+
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to parse output CSV: %v", err)
+	}
+
+	return records
+}
+
+func TestOutputCostComparisonCSV(t *testing.T) {
+	lastMonth := &model.CostInfo{
+		CostGroup: model.CostGroup{
+			"Amazon EC2": {Amount: 100.0, Unit: "USD"},
+			"Amazon S3":  {Amount: 50.0, Unit: "USD"},
+		},
+	}
+	lastMonth.Start = aws.String("2024-01-01")
+	lastMonth.End = aws.String("2024-01-31")
+
+	currentMonth := &model.CostInfo{
+		CostGroup: model.CostGroup{
+			"Amazon S3":  {Amount: 45.0, Unit: "USD"},
+			"Amazon EC2": {Amount: 120.0, Unit: "USD"},
+		},
+	}
+	currentMonth.Start = aws.String("2024-02-01")
+	currentMonth.End = aws.String("2024-02-29")
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputCostComparisonCSV(os.Stdout, "123456789012", 150.0, 165.0, lastMonth, currentMonth)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputCostComparisonCSV() error = %v", err)
+	}
+
+	records := parseCSVOutput(t, output)
+
+	want := [][]string{
+		costComparisonCSVHeader,
+		{"123456789012", "Amazon EC2", "2024-01-01", "100.00", "2024-02-01", "120.00", "20.00", "USD"},
+		{"123456789012", "Amazon S3", "2024-01-01", "50.00", "2024-02-01", "45.00", "-5.00", "USD"},
+		{"123456789012", "Total", "2024-01-01", "150.00", "2024-02-01", "165.00", "15.00", "USD"},
+	}
+
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d:\n%s", len(records), len(want), output)
+	}
+
+	for i := range want {
+		if strings.Join(records[i], ",") != strings.Join(want[i], ",") {
+			t.Errorf("record %d = %v, want %v", i, records[i], want[i])
+		}
+	}
+}
+
+func TestOutputTrendCSV(t *testing.T) {
+	costInfo := []model.CostInfo{
+		{CostGroup: model.CostGroup{"Total": {Amount: 100.0, Unit: "USD"}}},
+		{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 80.0, Unit: "USD"}}},
+		{CostGroup: model.CostGroup{"Total": {Amount: 90.5, Unit: "USD"}}},
+	}
+	costInfo[0].Start = aws.String("2024-01-01")
+	costInfo[0].End = aws.String("2024-01-31")
+	costInfo[2].Start = aws.String("2024-03-01")
+	costInfo[2].End = aws.String("2024-03-31")
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputTrendCSV(os.Stdout, "123456789012", costInfo)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputTrendCSV() error = %v", err)
+	}
+
+	records := parseCSVOutput(t, output)
+
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want header + 2 months:\n%s", len(records), output)
+	}
+
+	if got := strings.Join(records[0], ","); got != "account_id,month_start,month_end,total,unit" {
+		t.Errorf("header = %q", got)
+	}
+
+	if got := strings.Join(records[2], ","); got != "123456789012,2024-03-01,2024-03-31,90.50,USD" {
+		t.Errorf("last month = %q", got)
+	}
+}
+
+func TestOutputWasteCSV(t *testing.T) {
+	report := model.WasteReport{
+		ElasticIPs: []types.Address{
+			{PublicIp: aws.String("1.2.3.4"), AllocationId: aws.String("eipalloc-123")},
+		},
+		UnusedVolumes: []types.Volume{
+			{VolumeId: aws.String("vol-123"), Size: aws.Int32(100)},
+		},
+		SageMakerEndpoints: []model.SageMakerEndpointWasteInfo{
+			{EndpointName: "endpoint", VariantName: "AllTraffic", InstanceType: "ml.m5.large", InstanceCount: 1, HourlyCost: 0.1, IdleDays: 7},
+		},
+		KMSKeys: []model.KMSKeyWasteInfo{
+			{KeyID: "key-1", Description: "old, unused key", KeyState: "Disabled", Status: model.KMSKeyDisabled, MonthlyCost: 1},
+		},
+	}
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputWasteCSV(os.Stdout, "123456789012", report)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputWasteCSV() error = %v", err)
+	}
+
+	records := parseCSVOutput(t, output)
+
+	if len(records) != 5 {
+		t.Fatalf("got %d records, want header + 4 resources:\n%s", len(records), output)
+	}
+
+	if got := strings.Join(records[0], ","); got != strings.Join(wasteCSVHeader, ",") {
+		t.Errorf("header = %q", got)
+	}
+
+	tests := []struct {
+		row      int
+		category string
+		id       string
+		cost     string
+	}{
+		{row: 1, category: "unused_elastic_ips", id: "eipalloc-123", cost: ""},
+		{row: 2, category: "unused_ebs_volumes", id: "vol-123", cost: ""},
+		{row: 3, category: "idle_sagemaker_endpoints", id: "endpoint/AllTraffic", cost: "73.00"},
+		{row: 4, category: "unused_kms_keys", id: "key-1", cost: "1.00"},
+	}
+
+	for _, tt := range tests {
+		record := records[tt.row]
+
+		if record[0] != "123456789012" || record[1] != tt.category || record[2] != tt.id || record[6] != tt.cost {
+			t.Errorf("record %d = %v, want category %q, id %q, cost %q", tt.row, record, tt.category, tt.id, tt.cost)
+		}
+	}
+
+	if records[4][3] != "old, unused key" {
+		t.Errorf("resource_name = %q, want the quoted description", records[4][3])
+	}
+}
+
+func TestBuildWasteRows_EFSLifecycleSavings(t *testing.T) {
+	rows := buildWasteRows(BuildWasteReportJSON("123456789012", model.WasteReport{
+		EFSFileSystems: []model.EFSFileSystemWasteInfo{
+			{FileSystemID: "fs-unmounted", Status: model.EFSFileSystemNoMountTargets, EstimatedMonthlyCost: 30, PotentialIASavings: 20},
+			{FileSystemID: "fs-busy", Status: model.EFSFileSystemNoIALifecycle, EstimatedMonthlyCost: 300, PotentialIASavings: 250},
+		},
+	}))
+
+	if len(rows) != 2 {
+		t.Fatalf("buildWasteRows() returned %d rows, want 2", len(rows))
+	}
+
+	if rows[0].MonthlyCost != 30 {
+		t.Errorf("unmounted file system cost = %v, want its storage cost 30", rows[0].MonthlyCost)
+	}
+
+	if rows[1].MonthlyCost != 250 {
+		t.Errorf("file system without IA lifecycle cost = %v, want its IA savings 250", rows[1].MonthlyCost)
+	}
+}
+
+func TestOutputWasteCSV_NoWaste(t *testing.T) {
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputWasteCSV(os.Stdout, "123456789012", model.WasteReport{})
+	})
+
+	if err != nil {
+		t.Fatalf("OutputWasteCSV() error = %v", err)
+	}
+
+	if records := parseCSVOutput(t, output); len(records) != 1 {
+		t.Errorf("got %d records, want only the header", len(records))
+	}
+}
+
+func TestOutputWasteCSV_SkippedChecks(t *testing.T) {
+	output := captureStdout(func() {
+		_ = OutputWasteCSV(os.Stdout, "123456789012", model.WasteReport{SkippedChecks: []model.SkippedCheck{{Name: "Route 53", Reason: "access denied", Categories: []string{"route53_hosted_zones", "unused_route53_health_checks"}}}})
+	})
+
+	records := parseCSVOutput(t, output)
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want the header and one row per skipped category", len(records))
+	}
+
+	want := []string{"123456789012", "route53_hosted_zones", "", "", "skipped", "Route 53: access denied", ""}
+	if strings.Join(records[1], ",") != strings.Join(want, ",") {
+		t.Errorf("skipped row = %v, want %v", records[1], want)
+	}
+}
@@ -0,0 +1,186 @@
+package utils //nolint:revive
+
+import (
+	_ "embed" // embeds the HTML report template
+	"html/template"
+	"io"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Trend chart geometry in SVG user units
+const (
+	htmlChartWidth     = 900.0
+	htmlChartHeight    = 320.0
+	htmlChartBarHeight = 260.0
+	htmlChartMaxBar    = 120.0
+)
+
+//go:embed templates/report.html.tmpl
+var htmlReportTemplate string
+
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{
+	"amount": formatAmount,
+	"cost":   formatMonthlyCost,
+	"trend": func(difference float64) string {
+		switch {
+		case difference > 0:
+			return "increase"
+		case difference < 0:
+			return "decrease"
+		default:
+			return ""
+		}
+	},
+}).Parse(htmlReportTemplate))
+
+// htmlReportData is the data rendered by the HTML report template; exactly one of Cost, Trend and Waste is set
+type htmlReportData struct {
+	Title          string
+	AccountID      string
+	GeneratedAt    string
+	Cost           *model.CostComparisonJSON
+	CostDifference float64
+	Trend          *htmlTrend
+	Waste          *htmlWaste
+}
+
+type htmlTrend struct {
+	Width    float64
+	Height   float64
+	Baseline float64
+	Bars     []htmlBar
+	Months   []model.MonthCostJSON
+}
+
+type htmlBar struct {
+	Label  string
+	Value  string
+	Color  string
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+	Center float64
+	ValueY float64
+	LabelY float64
+}
+
+type htmlWaste struct {
+	Count    int
+	Total    float64
+	Sections []htmlWasteSection
+	Skipped  []model.SkippedCheckJSON
+}
+
+type htmlWasteSection struct {
+	Title string
+	Total float64
+	Rows  []wasteRow
+}
+
+// OutputCostComparisonHTML writes the cost comparison as a self-contained HTML document
+func OutputCostComparisonHTML(w io.Writer, accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) error {
+	output := BuildCostComparisonJSON(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth)
+
+	return htmlReport.Execute(w, htmlReportData{
+		Title:          "Cost Comparison",
+		AccountID:      accountID,
+		GeneratedAt:    output.GeneratedAt,
+		Cost:           &output,
+		CostDifference: currentTotalCost - lastTotalCost,
+	})
+}
+
+// OutputTrendHTML writes the monthly cost trend as a self-contained HTML document with an SVG bar chart
+func OutputTrendHTML(w io.Writer, accountID string, costInfo []model.CostInfo) error {
+	output := BuildTrendJSON(accountID, costInfo)
+
+	return htmlReport.Execute(w, htmlReportData{
+		Title:       "Cost Trend",
+		AccountID:   accountID,
+		GeneratedAt: output.GeneratedAt,
+		Trend:       buildHTMLTrend(output.Months, assignRankedColors(costInfoWithTotals(costInfo))),
+	})
+}
+
+// OutputWasteHTML writes the waste report as a self-contained HTML document, with a summary
+// followed by one collapsible, sortable table per waste category
+func OutputWasteHTML(w io.Writer, accountID string, report model.WasteReport) error {
+	output := BuildWasteReportJSON(accountID, report)
+
+	return htmlReport.Execute(w, htmlReportData{
+		Title:       "Waste Report",
+		AccountID:   accountID,
+		GeneratedAt: output.GeneratedAt,
+		Waste:       buildHTMLWaste(buildWasteRows(output), output.SkippedChecks),
+	})
+}
+
+// buildHTMLTrend lays out one bar per month, scaled to the highest total
+func buildHTMLTrend(months []model.MonthCostJSON, colors []string) *htmlTrend {
+	trend := &htmlTrend{
+		Width:    htmlChartWidth,
+		Height:   htmlChartHeight,
+		Baseline: htmlChartBarHeight + 20,
+		Months:   months,
+	}
+
+	if len(months) == 0 {
+		return trend
+	}
+
+	var maxTotal float64
+	for _, month := range months {
+		maxTotal = max(maxTotal, month.Total)
+	}
+
+	slot := htmlChartWidth / float64(len(months))
+	barWidth := min(slot*0.6, htmlChartMaxBar)
+
+	for i, month := range months {
+		height := 0.0
+		if maxTotal > 0 {
+			height = month.Total / maxTotal * htmlChartBarHeight
+		}
+
+		color := colors[i]
+		if color == "" {
+			color = "#8c959f"
+		}
+
+		center := slot*float64(i) + slot/2
+
+		trend.Bars = append(trend.Bars, htmlBar{
+			Label:  monthLabel(month.Start),
+			Value:  formatAmount(month.Total, month.Unit),
+			Color:  color,
+			X:      center - barWidth/2,
+			Y:      trend.Baseline - height,
+			Width:  barWidth,
+			Height: height,
+			Center: center,
+			ValueY: trend.Baseline - height - 6,
+			LabelY: trend.Baseline + 18,
+		})
+	}
+
+	return trend
+}
+
+func buildHTMLWaste(rows []wasteRow, skipped []model.SkippedCheckJSON) *htmlWaste {
+	waste := &htmlWaste{Count: len(rows), Skipped: skipped}
+
+	for _, section := range groupWasteRows(rows) {
+		total := sumWasteCost(section)
+		waste.Total += total
+
+		waste.Sections = append(waste.Sections, htmlWasteSection{
+			Title: wasteCategoryTitles[section[0].Category],
+			Total: total,
+			Rows:  section,
+		})
+	}
+
+	return waste
+}
@@ -0,0 +1,138 @@
+package utils //nolint:revive
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func checkHTMLReport(t *testing.T, html string) string {
+	t.Helper()
+
+	for _, external := range []string{"<link", "src=\"http", "href=\"http"} {
+		if strings.Contains(html, external) {
+			t.Errorf("report references an external resource (%q)", external)
+		}
+	}
+
+	return html
+}
+
+func TestOutputCostComparisonHTML(t *testing.T) {
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 100.0, Unit: "USD"}}}
+	lastMonth.Start = aws.String("2024-01-01")
+
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 120.0, Unit: "USD"}}}
+	currentMonth.Start = aws.String("2024-02-01")
+
+	var buf bytes.Buffer
+
+	if err := OutputCostComparisonHTML(&buf, "123456789012", 100.0, 120.0, lastMonth, currentMonth); err != nil {
+		t.Fatalf("OutputCostComparisonHTML() error = %v", err)
+	}
+
+	html := checkHTMLReport(t, buf.String())
+
+	for _, want := range []string{"123456789012", "Amazon EC2", "120.00 USD", "class=\"num increase\"", "table.sortable"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("report does not contain %q", want)
+		}
+	}
+}
+
+func TestOutputTrendHTML(t *testing.T) {
+	costInfo := []model.CostInfo{
+		{CostGroup: model.CostGroup{"Total": {Amount: 50.0, Unit: "USD"}}},
+		{CostGroup: model.CostGroup{"Total": {Amount: 100.0, Unit: "USD"}}},
+	}
+	costInfo[0].Start = aws.String("2024-01-01")
+	costInfo[1].Start = aws.String("2024-02-01")
+
+	var buf bytes.Buffer
+
+	if err := OutputTrendHTML(&buf, "123456789012", costInfo); err != nil {
+		t.Fatalf("OutputTrendHTML() error = %v", err)
+	}
+
+	html := checkHTMLReport(t, buf.String())
+
+	if strings.Count(html, "<rect") != 2 {
+		t.Errorf("report should contain one bar per month:\n%s", html)
+	}
+
+	if !strings.Contains(html, "Feb 2024") {
+		t.Error("report does not label the bars with their month")
+	}
+}
+
+func TestBuildHTMLTrend(t *testing.T) {
+	months := []model.MonthCostJSON{{Start: "2024-01-01", Total: 50}, {Start: "2024-02-01", Total: 100}}
+
+	trend := buildHTMLTrend(months, []string{ColorRank2, ""})
+
+	if len(trend.Bars) != 2 {
+		t.Fatalf("got %d bars, want 2", len(trend.Bars))
+	}
+
+	if trend.Bars[1].Height != htmlChartBarHeight || trend.Bars[0].Height != htmlChartBarHeight/2 {
+		t.Errorf("bar heights = %v, %v, want half and full height", trend.Bars[0].Height, trend.Bars[1].Height)
+	}
+
+	if trend.Bars[1].Color == "" {
+		t.Error("bars without a ranked color should fall back to gray")
+	}
+}
+
+func TestOutputWasteHTML(t *testing.T) {
+	report := model.WasteReport{
+		UnusedVolumes: []types.Volume{{VolumeId: aws.String("vol-123"), Size: aws.Int32(100)}},
+		KMSKeys: []model.KMSKeyWasteInfo{
+			{KeyID: "key-1", Description: "<script>alert(1)</script>", Status: model.KMSKeyDisabled, MonthlyCost: 1},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	if err := OutputWasteHTML(&buf, "123456789012", report); err != nil {
+		t.Fatalf("OutputWasteHTML() error = %v", err)
+	}
+
+	html := checkHTMLReport(t, buf.String())
+
+	if strings.Count(html, "<details") != 2 {
+		t.Error("report should contain one collapsible section per waste category")
+	}
+
+	if strings.Contains(html, "<script>alert(1)</script>") {
+		t.Error("resource values must be HTML escaped")
+	}
+}
+
+func TestOutputWasteHTML_NoWaste(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := OutputWasteHTML(&buf, "123456789012", model.WasteReport{}); err != nil {
+		t.Fatalf("OutputWasteHTML() error = %v", err)
+	}
+
+	if html := checkHTMLReport(t, buf.String()); !strings.Contains(html, "No waste found") {
+		t.Error("report should state that no waste was found")
+	}
+}
+
+func TestOutputWasteHTML_SkippedChecks(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := OutputWasteHTML(&buf, "123456789012", model.WasteReport{SkippedChecks: []model.SkippedCheck{{Name: "Route 53", Reason: "access denied", Categories: []string{"route53_hosted_zones", "unused_route53_health_checks"}}}}); err != nil {
+		t.Fatalf("OutputWasteHTML() error = %v", err)
+	}
+
+	html := checkHTMLReport(t, buf.String())
+	if !strings.Contains(html, "<li>Route 53: access denied</li>") || strings.Contains(html, "good health") {
+		t.Error("report should list the skipped checks and not claim the account is healthy")
+	}
+}
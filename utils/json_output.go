@@ -3,6 +3,8 @@ package utils //nolint:revive
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -12,10 +14,16 @@ import (
 )
 
 // OutputCostComparisonJSON outputs cost comparison data as JSON
-func OutputCostComparisonJSON(accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) error {
+func OutputCostComparisonJSON(w io.Writer, accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) error {
+	return printJSON(w, BuildCostComparisonJSON(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth))
+}
+
+// BuildCostComparisonJSON converts cost comparison data into its serializable form
+func BuildCostComparisonJSON(accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) model.CostComparisonJSON {
 	output := model.CostComparisonJSON{
-		AccountID:   accountID,
-		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		SchemaVersion: model.JSONSchemaVersion,
+		AccountID:     accountID,
+		GeneratedAt:   time.Now().UTC().Format(time.RFC3339),
 		CurrentMonth: model.CostPeriodJSON{
 			Start: aws.ToString(currentMonth.Start),
 			End:   aws.ToString(currentMonth.End),
@@ -43,15 +51,35 @@ func OutputCostComparisonJSON(accountID string, lastTotalCost, currentTotalCost
 		})
 	}
 
-	return printJSON(output)
+	sortServiceBreakdown(output.ServiceBreakdown)
+
+	return output
+}
+
+// sortServiceBreakdown orders services by current month cost, highest first, then by name, so the
+// breakdown is stable across runs
+func sortServiceBreakdown(breakdown []model.ServiceCostCompareJSON) {
+	sort.SliceStable(breakdown, func(i, j int) bool {
+		if breakdown[i].CurrentCost != breakdown[j].CurrentCost {
+			return breakdown[i].CurrentCost > breakdown[j].CurrentCost
+		}
+
+		return breakdown[i].Service < breakdown[j].Service
+	})
 }
 
 // OutputTrendJSON outputs trend data as JSON
-func OutputTrendJSON(accountID string, costInfo []model.CostInfo) error {
+func OutputTrendJSON(w io.Writer, accountID string, costInfo []model.CostInfo) error {
+	return printJSON(w, BuildTrendJSON(accountID, costInfo))
+}
+
+// BuildTrendJSON converts trend data into its serializable form
+func BuildTrendJSON(accountID string, costInfo []model.CostInfo) model.TrendJSON {
 	output := model.TrendJSON{
-		AccountID:   accountID,
-		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
-		Months:      []model.MonthCostJSON{},
+		SchemaVersion: model.JSONSchemaVersion,
+		AccountID:     accountID,
+		GeneratedAt:   time.Now().UTC().Format(time.RFC3339),
+		Months:        []model.MonthCostJSON{},
 	}
 
 	for _, info := range costInfo {
@@ -65,55 +93,86 @@ func OutputTrendJSON(accountID string, costInfo []model.CostInfo) error {
 		}
 	}
 
-	return printJSON(output)
+	return output
 }
 
 // OutputWasteJSON outputs waste detection data as JSON
-func OutputWasteJSON(accountID string, elasticIPs []types.Address, unusedVolumes []types.Volume, stoppedVolumes []types.Volume, ris []model.RiExpirationInfo, stoppedInstances []types.Instance, loadBalancers []elbtypes.LoadBalancer, unusedAMIs []model.AMIWasteInfo, orphanedSnapshots []model.SnapshotWasteInfo) error {
-	output := model.WasteReportJSON{
-		AccountID:           accountID,
-		GeneratedAt:         time.Now().UTC().Format(time.RFC3339),
-		UnusedElasticIPs:    []model.ElasticIPJSON{},
-		UnusedEBSVolumes:    []model.EBSVolumeJSON{},
-		StoppedVolumes:      []model.EBSVolumeJSON{},
-		StoppedInstances:    []model.StoppedInstanceJSON{},
-		ReservedInstances:   []model.ReservedInstanceJSON{},
-		UnusedLoadBalancers: []model.LoadBalancerJSON{},
-		UnusedAMIs:          []model.AMIJSON{},
-		OrphanedSnapshots:   []model.SnapshotJSON{},
-		StaleSnapshots:      []model.SnapshotJSON{},
-	}
-
-	// Unused Elastic IPs
-	for _, ip := range elasticIPs {
-		output.UnusedElasticIPs = append(output.UnusedElasticIPs, model.ElasticIPJSON{
+func OutputWasteJSON(w io.Writer, accountID string, report model.WasteReport) error {
+	return printJSON(w, BuildWasteReportJSON(accountID, report))
+}
+
+// BuildWasteReportJSON converts a waste report into its serializable form
+func BuildWasteReportJSON(accountID string, report model.WasteReport) model.WasteReportJSON {
+	orphanedSnapshots, staleSnapshots := snapshotsToJSON(report.Snapshots)
+
+	return model.WasteReportJSON{
+		SchemaVersion:                 model.JSONSchemaVersion,
+		AccountID:                     accountID,
+		GeneratedAt:                   time.Now().UTC().Format(time.RFC3339),
+		HasWaste:                      report.HasWaste(),
+		UnusedElasticIPs:              elasticIPsToJSON(report.ElasticIPs),
+		UnusedEBSVolumes:              volumesToJSON(report.UnusedVolumes, "available"),
+		StoppedVolumes:                volumesToJSON(report.StoppedVolumes, "attached_to_stopped"),
+		StoppedInstances:              stoppedInstancesToJSON(report.StoppedInstances),
+		ReservedInstances:             reservedInstancesToJSON(report.ReservedInstances),
+		CapacityReservations:          capacityReservationsToJSON(report.CapacityReservations),
+		DedicatedHosts:                dedicatedHostsToJSON(report.DedicatedHosts),
+		UnusedLoadBalancers:           loadBalancersToJSON(report.LoadBalancers),
+		UnusedClassicLBs:              classicLoadBalancersToJSON(report.ClassicLoadBalancers),
+		IdleEKSClusters:               eksClustersToJSON(report.EKSClusters),
+		IdleECSServices:               ecsServicesToJSON(report.ECSServices),
+		IdleSageMakerEndpoints:        sageMakerEndpointsToJSON(report.SageMakerEndpoints),
+		LongRunningSageMakerNotebooks: sageMakerNotebooksToJSON(report.SageMakerNotebooks),
+		IdleRedshiftClusters:          redshiftClustersToJSON(report.RedshiftClusters),
+		StaleRedshiftSnapshots:        redshiftSnapshotsToJSON(report.RedshiftSnapshots),
+		EFSFileSystems:                efsFileSystemsToJSON(report.EFSFileSystems),
+		BackupRecoveryPoints:          backupRecoveryPointsToJSON(report.BackupRecoveryPoints),
+		UnusedSecrets:                 secretsToJSON(report.Secrets),
+		UnusedKMSKeys:                 kmsKeysToJSON(report.KMSKeys),
+		Route53HostedZones:            route53HostedZonesToJSON(report.Route53HostedZones),
+		UnusedRoute53Checks:           route53HealthChecksToJSON(report.Route53HealthChecks),
+		KinesisStreams:                kinesisStreamsToJSON(report.KinesisStreams),
+		IdleMSKClusters:               mskClustersToJSON(report.MSKClusters),
+		CloudTrailTrails:              cloudTrailTrailsToJSON(report.CloudTrailTrails),
+		UnusedAMIs:                    amisToJSON(report.UnusedAMIs),
+		OrphanedSnapshots:             orphanedSnapshots,
+		StaleSnapshots:                staleSnapshots,
+		SkippedChecks:                 skippedChecksToJSON(report.SkippedChecks),
+	}
+}
+
+func elasticIPsToJSON(ips []types.Address) []model.ElasticIPJSON {
+	result := []model.ElasticIPJSON{}
+
+	for _, ip := range ips {
+		result = append(result, model.ElasticIPJSON{
 			PublicIP:     aws.ToString(ip.PublicIp),
 			AllocationID: aws.ToString(ip.AllocationId),
 		})
 	}
 
-	// Unused EBS Volumes
-	for _, vol := range unusedVolumes {
-		output.UnusedEBSVolumes = append(output.UnusedEBSVolumes, model.EBSVolumeJSON{
-			VolumeID: aws.ToString(vol.VolumeId),
-			Size:     aws.ToInt32(vol.Size),
-			Status:   "available",
-		})
-	}
+	return result
+}
+
+func volumesToJSON(volumes []types.Volume, status string) []model.EBSVolumeJSON {
+	result := []model.EBSVolumeJSON{}
 
-	// EBS Volumes attached to stopped instances
-	for _, vol := range stoppedVolumes {
-		output.StoppedVolumes = append(output.StoppedVolumes, model.EBSVolumeJSON{
+	for _, vol := range volumes {
+		result = append(result, model.EBSVolumeJSON{
 			VolumeID: aws.ToString(vol.VolumeId),
 			Size:     aws.ToInt32(vol.Size),
-			Status:   "attached_to_stopped",
+			Status:   status,
 		})
 	}
 
-	// Stopped instances
+	return result
+}
+
+func stoppedInstancesToJSON(instances []types.Instance) []model.StoppedInstanceJSON {
+	result := []model.StoppedInstanceJSON{}
 	now := time.Now()
 
-	for _, instance := range stoppedInstances {
+	for _, instance := range instances {
 		si := model.StoppedInstanceJSON{
 			InstanceID: aws.ToString(instance.InstanceId),
 		}
@@ -124,12 +183,17 @@ func OutputWasteJSON(accountID string, elasticIPs []types.Address, unusedVolumes
 			}
 		}
 
-		output.StoppedInstances = append(output.StoppedInstances, si)
+		result = append(result, si)
 	}
 
-	// Reserved instances
-	for _, ri := range ris {
-		output.ReservedInstances = append(output.ReservedInstances, model.ReservedInstanceJSON{
+	return result
+}
+
+func reservedInstancesToJSON(reservedInstances []model.RiExpirationInfo) []model.ReservedInstanceJSON {
+	result := []model.ReservedInstanceJSON{}
+
+	for _, ri := range reservedInstances {
+		result = append(result, model.ReservedInstanceJSON{
 			ReservedInstanceID: ri.ReservedInstanceID,
 			InstanceType:       ri.InstanceType,
 			ExpirationDate:     ri.ExpirationDate.Format(time.RFC3339),
@@ -139,33 +203,394 @@ func OutputWasteJSON(accountID string, elasticIPs []types.Address, unusedVolumes
 		})
 	}
 
-	// Unused load balancers
+	return result
+}
+
+func capacityReservationsToJSON(reservations []model.CapacityReservationWasteInfo) []model.CapacityReservationJSON {
+	result := []model.CapacityReservationJSON{}
+
+	for _, cr := range reservations {
+		result = append(result, model.CapacityReservationJSON{
+			CapacityReservationID:  cr.CapacityReservationID,
+			InstanceType:           cr.InstanceType,
+			InstancePlatform:       cr.InstancePlatform,
+			AvailabilityZone:       cr.AvailabilityZone,
+			TotalInstanceCount:     cr.TotalInstanceCount,
+			AvailableInstanceCount: cr.AvailableInstanceCount,
+			UtilizationPercent:     cr.UtilizationPercent,
+			State:                  cr.State,
+			Status:                 cr.Status,
+		})
+	}
+
+	return result
+}
+
+func dedicatedHostsToJSON(hosts []model.DedicatedHostWasteInfo) []model.DedicatedHostJSON {
+	result := []model.DedicatedHostJSON{}
+
+	for _, host := range hosts {
+		hostJSON := model.DedicatedHostJSON{
+			HostID:              host.HostID,
+			InstanceFamily:      host.InstanceFamily,
+			InstanceType:        host.InstanceType,
+			AvailabilityZone:    host.AvailabilityZone,
+			State:               host.State,
+			DaysSinceAllocation: host.DaysSinceAllocation,
+		}
+		if !host.AllocationTime.IsZero() {
+			hostJSON.AllocationTime = host.AllocationTime.Format(time.RFC3339)
+		}
+
+		result = append(result, hostJSON)
+	}
+
+	return result
+}
+
+func loadBalancersToJSON(loadBalancers []elbtypes.LoadBalancer) []model.LoadBalancerJSON {
+	result := []model.LoadBalancerJSON{}
+
 	for _, lb := range loadBalancers {
-		output.UnusedLoadBalancers = append(output.UnusedLoadBalancers, model.LoadBalancerJSON{
+		result = append(result, model.LoadBalancerJSON{
 			Name: aws.ToString(lb.LoadBalancerName),
 			ARN:  aws.ToString(lb.LoadBalancerArn),
 			Type: string(lb.Type),
 		})
 	}
 
-	// Unused AMIs
-	for _, ami := range unusedAMIs {
-		output.UnusedAMIs = append(output.UnusedAMIs, model.AMIJSON{
+	return result
+}
+
+func classicLoadBalancersToJSON(loadBalancers []model.ClassicLoadBalancerWasteInfo) []model.ClassicLoadBalancerJSON {
+	result := []model.ClassicLoadBalancerJSON{}
+
+	for _, lb := range loadBalancers {
+		result = append(result, model.ClassicLoadBalancerJSON{
+			Name:          lb.Name,
+			DNSName:       lb.DNSName,
+			InstanceCount: lb.InstanceCount,
+			Status:        lb.Status,
+		})
+	}
+
+	return result
+}
+
+func eksClustersToJSON(clusters []model.EKSClusterWasteInfo) []model.EKSClusterJSON {
+	result := []model.EKSClusterJSON{}
+
+	for _, cluster := range clusters {
+		result = append(result, model.EKSClusterJSON{
+			ClusterName:          cluster.ClusterName,
+			KubernetesVersion:    cluster.KubernetesVersion,
+			NodeGroupCount:       cluster.NodeGroupCount,
+			Status:               cluster.Status,
+			ExtendedSupport:      cluster.ExtendedSupport,
+			EstimatedMonthlyCost: cluster.EstimatedMonthlyCost,
+		})
+	}
+
+	return result
+}
+
+func ecsServicesToJSON(services []model.ECSServiceWasteInfo) []model.ECSServiceJSON {
+	result := []model.ECSServiceJSON{}
+
+	for _, svc := range services {
+		result = append(result, model.ECSServiceJSON{
+			ClusterName:          svc.ClusterName,
+			ServiceName:          svc.ServiceName,
+			TargetGroupArns:      append([]string{}, svc.TargetGroupArns...),
+			LoadBalancerArns:     append([]string{}, svc.LoadBalancerArns...),
+			EstimatedMonthlyCost: svc.EstimatedMonthlyCost,
+		})
+	}
+
+	return result
+}
+
+func sageMakerEndpointsToJSON(endpoints []model.SageMakerEndpointWasteInfo) []model.SageMakerEndpointJSON {
+	result := []model.SageMakerEndpointJSON{}
+
+	for _, endpoint := range endpoints {
+		result = append(result, model.SageMakerEndpointJSON{
+			EndpointName:  endpoint.EndpointName,
+			VariantName:   endpoint.VariantName,
+			InstanceType:  endpoint.InstanceType,
+			InstanceCount: endpoint.InstanceCount,
+			HourlyCost:    endpoint.HourlyCost,
+			IdleDays:      endpoint.IdleDays,
+		})
+	}
+
+	return result
+}
+
+func sageMakerNotebooksToJSON(notebooks []model.SageMakerNotebookWasteInfo) []model.SageMakerNotebookJSON {
+	result := []model.SageMakerNotebookJSON{}
+
+	for _, notebook := range notebooks {
+		result = append(result, model.SageMakerNotebookJSON{
+			NotebookInstanceName: notebook.NotebookInstanceName,
+			InstanceType:         notebook.InstanceType,
+			LastModifiedTime:     notebook.LastModifiedTime.Format(time.RFC3339),
+			DaysSinceModified:    notebook.DaysSinceModified,
+			HourlyCost:           notebook.HourlyCost,
+		})
+	}
+
+	return result
+}
+
+func redshiftClustersToJSON(clusters []model.RedshiftClusterWasteInfo) []model.RedshiftClusterJSON {
+	result := []model.RedshiftClusterJSON{}
+
+	for _, cluster := range clusters {
+		result = append(result, model.RedshiftClusterJSON{
+			ClusterIdentifier:    cluster.ClusterIdentifier,
+			NodeType:             cluster.NodeType,
+			NodeCount:            cluster.NodeCount,
+			Status:               cluster.Status,
+			MaxConnections:       cluster.MaxConnections,
+			AverageCPUPercent:    cluster.AverageCPUPercent,
+			ObservationDays:      cluster.ObservationDays,
+			Recommendation:       cluster.Recommendation,
+			EstimatedMonthlyCost: cluster.EstimatedMonthlyCost,
+		})
+	}
+
+	return result
+}
+
+func redshiftSnapshotsToJSON(snapshots []model.RedshiftSnapshotWasteInfo) []model.RedshiftSnapshotJSON {
+	result := []model.RedshiftSnapshotJSON{}
+
+	for _, snapshot := range snapshots {
+		result = append(result, model.RedshiftSnapshotJSON{
+			SnapshotIdentifier:   snapshot.SnapshotIdentifier,
+			ClusterIdentifier:    snapshot.ClusterIdentifier,
+			NodeType:             snapshot.NodeType,
+			NodeCount:            snapshot.NodeCount,
+			SizeGB:               snapshot.SizeGB,
+			CreateTime:           snapshot.CreateTime.Format(time.RFC3339),
+			DaysSinceCreate:      snapshot.DaysSinceCreate,
+			EstimatedMonthlyCost: snapshot.EstimatedMonthlyCost,
+		})
+	}
+
+	return result
+}
+
+func efsFileSystemsToJSON(fileSystems []model.EFSFileSystemWasteInfo) []model.EFSFileSystemJSON {
+	result := []model.EFSFileSystemJSON{}
+
+	for _, fileSystem := range fileSystems {
+		result = append(result, model.EFSFileSystemJSON{
+			FileSystemID:         fileSystem.FileSystemID,
+			Name:                 fileSystem.Name,
+			MountTargetCount:     fileSystem.MountTargetCount,
+			StandardSizeGB:       fileSystem.StandardSizeGB,
+			TotalSizeGB:          fileSystem.TotalSizeGB,
+			Status:               fileSystem.Status,
+			HasIALifecycle:       fileSystem.HasIALifecycle,
+			EstimatedMonthlyCost: fileSystem.EstimatedMonthlyCost,
+			PotentialIASavings:   fileSystem.PotentialIASavings,
+		})
+	}
+
+	return result
+}
+
+func backupRecoveryPointsToJSON(recoveryPoints []model.BackupRecoveryPointWasteInfo) []model.BackupRecoveryPointJSON {
+	result := []model.BackupRecoveryPointJSON{}
+
+	for _, recoveryPoint := range recoveryPoints {
+		result = append(result, model.BackupRecoveryPointJSON{
+			RecoveryPointArn:     recoveryPoint.RecoveryPointArn,
+			BackupVaultName:      recoveryPoint.BackupVaultName,
+			ResourceArn:          recoveryPoint.ResourceArn,
+			ResourceType:         recoveryPoint.ResourceType,
+			ResourceName:         recoveryPoint.ResourceName,
+			CreationDate:         recoveryPoint.CreationDate.Format(time.RFC3339),
+			DaysSinceCreate:      recoveryPoint.DaysSinceCreate,
+			SizeGB:               recoveryPoint.SizeGB,
+			Status:               recoveryPoint.Status,
+			HasDeleteLifecycle:   recoveryPoint.HasDeleteLifecycle,
+			EstimatedMonthlyCost: recoveryPoint.EstimatedMonthlyCost,
+		})
+	}
+
+	return result
+}
+
+func secretsToJSON(secrets []model.SecretWasteInfo) []model.SecretJSON {
+	result := []model.SecretJSON{}
+
+	for _, secret := range secrets {
+		secretJSON := model.SecretJSON{
+			Name:            secret.Name,
+			ARN:             secret.ARN,
+			DaysSinceAccess: secret.DaysSinceAccess,
+			OwningService:   secret.OwningService,
+			MonthlyCost:     secret.MonthlyCost,
+		}
+		if !secret.LastAccessedDate.IsZero() {
+			secretJSON.LastAccessedDate = secret.LastAccessedDate.Format(time.RFC3339)
+		}
+
+		result = append(result, secretJSON)
+	}
+
+	return result
+}
+
+func kmsKeysToJSON(keys []model.KMSKeyWasteInfo) []model.KMSKeyJSON {
+	result := []model.KMSKeyJSON{}
+
+	for _, key := range keys {
+		result = append(result, model.KMSKeyJSON{
+			KeyID:        key.KeyID,
+			ARN:          key.ARN,
+			Description:  key.Description,
+			KeyState:     key.KeyState,
+			Status:       key.Status,
+			CreationDate: key.CreationDate.Format(time.RFC3339),
+			MonthlyCost:  key.MonthlyCost,
+		})
+	}
+
+	return result
+}
+
+func route53HostedZonesToJSON(zones []model.Route53HostedZoneWasteInfo) []model.Route53HostedZoneJSON {
+	result := []model.Route53HostedZoneJSON{}
+
+	for _, zone := range zones {
+		zoneJSON := model.Route53HostedZoneJSON{
+			ZoneID:      zone.ZoneID,
+			Name:        zone.Name,
+			PrivateZone: zone.PrivateZone,
+			RecordCount: zone.RecordCount,
+			Status:      zone.Status,
+			MonthlyCost: zone.MonthlyCost,
+		}
+
+		for _, record := range zone.DanglingRecords {
+			zoneJSON.DanglingRecords = append(zoneJSON.DanglingRecords, model.Route53DanglingRecordJSON{
+				Name:   record.Name,
+				Type:   record.Type,
+				Target: record.Target,
+				Status: record.Status,
+			})
+		}
+
+		result = append(result, zoneJSON)
+	}
+
+	return result
+}
+
+func route53HealthChecksToJSON(healthChecks []model.Route53HealthCheckWasteInfo) []model.Route53HealthCheckJSON {
+	result := []model.Route53HealthCheckJSON{}
+
+	for _, healthCheck := range healthChecks {
+		result = append(result, model.Route53HealthCheckJSON{
+			HealthCheckID: healthCheck.HealthCheckID,
+			Type:          healthCheck.Type,
+			Endpoint:      healthCheck.Endpoint,
+			MonthlyCost:   healthCheck.MonthlyCost,
+		})
+	}
+
+	return result
+}
+
+func kinesisStreamsToJSON(streams []model.KinesisStreamWasteInfo) []model.KinesisStreamJSON {
+	result := []model.KinesisStreamJSON{}
+
+	for _, stream := range streams {
+		result = append(result, model.KinesisStreamJSON{
+			StreamName:                stream.StreamName,
+			StreamMode:                stream.StreamMode,
+			ShardCount:                stream.ShardCount,
+			Status:                    stream.Status,
+			PeakIncomingBytesPerSec:   stream.PeakIncomingBytesPerSec,
+			PeakIncomingRecordsPerSec: stream.PeakIncomingRecordsPerSec,
+			SuggestedShardCount:       stream.SuggestedShardCount,
+			Recommendation:            stream.Recommendation,
+			ObservationDays:           stream.ObservationDays,
+			HourlyCost:                stream.HourlyCost,
+		})
+	}
+
+	return result
+}
+
+func mskClustersToJSON(clusters []model.MSKClusterWasteInfo) []model.MSKClusterJSON {
+	result := []model.MSKClusterJSON{}
+
+	for _, cluster := range clusters {
+		result = append(result, model.MSKClusterJSON{
+			ClusterName:          cluster.ClusterName,
+			ClusterArn:           cluster.ClusterArn,
+			InstanceType:         cluster.InstanceType,
+			BrokerCount:          cluster.BrokerCount,
+			AverageBytesInPerSec: cluster.AverageBytesInPerSec,
+			ObservationDays:      cluster.ObservationDays,
+			HourlyCost:           cluster.HourlyCost,
+		})
+	}
+
+	return result
+}
+
+func cloudTrailTrailsToJSON(trails []model.CloudTrailWasteInfo) []model.CloudTrailJSON {
+	result := []model.CloudTrailJSON{}
+
+	for _, trail := range trails {
+		result = append(result, model.CloudTrailJSON{
+			TrailName:              trail.TrailName,
+			TrailARN:               trail.TrailARN,
+			HomeRegion:             trail.HomeRegion,
+			IsMultiRegionTrail:     trail.IsMultiRegionTrail,
+			IsOrganizationTrail:    trail.IsOrganizationTrail,
+			Status:                 trail.Status,
+			DuplicateOf:            trail.DuplicateOf,
+			EstimatedMonthlyEvents: trail.EstimatedMonthlyEvents,
+			EstimatedMonthlyCost:   trail.EstimatedMonthlyCost,
+		})
+	}
+
+	return result
+}
+
+func amisToJSON(amis []model.AMIWasteInfo) []model.AMIJSON {
+	result := []model.AMIJSON{}
+
+	for _, ami := range amis {
+		result = append(result, model.AMIJSON{
 			ImageID:            ami.ImageID,
 			Name:               ami.Name,
 			Description:        ami.Description,
 			CreationDate:       ami.CreationDate.Format(time.RFC3339),
 			DaysSinceCreate:    ami.DaysSinceCreate,
 			IsPublic:           ami.IsPublic,
-			SnapshotIDs:        ami.SnapshotIDs,
+			SnapshotIDs:        append([]string{}, ami.SnapshotIDs...),
 			SnapshotSizeGB:     ami.SnapshotSizeGB,
 			MaxPotentialSaving: ami.MaxPotentialSaving,
 			SafetyWarning:      ami.SafetyWarning,
 		})
 	}
 
-	// Snapshots (split by category: orphaned vs stale)
-	for _, snap := range orphanedSnapshots {
+	return result
+}
+
+// snapshotsToJSON splits snapshots into orphaned and stale ones
+func snapshotsToJSON(snapshots []model.SnapshotWasteInfo) (orphaned, stale []model.SnapshotJSON) {
+	orphaned, stale = []model.SnapshotJSON{}, []model.SnapshotJSON{}
+
+	for _, snap := range snapshots {
 		snapshotJSON := model.SnapshotJSON{
 			SnapshotID:          snap.SnapshotID,
 			VolumeID:            snap.VolumeID,
@@ -179,34 +604,160 @@ func OutputWasteJSON(accountID string, elasticIPs []types.Address, unusedVolumes
 			Category:            string(snap.Category),
 			Reason:              snap.Reason,
 			MaxPotentialSavings: snap.MaxPotentialSavings,
+			ManagedBy:           snap.ManagedBy,
 		}
 		if snap.Category == model.SnapshotCategoryOrphaned {
-			output.OrphanedSnapshots = append(output.OrphanedSnapshots, snapshotJSON)
+			orphaned = append(orphaned, snapshotJSON)
 		} else {
-			output.StaleSnapshots = append(output.StaleSnapshots, snapshotJSON)
+			stale = append(stale, snapshotJSON)
 		}
 	}
 
-	output.HasWaste = len(output.UnusedElasticIPs) > 0 ||
-		len(output.UnusedEBSVolumes) > 0 ||
-		len(output.StoppedVolumes) > 0 ||
-		len(output.StoppedInstances) > 0 ||
-		len(output.ReservedInstances) > 0 ||
-		len(output.UnusedLoadBalancers) > 0 ||
-		len(output.UnusedAMIs) > 0 ||
-		len(output.OrphanedSnapshots) > 0 ||
-		len(output.StaleSnapshots) > 0
+	return orphaned, stale
+}
+
+func skippedChecksToJSON(checks []model.SkippedCheck) []model.SkippedCheckJSON {
+	result := []model.SkippedCheckJSON{}
+
+	for _, check := range checks {
+		result = append(result, model.SkippedCheckJSON{
+			Name:       check.Name,
+			Reason:     check.Reason,
+			Categories: append([]string{}, check.Categories...),
+		})
+	}
+
+	return result
+}
+
+// OutputCommitmentsJSON outputs Reserved Instance and Savings Plans report data as JSON
+func OutputCommitmentsJSON(w io.Writer, accountID string, report model.CommitmentReport) error {
+	output := model.CommitmentReportJSON{
+		SchemaVersion:             model.JSONSchemaVersion,
+		AccountID:                 accountID,
+		GeneratedAt:               time.Now().UTC().Format(time.RFC3339),
+		LookbackDays:              report.LookbackDays,
+		ReservedInstances:         commitmentSummaryToJSON(report.Reservations),
+		SavingsPlans:              commitmentSummaryToJSON(report.SavingsPlans),
+		UnderutilizedReservations: commitmentsToJSON(report.UnderutilizedReservations),
+		UnderutilizedSavingsPlans: commitmentsToJSON(report.UnderutilizedSavingsPlans),
+		ExpiringSavingsPlans:      commitmentsToJSON(report.ExpiringSavingsPlans),
+	}
+
+	return printJSON(w, output)
+}
+
+func commitmentSummaryToJSON(summary *model.CommitmentSummaryInfo) *model.CommitmentSummaryJSON {
+	if summary == nil {
+		return nil
+	}
+
+	return &model.CommitmentSummaryJSON{
+		UtilizationPercent:    summary.UtilizationPercent,
+		UnusedHours:           summary.UnusedHours,
+		UnusedCommitment:      summary.UnusedCommitment,
+		CoveragePercent:       summary.CoveragePercent,
+		UncoveredOnDemandCost: summary.OnDemandCost,
+	}
+}
+
+func commitmentsToJSON(commitments []model.CommitmentUtilizationInfo) []model.CommitmentJSON {
+	result := []model.CommitmentJSON{}
+
+	for _, c := range commitments {
+		commitmentJSON := model.CommitmentJSON{
+			Type:               string(c.CommitmentType),
+			ID:                 c.ID,
+			Description:        c.Description,
+			UtilizationPercent: c.UtilizationPercent,
+			UnusedHours:        c.UnusedHours,
+			UnusedCommitment:   c.UnusedCommitment,
+		}
+		if !c.EndDate.IsZero() {
+			commitmentJSON.EndDate = c.EndDate.Format(time.RFC3339)
+			commitmentJSON.DaysUntilExpiry = c.DaysUntilExpiry
+		}
+
+		result = append(result, commitmentJSON)
+	}
+
+	return result
+}
+
+// OutputRecommendationsJSON outputs Savings Plans and Reserved Instance purchase recommendations as JSON
+func OutputRecommendationsJSON(w io.Writer, accountID string, report model.RecommendationReport) error {
+	output := model.RecommendationReportJSON{
+		SchemaVersion:       model.JSONSchemaVersion,
+		AccountID:           accountID,
+		GeneratedAt:         time.Now().UTC().Format(time.RFC3339),
+		TermInYears:         report.Options.TermInYears,
+		PaymentOption:       report.Options.PaymentOption,
+		LookbackDays:        report.Options.LookbackDays,
+		TotalMonthlySavings: report.TotalMonthlySavings(),
+		SavingsPlans:        recommendationsToJSON(report.SavingsPlans),
+		ReservedInstances:   recommendationsToJSON(report.ReservedInstances),
+	}
+
+	return printJSON(w, output)
+}
+
+func recommendationsToJSON(recommendations []model.PurchaseRecommendationInfo) []model.PurchaseRecommendationJSON {
+	result := []model.PurchaseRecommendationJSON{}
+
+	for _, r := range recommendations {
+		result = append(result, model.PurchaseRecommendationJSON{
+			Type:                    string(r.CommitmentType),
+			Service:                 r.Service,
+			Description:             r.Description,
+			HourlyCommitment:        r.HourlyCommitment,
+			InstanceCount:           r.InstanceCount,
+			UpfrontCost:             r.UpfrontCost,
+			EstimatedMonthlySavings: r.EstimatedMonthlySavings,
+			EstimatedSavingsPercent: r.EstimatedSavingsPercent,
+			BreakEvenMonths:         r.BreakEvenMonths,
+			Currency:                r.Currency,
+		})
+	}
+
+	return result
+}
+
+// OutputRightsizingJSON outputs EC2, EBS and Lambda rightsizing recommendations as JSON
+func OutputRightsizingJSON(w io.Writer, accountID string, report model.RightsizingReport) error {
+	output := model.RightsizingReportJSON{
+		SchemaVersion:           model.JSONSchemaVersion,
+		AccountID:               accountID,
+		GeneratedAt:             time.Now().UTC().Format(time.RFC3339),
+		ComputeOptimizerEnabled: report.ComputeOptimizerEnabled,
+		TotalMonthlySavings:     report.TotalMonthlySavings(),
+		Recommendations:         []model.RightsizingRecommendationJSON{},
+	}
+
+	for _, r := range report.Recommendations {
+		output.Recommendations = append(output.Recommendations, model.RightsizingRecommendationJSON{
+			Source:                     string(r.Source),
+			ResourceType:               r.ResourceType,
+			ResourceID:                 r.ResourceID,
+			Finding:                    r.Finding,
+			CurrentConfiguration:       r.CurrentConfiguration,
+			RecommendedConfiguration:   r.RecommendedConfiguration,
+			ProjectedCPUUtilization:    r.ProjectedCPUUtilization,
+			ProjectedMemoryUtilization: r.ProjectedMemoryUtilization,
+			EstimatedMonthlySavings:    r.EstimatedMonthlySavings,
+			Currency:                   r.Currency,
+		})
+	}
 
-	return printJSON(output)
+	return printJSON(w, output)
 }
 
-func printJSON(v interface{}) error {
+func printJSON(w io.Writer, v interface{}) error {
 	data, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(string(data))
+	_, err = fmt.Fprintln(w, string(data))
 
-	return nil
+	return err
 }
@@ -66,7 +66,7 @@ func TestPrintJSON(t *testing.T) {
 			var err error
 
 			output := captureStdout(func() {
-				err = printJSON(tt.input)
+				err = printJSON(os.Stdout, tt.input)
 			})
 
 			if (err != nil) != tt.wantErr {
@@ -107,7 +107,7 @@ func TestOutputCostComparisonJSON(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputCostComparisonJSON("123456789012", 150.0, 165.0, lastMonth, currentMonth)
+		err = OutputCostComparisonJSON(os.Stdout, "123456789012", 150.0, 165.0, lastMonth, currentMonth)
 	})
 
 	if err != nil {
@@ -135,9 +135,49 @@ func TestOutputCostComparisonJSON(t *testing.T) {
 		t.Errorf("LastMonth.Total = %v, want 150.0", result.LastMonth.Total)
 	}
 
-	// Verify service breakdown exists
+	if result.SchemaVersion != model.JSONSchemaVersion {
+		t.Errorf("SchemaVersion = %q, want %q", result.SchemaVersion, model.JSONSchemaVersion)
+	}
+
+	// Verify service breakdown is ordered by current month cost, highest first
 	if len(result.ServiceBreakdown) != 2 {
-		t.Errorf("ServiceBreakdown has %d items, want 2", len(result.ServiceBreakdown))
+		t.Fatalf("ServiceBreakdown has %d items, want 2", len(result.ServiceBreakdown))
+	}
+
+	if result.ServiceBreakdown[0].Service != "Amazon EC2" || result.ServiceBreakdown[1].Service != "Amazon S3" {
+		t.Errorf("ServiceBreakdown order = %s, %s; want Amazon EC2, Amazon S3", result.ServiceBreakdown[0].Service, result.ServiceBreakdown[1].Service)
+	}
+}
+
+func TestBuildCostComparisonJSON_StableOrder(t *testing.T) {
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{}}
+	currentMonth := &model.CostInfo{
+		CostGroup: model.CostGroup{
+			"AWS Lambda":      {Amount: 5.0, Unit: "USD"},
+			"Amazon S3":       {Amount: 45.0, Unit: "USD"},
+			"Amazon EC2":      {Amount: 120.0, Unit: "USD"},
+			"Amazon SQS":      {Amount: 5.0, Unit: "USD"},
+			"Amazon RDS":      {Amount: 80.0, Unit: "USD"},
+			"AWS Backup":      {Amount: 5.0, Unit: "USD"},
+			"Amazon DynamoDB": {Amount: 0.0, Unit: "USD"},
+		},
+	}
+
+	// Ties on cost are broken by service name
+	want := "Amazon EC2,Amazon RDS,Amazon S3,AWS Backup,AWS Lambda,Amazon SQS,Amazon DynamoDB"
+
+	// Map iteration order is randomized, so build the breakdown several times
+	for range 10 {
+		breakdown := BuildCostComparisonJSON("123456789012", 0, 0, lastMonth, currentMonth).ServiceBreakdown
+
+		got := make([]string, len(breakdown))
+		for i, service := range breakdown {
+			got[i] = service.Service
+		}
+
+		if strings.Join(got, ",") != want {
+			t.Fatalf("ServiceBreakdown order = %v, want %s", got, want)
+		}
 	}
 }
 
@@ -157,7 +197,7 @@ func TestOutputTrendJSON(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputTrendJSON("123456789012", costInfo)
+		err = OutputTrendJSON(os.Stdout, "123456789012", costInfo)
 	})
 
 	if err != nil {
@@ -193,7 +233,7 @@ func TestOutputTrendJSON_SkipsNonTotal(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputTrendJSON("123456789012", costInfo)
+		err = OutputTrendJSON(os.Stdout, "123456789012", costInfo)
 	})
 
 	if err != nil {
@@ -253,7 +293,70 @@ func TestOutputWasteJSON(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputWasteJSON("123456789012", elasticIPs, unusedVolumes, stoppedVolumes, ris, stoppedInstances, loadBalancers, nil, nil)
+		err = OutputWasteJSON(os.Stdout, "123456789012", model.WasteReport{
+			ElasticIPs:        elasticIPs,
+			UnusedVolumes:     unusedVolumes,
+			StoppedVolumes:    stoppedVolumes,
+			ReservedInstances: ris,
+			StoppedInstances:  stoppedInstances,
+			LoadBalancers:     loadBalancers,
+			EKSClusters: []model.EKSClusterWasteInfo{
+				{ClusterName: "idle", KubernetesVersion: "1.29", Status: model.EKSClusterNoNodeGroups, EstimatedMonthlyCost: 73},
+			},
+			ECSServices: []model.ECSServiceWasteInfo{
+				{ClusterName: "prod", ServiceName: "worker", TargetGroupArns: []string{"tg"}},
+			},
+			ClassicLoadBalancers: []model.ClassicLoadBalancerWasteInfo{
+				{Name: "legacy", DNSName: "legacy.elb.amazonaws.com", Status: model.ClassicLoadBalancerNoInstances},
+			},
+			SageMakerEndpoints: []model.SageMakerEndpointWasteInfo{
+				{EndpointName: "churn", VariantName: "AllTraffic", InstanceType: "ml.m5.xlarge", InstanceCount: 2, HourlyCost: 0.46, IdleDays: 14},
+			},
+			SageMakerNotebooks: []model.SageMakerNotebookWasteInfo{
+				{NotebookInstanceName: "research", InstanceType: "ml.t3.medium", DaysSinceModified: 40, HourlyCost: 0.05},
+			},
+			RedshiftClusters: []model.RedshiftClusterWasteInfo{
+				{ClusterIdentifier: "analytics", NodeType: "dc2.large", NodeCount: 2, Status: model.RedshiftClusterNoConnections, EstimatedMonthlyCost: 365},
+			},
+			RedshiftSnapshots: []model.RedshiftSnapshotWasteInfo{
+				{SnapshotIdentifier: "before-migration", SizeGB: 500, DaysSinceCreate: 120},
+			},
+			EFSFileSystems: []model.EFSFileSystemWasteInfo{
+				{FileSystemID: "fs-1", Status: model.EFSFileSystemNoIALifecycle, StandardSizeGB: 500, PotentialIASavings: 142},
+			},
+			BackupRecoveryPoints: []model.BackupRecoveryPointWasteInfo{
+				{ResourceArn: "arn:aws:ec2:us-east-1:123456789012:volume/vol-123", ResourceType: "EBS", Status: model.BackupRecoveryPointSourceDeleted, SizeGB: 200},
+			},
+			Snapshots: []model.SnapshotWasteInfo{
+				{SnapshotID: "snap-1", Category: model.SnapshotCategoryOrphaned, ManagedBy: model.SnapshotManagedByBackup},
+			},
+			Secrets: []model.SecretWasteInfo{
+				{Name: "forgotten", DaysSinceAccess: 95, MonthlyCost: 0.40},
+			},
+			KMSKeys: []model.KMSKeyWasteInfo{
+				{KeyID: "1234abcd", Status: model.KMSKeyDisabled, KeyState: "Disabled", MonthlyCost: 1},
+			},
+			Route53HostedZones: []model.Route53HostedZoneWasteInfo{
+				{
+					ZoneID:          "Z123",
+					Name:            "example.com",
+					Status:          model.Route53ZoneDanglingRecords,
+					DanglingRecords: []model.Route53DanglingRecord{{Name: "app.example.com", Type: "CNAME", Target: "old-alb.us-east-1.elb.amazonaws.com"}},
+				},
+			},
+			Route53HealthChecks: []model.Route53HealthCheckWasteInfo{
+				{HealthCheckID: "abcd-1234", Type: "HTTPS", MonthlyCost: 0.50},
+			},
+			KinesisStreams: []model.KinesisStreamWasteInfo{
+				{StreamName: "events", StreamMode: "PROVISIONED", ShardCount: 10, Status: model.KinesisStreamOverProvisioned, SuggestedShardCount: 1, HourlyCost: 0.15},
+			},
+			MSKClusters: []model.MSKClusterWasteInfo{
+				{ClusterName: "orders", InstanceType: "kafka.m5.large", BrokerCount: 3, HourlyCost: 0.63},
+			},
+			CloudTrailTrails: []model.CloudTrailWasteInfo{
+				{TrailName: "legacy", Status: model.CloudTrailDuplicateManagementEvents, DuplicateOf: "org", EstimatedMonthlyCost: 20},
+			},
+		})
 	})
 
 	if err != nil {
@@ -297,13 +400,82 @@ func TestOutputWasteJSON(t *testing.T) {
 	if len(result.UnusedLoadBalancers) != 1 {
 		t.Errorf("UnusedLoadBalancers has %d items, want 1", len(result.UnusedLoadBalancers))
 	}
+
+	if len(result.IdleEKSClusters) != 1 || result.IdleEKSClusters[0].EstimatedMonthlyCost != 73 {
+		t.Errorf("IdleEKSClusters = %+v, want one cluster costing 73", result.IdleEKSClusters)
+	}
+
+	if len(result.IdleECSServices) != 1 || result.IdleECSServices[0].ServiceName != "worker" {
+		t.Errorf("IdleECSServices = %+v, want one 'worker' service", result.IdleECSServices)
+	}
+
+	if len(result.UnusedClassicLBs) != 1 || result.UnusedClassicLBs[0].Status != "NO_INSTANCES" {
+		t.Errorf("UnusedClassicLBs = %+v, want one NO_INSTANCES load balancer", result.UnusedClassicLBs)
+	}
+
+	if len(result.IdleSageMakerEndpoints) != 1 || result.IdleSageMakerEndpoints[0].InstanceCount != 2 {
+		t.Errorf("IdleSageMakerEndpoints = %+v, want one endpoint with 2 instances", result.IdleSageMakerEndpoints)
+	}
+
+	if len(result.LongRunningSageMakerNotebooks) != 1 || result.LongRunningSageMakerNotebooks[0].NotebookInstanceName != "research" {
+		t.Errorf("LongRunningSageMakerNotebooks = %+v, want one 'research' notebook", result.LongRunningSageMakerNotebooks)
+	}
+
+	if len(result.IdleRedshiftClusters) != 1 || result.IdleRedshiftClusters[0].NodeCount != 2 || result.IdleRedshiftClusters[0].Status != "NO_CONNECTIONS" {
+		t.Errorf("IdleRedshiftClusters = %+v, want one NO_CONNECTIONS cluster with 2 nodes", result.IdleRedshiftClusters)
+	}
+
+	if len(result.StaleRedshiftSnapshots) != 1 || result.StaleRedshiftSnapshots[0].SizeGB != 500 {
+		t.Errorf("StaleRedshiftSnapshots = %+v, want one 500 GB snapshot", result.StaleRedshiftSnapshots)
+	}
+
+	if len(result.EFSFileSystems) != 1 || result.EFSFileSystems[0].Status != "NO_IA_LIFECYCLE" || result.EFSFileSystems[0].PotentialIASavings != 142 {
+		t.Errorf("EFSFileSystems = %+v, want one NO_IA_LIFECYCLE file system saving 142", result.EFSFileSystems)
+	}
+
+	if len(result.BackupRecoveryPoints) != 1 || result.BackupRecoveryPoints[0].Status != "SOURCE_DELETED" || result.BackupRecoveryPoints[0].SizeGB != 200 {
+		t.Errorf("BackupRecoveryPoints = %+v, want one 200 GB SOURCE_DELETED recovery point", result.BackupRecoveryPoints)
+	}
+
+	if len(result.OrphanedSnapshots) != 1 || result.OrphanedSnapshots[0].ManagedBy != "AWS Backup" {
+		t.Errorf("OrphanedSnapshots = %+v, want one snapshot managed by AWS Backup", result.OrphanedSnapshots)
+	}
+
+	if len(result.UnusedSecrets) != 1 || result.UnusedSecrets[0].LastAccessedDate != "" || result.UnusedSecrets[0].MonthlyCost != 0.40 {
+		t.Errorf("UnusedSecrets = %+v, want one never accessed secret costing 0.40", result.UnusedSecrets)
+	}
+
+	if len(result.UnusedKMSKeys) != 1 || result.UnusedKMSKeys[0].Status != "DISABLED" {
+		t.Errorf("UnusedKMSKeys = %+v, want one DISABLED key", result.UnusedKMSKeys)
+	}
+
+	if len(result.Route53HostedZones) != 1 || len(result.Route53HostedZones[0].DanglingRecords) != 1 ||
+		result.Route53HostedZones[0].DanglingRecords[0].Target != "old-alb.us-east-1.elb.amazonaws.com" {
+		t.Errorf("Route53HostedZones = %+v, want one zone with a dangling record", result.Route53HostedZones)
+	}
+
+	if len(result.UnusedRoute53Checks) != 1 || result.UnusedRoute53Checks[0].HealthCheckID != "abcd-1234" {
+		t.Errorf("UnusedRoute53Checks = %+v, want one health check", result.UnusedRoute53Checks)
+	}
+
+	if len(result.KinesisStreams) != 1 || result.KinesisStreams[0].SuggestedShardCount != 1 {
+		t.Errorf("KinesisStreams = %+v, want one stream with a suggested shard count of 1", result.KinesisStreams)
+	}
+
+	if len(result.IdleMSKClusters) != 1 || result.IdleMSKClusters[0].HourlyCost != 0.63 {
+		t.Errorf("IdleMSKClusters = %+v, want one cluster costing 0.63 per hour", result.IdleMSKClusters)
+	}
+
+	if len(result.CloudTrailTrails) != 1 || result.CloudTrailTrails[0].DuplicateOf != "org" {
+		t.Errorf("CloudTrailTrails = %+v, want one trail duplicating org", result.CloudTrailTrails)
+	}
 }
 
 func TestOutputWasteJSON_NoWaste(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputWasteJSON("123456789012", nil, nil, nil, nil, nil, nil, nil, nil)
+		err = OutputWasteJSON(os.Stdout, "123456789012", model.WasteReport{})
 	})
 
 	if err != nil {
@@ -331,7 +503,7 @@ func TestOutputWasteJSON_InstanceWithoutTransitionReason(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputWasteJSON("123456789012", nil, nil, nil, nil, stoppedInstances, nil, nil, nil)
+		err = OutputWasteJSON(os.Stdout, "123456789012", model.WasteReport{StoppedInstances: stoppedInstances})
 	})
 
 	if err != nil {
@@ -364,7 +536,7 @@ func TestOutputWasteJSON_InstanceWithInvalidTransitionReason(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputWasteJSON("123456789012", nil, nil, nil, nil, stoppedInstances, nil, nil, nil)
+		err = OutputWasteJSON(os.Stdout, "123456789012", model.WasteReport{StoppedInstances: stoppedInstances})
 	})
 
 	if err != nil {
@@ -382,6 +554,56 @@ func TestOutputWasteJSON_InstanceWithInvalidTransitionReason(t *testing.T) {
 	}
 }
 
+func TestOutputWasteJSON_WithCapacityWaste(t *testing.T) {
+	reservations := []model.CapacityReservationWasteInfo{
+		{
+			CapacityReservationID:  "cr-123",
+			InstanceType:           "m5.large",
+			TotalInstanceCount:     2,
+			AvailableInstanceCount: 2,
+			Status:                 "UNUSED",
+		},
+	}
+	hosts := []model.DedicatedHostWasteInfo{
+		{HostID: "h-123", InstanceFamily: "m5"},
+	}
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputWasteJSON(os.Stdout, "123456789012", model.WasteReport{
+			CapacityReservations: reservations,
+			DedicatedHosts:       hosts,
+		})
+	})
+
+	if err != nil {
+		t.Fatalf("OutputWasteJSON() error = %v", err)
+	}
+
+	var result model.WasteReportJSON
+	if jsonErr := json.Unmarshal([]byte(strings.TrimSpace(output)), &result); jsonErr != nil {
+		t.Fatalf("Failed to parse output JSON: %v", jsonErr)
+	}
+
+	if !result.HasWaste {
+		t.Error("HasWaste should be true when capacity waste exists")
+	}
+
+	if len(result.CapacityReservations) != 1 || result.CapacityReservations[0].Status != "UNUSED" {
+		t.Errorf("CapacityReservations = %+v, want one UNUSED reservation", result.CapacityReservations)
+	}
+
+	if len(result.DedicatedHosts) != 1 {
+		t.Fatalf("DedicatedHosts has %d items, want 1", len(result.DedicatedHosts))
+	}
+
+	// Unknown allocation time should be omitted rather than rendered as the zero time
+	if result.DedicatedHosts[0].AllocationTime != "" {
+		t.Errorf("DedicatedHosts[0].AllocationTime = %q, want empty", result.DedicatedHosts[0].AllocationTime)
+	}
+}
+
 func TestOutputWasteJSON_WithUnusedAMIs(t *testing.T) {
 	unusedAMIs := []model.AMIWasteInfo{
 		{
@@ -402,7 +624,7 @@ func TestOutputWasteJSON_WithUnusedAMIs(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputWasteJSON("123456789012", nil, nil, nil, nil, nil, nil, unusedAMIs, nil)
+		err = OutputWasteJSON(os.Stdout, "123456789012", model.WasteReport{UnusedAMIs: unusedAMIs})
 	})
 
 	if err != nil {
@@ -464,7 +686,7 @@ func TestOutputWasteJSON_AMIWithEmptySnapshots(t *testing.T) {
 	var err error
 
 	output := captureStdout(func() {
-		err = OutputWasteJSON("123456789012", nil, nil, nil, nil, nil, nil, unusedAMIs, nil)
+		err = OutputWasteJSON(os.Stdout, "123456789012", model.WasteReport{UnusedAMIs: unusedAMIs})
 	})
 
 	if err != nil {
@@ -490,6 +712,65 @@ func TestOutputWasteJSON_AMIWithEmptySnapshots(t *testing.T) {
 	}
 }
 
+func TestOutputCommitmentsJSON(t *testing.T) {
+	report := model.CommitmentReport{
+		LookbackDays: 30,
+		Reservations: &model.CommitmentSummaryInfo{
+			CommitmentType:     model.CommitmentTypeReservedInstance,
+			UtilizationPercent: 75,
+			UnusedCommitment:   20,
+			CoveragePercent:    40,
+			OnDemandCost:       300,
+		},
+		UnderutilizedReservations: []model.CommitmentUtilizationInfo{
+			{CommitmentType: model.CommitmentTypeReservedInstance, ID: "ri-123", UtilizationPercent: 50},
+		},
+		ExpiringSavingsPlans: []model.CommitmentUtilizationInfo{
+			{
+				CommitmentType:  model.CommitmentTypeSavingsPlan,
+				ID:              "sp-123",
+				EndDate:         time.Now().AddDate(0, 0, 10),
+				DaysUntilExpiry: 10,
+			},
+		},
+	}
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputCommitmentsJSON(os.Stdout, "123456789012", report)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputCommitmentsJSON() error = %v", err)
+	}
+
+	var result model.CommitmentReportJSON
+	if jsonErr := json.Unmarshal([]byte(strings.TrimSpace(output)), &result); jsonErr != nil {
+		t.Fatalf("Failed to parse output JSON: %v", jsonErr)
+	}
+
+	if result.ReservedInstances == nil || result.ReservedInstances.UncoveredOnDemandCost != 300 {
+		t.Errorf("ReservedInstances = %+v, want uncovered on-demand cost 300", result.ReservedInstances)
+	}
+
+	if result.SavingsPlans != nil {
+		t.Error("SavingsPlans should be null when the account has no Savings Plans")
+	}
+
+	if len(result.UnderutilizedReservations) != 1 || result.UnderutilizedReservations[0].Type != "reserved_instance" {
+		t.Errorf("UnderutilizedReservations = %+v, want one reserved_instance", result.UnderutilizedReservations)
+	}
+
+	if result.UnderutilizedSavingsPlans == nil {
+		t.Error("UnderutilizedSavingsPlans should be an empty array, not null")
+	}
+
+	if len(result.ExpiringSavingsPlans) != 1 || result.ExpiringSavingsPlans[0].DaysUntilExpiry != 10 {
+		t.Errorf("ExpiringSavingsPlans = %+v, want one plan expiring in 10 days", result.ExpiringSavingsPlans)
+	}
+}
+
 func BenchmarkOutputWasteJSON(b *testing.B) {
 	elasticIPs := make([]types.Address, 10)
 	for i := 0; i < 10; i++ {
@@ -508,6 +789,105 @@ func BenchmarkOutputWasteJSON(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		_ = OutputWasteJSON("123456789012", elasticIPs, nil, nil, nil, nil, nil, nil, nil)
+		_ = OutputWasteJSON(os.Stdout, "123456789012", model.WasteReport{ElasticIPs: elasticIPs})
+	}
+}
+
+func TestOutputRecommendationsJSON(t *testing.T) {
+	report := model.RecommendationReport{
+		Options: model.RecommendationOptions{TermInYears: 1, PaymentOption: model.PaymentOptionPartialUpfront, LookbackDays: 30},
+		SavingsPlans: []model.PurchaseRecommendationInfo{
+			{
+				CommitmentType:          model.CommitmentTypeSavingsPlan,
+				Service:                 "Compute Savings Plan",
+				HourlyCommitment:        1.5,
+				UpfrontCost:             600,
+				EstimatedMonthlySavings: 200,
+				BreakEvenMonths:         3,
+			},
+		},
+		ReservedInstances: []model.PurchaseRecommendationInfo{
+			{
+				CommitmentType:          model.CommitmentTypeReservedInstance,
+				Service:                 "Amazon Relational Database Service",
+				InstanceCount:           2,
+				EstimatedMonthlySavings: 50,
+			},
+		},
+	}
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputRecommendationsJSON(os.Stdout, "123456789012", report)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputRecommendationsJSON() error = %v", err)
+	}
+
+	var result model.RecommendationReportJSON
+	if jsonErr := json.Unmarshal([]byte(strings.TrimSpace(output)), &result); jsonErr != nil {
+		t.Fatalf("Failed to parse output JSON: %v", jsonErr)
+	}
+
+	if result.PaymentOption != "partial-upfront" || result.TermInYears != 1 || result.LookbackDays != 30 {
+		t.Errorf("Options = %s/%d/%d, want partial-upfront/1/30", result.PaymentOption, result.TermInYears, result.LookbackDays)
+	}
+
+	if result.TotalMonthlySavings != 250 {
+		t.Errorf("TotalMonthlySavings = %v, want 250", result.TotalMonthlySavings)
+	}
+
+	if len(result.SavingsPlans) != 1 || result.SavingsPlans[0].BreakEvenMonths != 3 {
+		t.Errorf("SavingsPlans = %+v, want one plan with 3 months break-even", result.SavingsPlans)
+	}
+
+	if len(result.ReservedInstances) != 1 || result.ReservedInstances[0].InstanceCount != 2 {
+		t.Errorf("ReservedInstances = %+v, want one recommendation for 2 instances", result.ReservedInstances)
+	}
+}
+
+func TestOutputRightsizingJSON(t *testing.T) {
+	report := model.RightsizingReport{
+		Recommendations: []model.RightsizingRecommendationInfo{
+			{
+				Source:                   model.RightsizingSourceCostExplorer,
+				ResourceType:             model.RightsizingResourceEC2Instance,
+				ResourceID:               "i-0abc",
+				Finding:                  "Modify",
+				CurrentConfiguration:     "m5.2xlarge",
+				RecommendedConfiguration: "m5.xlarge",
+				ProjectedCPUUtilization:  55,
+				EstimatedMonthlySavings:  140,
+			},
+		},
+	}
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputRightsizingJSON(os.Stdout, "123456789012", report)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputRightsizingJSON() error = %v", err)
+	}
+
+	var result model.RightsizingReportJSON
+	if jsonErr := json.Unmarshal([]byte(strings.TrimSpace(output)), &result); jsonErr != nil {
+		t.Fatalf("Failed to parse output JSON: %v", jsonErr)
+	}
+
+	if result.ComputeOptimizerEnabled {
+		t.Error("ComputeOptimizerEnabled should be false")
+	}
+
+	if result.TotalMonthlySavings != 140 {
+		t.Errorf("TotalMonthlySavings = %v, want 140", result.TotalMonthlySavings)
+	}
+
+	if len(result.Recommendations) != 1 || result.Recommendations[0].Source != "cost_explorer" || result.Recommendations[0].RecommendedConfiguration != "m5.xlarge" {
+		t.Errorf("Recommendations = %+v, want one cost_explorer recommendation to m5.xlarge", result.Recommendations)
 	}
 }
@@ -0,0 +1,121 @@
+package utils //nolint:revive
+
+import (
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// jsonSchemaID is where the published copy of the schema (schema/report.schema.json) is served from
+const jsonSchemaID = "https://raw.githubusercontent.com/elC0mpa/aws-doctor/main/schema/report.schema.json"
+
+// jsonSchemaReports are the top-level documents produced by --output json, in the order they are listed in the schema
+var jsonSchemaReports = []struct {
+	Title string
+	Type  reflect.Type
+}{
+	{Title: "Cost comparison report", Type: reflect.TypeFor[model.CostComparisonJSON]()},
+	{Title: "Cost trend report", Type: reflect.TypeFor[model.TrendJSON]()},
+	{Title: "Waste report", Type: reflect.TypeFor[model.WasteReportJSON]()},
+	{Title: "Reserved Instance and Savings Plans report", Type: reflect.TypeFor[model.CommitmentReportJSON]()},
+	{Title: "Purchase recommendations report", Type: reflect.TypeFor[model.RecommendationReportJSON]()},
+	{Title: "Rightsizing report", Type: reflect.TypeFor[model.RightsizingReportJSON]()},
+}
+
+// OutputJSONSchema outputs the JSON Schema describing every JSON report
+func OutputJSONSchema(w io.Writer) error {
+	return printJSON(w, BuildJSONSchema())
+}
+
+// BuildJSONSchema generates a JSON Schema (draft 2020-12) document from the model.*JSON structs.
+// Each report is a definition under $defs and a document is valid if it matches any of them.
+func BuildJSONSchema() map[string]any {
+	builder := &jsonSchemaBuilder{defs: map[string]any{}}
+	reports := make([]any, 0, len(jsonSchemaReports))
+
+	for _, report := range jsonSchemaReports {
+		ref := builder.typeSchema(report.Type)
+		builder.defs[report.Type.Name()].(map[string]any)["title"] = report.Title
+		reports = append(reports, ref)
+	}
+
+	return map[string]any{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"$id":         jsonSchemaID,
+		"title":       "aws-doctor JSON reports",
+		"description": "Reports produced by aws-doctor --output json, format version " + model.JSONSchemaVersion,
+		"anyOf":       reports,
+		"$defs":       builder.defs,
+	}
+}
+
+type jsonSchemaBuilder struct {
+	defs map[string]any
+}
+
+func (b *jsonSchemaBuilder) typeSchema(t reflect.Type) map[string]any {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return map[string]any{"anyOf": []any{b.typeSchema(t.Elem()), map[string]any{"type": "null"}}}
+	case reflect.Struct:
+		b.define(t)
+
+		return map[string]any{"$ref": "#/$defs/" + t.Name()}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": b.typeSchema(t.Elem())}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	default:
+		return map[string]any{}
+	}
+}
+
+// define adds an object definition for a struct; fields tagged omitempty are optional
+func (b *jsonSchemaBuilder) define(t reflect.Type) {
+	if _, ok := b.defs[t.Name()]; ok {
+		return
+	}
+
+	properties := map[string]any{}
+	required := []string{}
+	def := map[string]any{"type": "object", "properties": properties}
+	b.defs[t.Name()] = def
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		property := b.typeSchema(field.Type)
+		if name == "schema_version" {
+			property["const"] = model.JSONSchemaVersion
+		}
+
+		properties[name] = property
+
+		if !strings.Contains(options, "omitempty") {
+			required = append(required, name)
+		}
+	}
+
+	def["required"] = required
+}
@@ -0,0 +1,186 @@
+package utils //nolint:revive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// publishedSchemaPath is the copy of the schema committed to the repository
+const publishedSchemaPath = "../schema/report.schema.json"
+
+func TestJSONSchemaIsUpToDate(t *testing.T) {
+	published, err := os.ReadFile(publishedSchemaPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", publishedSchemaPath, err)
+	}
+
+	var generated bytes.Buffer
+	if err := OutputJSONSchema(&generated); err != nil {
+		t.Fatalf("OutputJSONSchema() error = %v", err)
+	}
+
+	if !bytes.Equal(published, generated.Bytes()) {
+		t.Errorf("%s is out of date, regenerate it with: go run . schema > schema/report.schema.json", publishedSchemaPath)
+	}
+}
+
+func TestBuildJSONSchema(t *testing.T) {
+	schema := BuildJSONSchema()
+	defs := schema["$defs"].(map[string]any)
+
+	if len(schema["anyOf"].([]any)) != len(jsonSchemaReports) {
+		t.Errorf("anyOf has %d reports, want %d", len(schema["anyOf"].([]any)), len(jsonSchemaReports))
+	}
+
+	cost := defs["CostComparisonJSON"].(map[string]any)
+	if cost["title"] != "Cost comparison report" {
+		t.Errorf("CostComparisonJSON title = %v", cost["title"])
+	}
+
+	version := cost["properties"].(map[string]any)["schema_version"].(map[string]any)
+	if version["const"] != model.JSONSchemaVersion {
+		t.Errorf("schema_version const = %v, want %s", version["const"], model.JSONSchemaVersion)
+	}
+
+	commitments := defs["CommitmentReportJSON"].(map[string]any)["properties"].(map[string]any)
+	if _, ok := commitments["reserved_instances"].(map[string]any)["anyOf"]; !ok {
+		t.Error("pointer fields should be nullable")
+	}
+
+	required := defs["CommitmentJSON"].(map[string]any)["required"].([]string)
+	if strings.Contains(strings.Join(required, ","), "end_date") {
+		t.Errorf("omitempty fields should be optional, got required %v", required)
+	}
+}
+
+func TestJSONReportsMatchSchema(t *testing.T) {
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 100.0, Unit: "USD"}}}
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 120.0, Unit: "USD"}}}
+	trend := []model.CostInfo{{CostGroup: model.CostGroup{"Total": {Amount: 100.0, Unit: "USD"}}}}
+	waste := model.WasteReport{
+		UnusedVolumes: []types.Volume{{VolumeId: aws.String("vol-123"), Size: aws.Int32(100)}},
+		ECSServices:   []model.ECSServiceWasteInfo{{ServiceName: "api"}},
+		UnusedAMIs:    []model.AMIWasteInfo{{ImageID: "ami-123"}},
+	}
+
+	reports := map[string]func(w *bytes.Buffer) error{
+		"CostComparisonJSON": func(w *bytes.Buffer) error {
+			return OutputCostComparisonJSON(w, "123456789012", 100, 120, lastMonth, currentMonth)
+		},
+		"TrendJSON":       func(w *bytes.Buffer) error { return OutputTrendJSON(w, "123456789012", trend) },
+		"WasteReportJSON": func(w *bytes.Buffer) error { return OutputWasteJSON(w, "123456789012", waste) },
+		"CommitmentReportJSON": func(w *bytes.Buffer) error {
+			return OutputCommitmentsJSON(w, "123456789012", model.CommitmentReport{})
+		},
+		"RecommendationReportJSON": func(w *bytes.Buffer) error {
+			return OutputRecommendationsJSON(w, "123456789012", model.RecommendationReport{})
+		},
+		"RightsizingReportJSON": func(w *bytes.Buffer) error {
+			return OutputRightsizingJSON(w, "123456789012", model.RightsizingReport{})
+		},
+	}
+
+	defs := BuildJSONSchema()["$defs"].(map[string]any)
+
+	for name, render := range reports {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := render(&buf); err != nil {
+				t.Fatalf("render error = %v", err)
+			}
+
+			var document any
+			if err := json.Unmarshal(buf.Bytes(), &document); err != nil {
+				t.Fatalf("failed to parse output JSON: %v", err)
+			}
+
+			if err := validateJSONSchema(document, map[string]any{"$ref": "#/$defs/" + name}, defs, "$"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// validateJSONSchema checks a decoded document against the subset of JSON Schema emitted by BuildJSONSchema
+func validateJSONSchema(value any, schema, defs map[string]any, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		return validateJSONSchema(value, defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any), defs, path)
+	}
+
+	if anyOf, ok := schema["anyOf"].([]any); ok {
+		for _, option := range anyOf {
+			if validateJSONSchema(value, option.(map[string]any), defs, path) == nil {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s matches none of the allowed schemas", path)
+	}
+
+	if constant, ok := schema["const"]; ok && value != constant {
+		return fmt.Errorf("%s = %v, want %v", path, value, constant)
+	}
+
+	switch schema["type"] {
+	case "null":
+		if value != nil {
+			return fmt.Errorf("%s is not null", path)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s is not a string", path)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s is not a boolean", path)
+		}
+	case "integer", "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s is not a number", path)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s is not an array", path)
+		}
+
+		for i, item := range items {
+			if err := validateJSONSchema(item, schema["items"].(map[string]any), defs, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s is not an object", path)
+		}
+
+		for _, name := range schema["required"].([]string) {
+			if _, ok := object[name]; !ok {
+				return fmt.Errorf("%s is missing required property %s", path, name)
+			}
+		}
+
+		properties := schema["properties"].(map[string]any)
+		for name, property := range object {
+			propertySchema, ok := properties[name].(map[string]any)
+			if !ok {
+				return fmt.Errorf("%s.%s is not described by the schema", path, name)
+			}
+
+			if err := validateJSONSchema(property, propertySchema, defs, path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
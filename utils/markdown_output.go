@@ -0,0 +1,249 @@
+package utils //nolint:revive
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// markdownBarWidth is the number of block characters of the longest bar in the Markdown trend chart
+const markdownBarWidth = 30
+
+// markdownWasteSection groups waste categories under the same title and order as the table renderer
+type markdownWasteSection struct {
+	Emoji      string
+	Title      string
+	Categories []string
+}
+
+var markdownWasteSections = []markdownWasteSection{
+	{Emoji: "💾", Title: "EBS Volume Waste", Categories: []string{"unused_ebs_volumes", "stopped_instance_volumes"}},
+	{Emoji: "🌐", Title: "Elastic IP Waste", Categories: []string{"unused_elastic_ips"}},
+	{Emoji: "🖥️", Title: "EC2 & Reserved Instance Waste", Categories: []string{"stopped_instances", "reserved_instances"}},
+	{Emoji: "🏗️", Title: "EC2 Reserved Capacity Waste", Categories: []string{"unused_capacity_reservations", "idle_dedicated_hosts"}},
+	{Emoji: "⚖️", Title: "Load Balancer Waste", Categories: []string{"unused_load_balancers", "unused_classic_load_balancers"}},
+	{Emoji: "📦", Title: "Containers Waste", Categories: []string{"idle_eks_clusters", "idle_ecs_services"}},
+	{Emoji: "🧠", Title: "SageMaker Waste", Categories: []string{"idle_sagemaker_endpoints", "long_running_sagemaker_notebooks"}},
+	{Emoji: "🗄️", Title: "Redshift Waste", Categories: []string{"idle_redshift_clusters", "stale_redshift_snapshots"}},
+	{Emoji: "📁", Title: "EFS Waste", Categories: []string{"efs_file_systems"}},
+	{Emoji: "🛟", Title: "AWS Backup Waste", Categories: []string{"backup_recovery_points"}},
+	{Emoji: "🔑", Title: "Secrets & Keys Waste", Categories: []string{"unused_secrets", "unused_kms_keys"}},
+	{Emoji: "🧭", Title: "Route 53 Waste", Categories: []string{"route53_hosted_zones", "unused_route53_health_checks"}},
+	{Emoji: "🌊", Title: "Streaming Waste", Categories: []string{"kinesis_streams", "idle_msk_clusters"}},
+	{Emoji: "👣", Title: "CloudTrail Waste", Categories: []string{"cloudtrail_trails"}},
+	{Emoji: "💿", Title: "Unused AMI Waste", Categories: []string{"unused_amis"}},
+	{Emoji: "📸", Title: "EBS Snapshot Waste", Categories: []string{"orphaned_snapshots", "stale_snapshots"}},
+}
+
+// OutputCostComparisonMarkdown outputs cost comparison data as a GitHub-flavoured Markdown table
+func OutputCostComparisonMarkdown(w io.Writer, accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) error {
+	output := BuildCostComparisonJSON(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth)
+
+	var b strings.Builder
+
+	writeMarkdownHeader(&b, "💰 AWS Cost Diagnosis", accountID, output.GeneratedAt)
+	fmt.Fprintf(&b, "Last month (%s) vs current month to date (%s)\n\n", output.LastMonth.Start, output.CurrentMonth.Start)
+
+	b.WriteString("| Service | Last Month | Current Month | Difference |\n")
+	b.WriteString("| --- | ---: | ---: | ---: |\n")
+
+	for _, service := range output.ServiceBreakdown {
+		writeMarkdownRow(&b,
+			service.Service,
+			formatAmount(service.LastCost, service.Unit),
+			formatAmount(service.CurrentCost, service.Unit),
+			costDifferenceMarker(service.Difference)+formatAmount(service.Difference, service.Unit),
+		)
+	}
+
+	writeMarkdownRow(&b,
+		"**Total**",
+		"**"+formatAmount(lastTotalCost, output.LastMonth.Unit)+"**",
+		"**"+formatAmount(currentTotalCost, output.CurrentMonth.Unit)+"**",
+		"**"+costDifferenceMarker(currentTotalCost-lastTotalCost)+formatAmount(currentTotalCost-lastTotalCost, output.CurrentMonth.Unit)+"**",
+	)
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// OutputTrendMarkdown outputs trend data as a Markdown table with a text bar per month
+func OutputTrendMarkdown(w io.Writer, accountID string, costInfo []model.CostInfo) error {
+	output := BuildTrendJSON(accountID, costInfo)
+
+	var b strings.Builder
+
+	writeMarkdownHeader(&b, "📈 AWS Doctor Trend", accountID, output.GeneratedAt)
+
+	if len(output.Months) == 0 {
+		b.WriteString("_No cost data available for the requested period._\n")
+		_, err := io.WriteString(w, b.String())
+
+		return err
+	}
+
+	var maxTotal float64
+	for _, month := range output.Months {
+		maxTotal = max(maxTotal, month.Total)
+	}
+
+	b.WriteString("| Month | Total | |\n")
+	b.WriteString("| --- | ---: | --- |\n")
+
+	for _, month := range output.Months {
+		bar := 0
+		if maxTotal > 0 {
+			bar = int(month.Total / maxTotal * markdownBarWidth)
+		}
+
+		writeMarkdownRow(&b, monthLabel(month.Start), formatAmount(month.Total, month.Unit), strings.Repeat("█", bar))
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// OutputWasteMarkdown outputs waste detection data as Markdown, with a summary followed by one
+// table per section of the table renderer
+func OutputWasteMarkdown(w io.Writer, accountID string, report model.WasteReport) error {
+	output := BuildWasteReportJSON(accountID, report)
+	rows := buildWasteRows(output)
+
+	var b strings.Builder
+
+	writeMarkdownHeader(&b, "🏥 AWS Doctor Checkup", accountID, output.GeneratedAt)
+	writeMarkdownSkippedChecks(&b, output.SkippedChecks)
+
+	if len(rows) == 0 {
+		if len(output.SkippedChecks) > 0 {
+			b.WriteString("✅ No waste found by the checks that ran.\n")
+		} else {
+			b.WriteString("✅ Your account is healthy! No waste found.\n")
+		}
+
+		_, err := io.WriteString(w, b.String())
+
+		return err
+	}
+
+	byCategory := make(map[string][]wasteRow)
+	for _, row := range rows {
+		byCategory[row.Category] = append(byCategory[row.Category], row)
+	}
+
+	fmt.Fprintf(&b, "⚠️ Found **%d** wasted resources with an estimated cost of **%s** per month.\n",
+		len(rows), formatMonthlyCost(sumWasteCost(rows)))
+
+	for _, section := range markdownWasteSections {
+		var sectionRows []wasteRow
+		for _, category := range section.Categories {
+			sectionRows = append(sectionRows, byCategory[category]...)
+		}
+
+		if len(sectionRows) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n### %s %s\n\n", section.Emoji, section.Title)
+		b.WriteString("| Category | Resource ID | Name | Status | Details | Est. Monthly Cost |\n")
+		b.WriteString("| --- | --- | --- | --- | --- | ---: |\n")
+
+		for _, row := range sectionRows {
+			writeMarkdownRow(&b,
+				wasteCategoryTitles[row.Category],
+				"`"+row.ResourceID+"`",
+				row.ResourceName,
+				wasteStatusMarker(row.Status)+" "+row.Status,
+				row.Details,
+				formatMonthlyCost(row.MonthlyCost),
+			)
+		}
+
+		writeMarkdownRow(&b, "**Total**", "", "", "", "", "**"+formatMonthlyCost(sumWasteCost(sectionRows))+"**")
+	}
+
+	b.WriteString("\n_🔴 waste · 🟡 needs review · ⚪ could not be verified._\n")
+	b.WriteString("\n_Costs are estimates; \"n/a\" marks resources without a known price._\n")
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func writeMarkdownHeader(b *strings.Builder, title, accountID, generatedAt string) {
+	fmt.Fprintf(b, "## %s\n\n", title)
+	fmt.Fprintf(b, "**Account ID:** `%s` · **Generated at:** %s\n\n", accountID, generatedAt)
+}
+
+// writeMarkdownSkippedChecks lists the checks that could not run, so their empty categories are not
+// mistaken for a clean account
+func writeMarkdownSkippedChecks(b *strings.Builder, checks []model.SkippedCheckJSON) {
+	if len(checks) == 0 {
+		return
+	}
+
+	b.WriteString("⏭️ **Skipped checks:** the findings of these checks are missing.\n\n")
+
+	for _, check := range checks {
+		fmt.Fprintf(b, "- %s: %s\n", check.Name, check.Reason)
+	}
+
+	b.WriteString("\n")
+}
+
+// writeMarkdownRow writes a table row, escaping characters that would break the table layout
+func writeMarkdownRow(b *strings.Builder, cells ...string) {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = escapeMarkdownCell(cell)
+	}
+
+	fmt.Fprintf(b, "| %s |\n", strings.Join(escaped, " | "))
+}
+
+func escapeMarkdownCell(cell string) string {
+	cell = strings.ReplaceAll(cell, "|", "\\|")
+
+	return strings.Join(strings.Fields(cell), " ")
+}
+
+// reviewWasteStatuses are the statuses of resources that may still be in use, so they need a review
+// before they are removed
+var reviewWasteStatuses = map[string]bool{
+	string(model.SnapshotCategoryStale):  true,
+	"UNDERUTILIZED":                      true,
+	"long_running":                       true,
+	model.BackupRecoveryPointNoLifecycle: true,
+	model.EFSFileSystemNoIALifecycle:     true,
+	model.RedshiftClusterLowCPU:          true,
+	model.KinesisStreamOverProvisioned:   true,
+	"EXPIRING SOON":                      true,
+}
+
+// wasteStatusMarker returns the marker shown next to a waste status: red for waste, yellow for
+// findings to review and white for findings that could not be verified
+func wasteStatusMarker(status string) string {
+	switch {
+	case strings.Contains(status, "UNKNOWN") || strings.Contains(status, "UNVERIFIABLE"):
+		return "⚪"
+	case reviewWasteStatuses[status]:
+		return "🟡"
+	default:
+		return "🔴"
+	}
+}
+
+func costDifferenceMarker(difference float64) string {
+	switch {
+	case difference > 0:
+		return "🔺 "
+	case difference < 0:
+		return "🔻 "
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,173 @@
+package utils //nolint:revive
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestOutputCostComparisonMarkdown(t *testing.T) {
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{
+		"Amazon EC2": {Amount: 100.0, Unit: "USD"},
+		"Amazon S3":  {Amount: 50.0, Unit: "USD"},
+	}}
+	lastMonth.Start = aws.String("2024-01-01")
+
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{
+		"Amazon EC2": {Amount: 120.0, Unit: "USD"},
+		"Amazon S3":  {Amount: 45.0, Unit: "USD"},
+	}}
+	currentMonth.Start = aws.String("2024-02-01")
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputCostComparisonMarkdown(os.Stdout, "123456789012", 150.0, 165.0, lastMonth, currentMonth)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputCostComparisonMarkdown() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"| Service | Last Month | Current Month | Difference |",
+		"| Amazon EC2 | 100.00 USD | 120.00 USD | 🔺 20.00 USD |",
+		"| Amazon S3 | 50.00 USD | 45.00 USD | 🔻 -5.00 USD |",
+		"| **Total** | **150.00 USD** | **165.00 USD** | **🔺 15.00 USD** |",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %q:\n%s", want, output)
+		}
+	}
+
+	if strings.Index(output, "Amazon EC2") > strings.Index(output, "Amazon S3") {
+		t.Error("services should be ordered by current month cost")
+	}
+}
+
+func TestOutputTrendMarkdown(t *testing.T) {
+	costInfo := []model.CostInfo{
+		{CostGroup: model.CostGroup{"Total": {Amount: 50.0, Unit: "USD"}}},
+		{CostGroup: model.CostGroup{"Total": {Amount: 100.0, Unit: "USD"}}},
+	}
+	costInfo[0].Start = aws.String("2024-01-01")
+	costInfo[1].Start = aws.String("2024-02-01")
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputTrendMarkdown(os.Stdout, "123456789012", costInfo)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputTrendMarkdown() error = %v", err)
+	}
+
+	half := "| Jan 2024 | 50.00 USD | " + strings.Repeat("█", markdownBarWidth/2) + " |"
+	full := "| Feb 2024 | 100.00 USD | " + strings.Repeat("█", markdownBarWidth) + " |"
+
+	if !strings.Contains(output, half) || !strings.Contains(output, full) {
+		t.Errorf("bars should be scaled to the highest month:\n%s", output)
+	}
+}
+
+func TestOutputWasteMarkdown(t *testing.T) {
+	report := model.WasteReport{
+		ElasticIPs:    []types.Address{{PublicIp: aws.String("1.2.3.4"), AllocationId: aws.String("eipalloc-123")}},
+		UnusedVolumes: []types.Volume{{VolumeId: aws.String("vol-123"), Size: aws.Int32(100)}},
+		KMSKeys: []model.KMSKeyWasteInfo{
+			{KeyID: "key-1", Description: "a|b\nc", Status: model.KMSKeyDisabled, MonthlyCost: 1},
+		},
+	}
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputWasteMarkdown(os.Stdout, "123456789012", report)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputWasteMarkdown() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"Found **3** wasted resources with an estimated cost of **$1.00** per month.",
+		"### 💾 EBS Volume Waste",
+		"### 🌐 Elastic IP Waste",
+		"### 🔑 Secrets & Keys Waste",
+		"| Unused KMS Keys | `key-1` | a\\|b c | 🔴 DISABLED |  | $1.00 |",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %q:\n%s", want, output)
+		}
+	}
+
+	if strings.Index(output, "EBS Volume Waste") > strings.Index(output, "Elastic IP Waste") {
+		t.Error("sections should follow the table renderer order")
+	}
+}
+
+func TestOutputWasteMarkdown_NoWaste(t *testing.T) {
+	output := captureStdout(func() {
+		_ = OutputWasteMarkdown(os.Stdout, "123456789012", model.WasteReport{})
+	})
+
+	if !strings.Contains(output, "No waste found") {
+		t.Errorf("output should state that no waste was found:\n%s", output)
+	}
+}
+
+func TestOutputWasteMarkdown_SkippedChecks(t *testing.T) {
+	output := captureStdout(func() {
+		_ = OutputWasteMarkdown(os.Stdout, "123456789012", model.WasteReport{SkippedChecks: []model.SkippedCheck{{Name: "Route 53", Reason: "access denied", Categories: []string{"route53_hosted_zones", "unused_route53_health_checks"}}}})
+	})
+
+	for _, want := range []string{"Skipped checks", "- Route 53: access denied", "No waste found by the checks that ran"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+
+	if strings.Contains(output, "Your account is healthy") {
+		t.Error("an account with skipped checks should not be reported as healthy")
+	}
+}
+
+func TestWasteStatusMarker(t *testing.T) {
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{model.KMSKeyDisabled, "🔴"},
+		{string(model.SnapshotCategoryStale), "🟡"},
+		{"UNDERUTILIZED", "🟡"},
+		{model.KMSKeyUsageUnknown, "⚪"},
+		{model.Route53ZoneUnverifiableRecords, "⚪"},
+	}
+
+	for _, tt := range tests {
+		if got := wasteStatusMarker(tt.status); got != tt.want {
+			t.Errorf("wasteStatusMarker(%q) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestMarkdownWasteSectionsCoverAllCategories(t *testing.T) {
+	covered := make(map[string]bool)
+
+	for _, section := range markdownWasteSections {
+		for _, category := range section.Categories {
+			covered[category] = true
+		}
+	}
+
+	for category := range wasteCategoryTitles {
+		if !covered[category] {
+			t.Errorf("waste category %q is not rendered by any Markdown section", category)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package utils //nolint:revive
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// Content types of the two exposition formats written by WriteMetrics
+const (
+	PrometheusContentType  = "text/plain; version=0.0.4; charset=utf-8"
+	OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// BuildCostMetrics converts cost comparison data into gauges: account totals, per-service costs and,
+// when Cost Explorer returned one, the forecasted cost at the end of the month
+func BuildCostMetrics(accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo, forecast *model.CostForecast) []model.MetricFamily {
+	account := []model.MetricLabel{{Name: "account_id", Value: accountID}}
+
+	families := []model.MetricFamily{
+		{
+			Name:    "aws_doctor_cost_month_to_date_usd",
+			Help:    "Unblended cost of the current month to date.",
+			Samples: []model.MetricSample{{Labels: account, Value: currentTotalCost}},
+		},
+		{
+			Name:    "aws_doctor_cost_last_month_to_date_usd",
+			Help:    "Unblended cost of last month up to the same day of the month.",
+			Samples: []model.MetricSample{{Labels: account, Value: lastTotalCost}},
+		},
+	}
+
+	if forecast != nil {
+		families = append(families, model.MetricFamily{
+			Name:    "aws_doctor_cost_forecast_usd",
+			Help:    "Forecasted unblended cost of the current month: the cost to date plus the Cost Explorer forecast for the remaining days.",
+			Samples: []model.MetricSample{{Labels: account, Value: currentTotalCost + forecast.Amount}},
+		})
+	}
+
+	current := model.MetricFamily{
+		Name: "aws_doctor_service_cost_month_to_date_usd",
+		Help: "Unblended cost of the current month to date per service.",
+	}
+	last := model.MetricFamily{
+		Name: "aws_doctor_service_cost_last_month_to_date_usd",
+		Help: "Unblended cost of last month up to the same day of the month per service.",
+	}
+
+	for _, service := range BuildCostComparisonJSON(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth).ServiceBreakdown {
+		labels := []model.MetricLabel{{Name: "account_id", Value: accountID}, {Name: "service", Value: service.Service}}
+		current.Samples = append(current.Samples, model.MetricSample{Labels: labels, Value: service.CurrentCost})
+		last.Samples = append(last.Samples, model.MetricSample{Labels: labels, Value: service.LastCost})
+	}
+
+	return append(families, current, last)
+}
+
+// BuildWasteMetrics converts a waste report into per-category resource counts and estimated
+// monthly savings. Every category that was checked is reported, with zero values when nothing was
+// found, so the series do not disappear once the waste is cleaned up. Categories whose check was
+// skipped have no count or savings sample, since zero would read as a clean account, and are
+// flagged by aws_doctor_waste_check_skipped instead.
+func BuildWasteMetrics(accountID, region string, report model.WasteReport) []model.MetricFamily {
+	counts := make(map[string]int)
+	costs := make(map[string]float64)
+	skipped := make(map[string]bool)
+
+	for _, check := range report.SkippedChecks {
+		for _, category := range check.Categories {
+			skipped[category] = true
+		}
+	}
+
+	for _, row := range buildWasteRows(BuildWasteReportJSON(accountID, report)) {
+		counts[row.Category]++
+		costs[row.Category] += row.MonthlyCost
+	}
+
+	categories := make([]string, 0, len(wasteCategoryTitles))
+	for category := range wasteCategoryTitles {
+		categories = append(categories, category)
+	}
+
+	sort.Strings(categories)
+
+	resources := model.MetricFamily{
+		Name: "aws_doctor_waste_resources",
+		Help: "Number of wasted resources per waste category.",
+	}
+	savings := model.MetricFamily{
+		Name: "aws_doctor_waste_potential_savings_usd",
+		Help: "Estimated monthly cost of the wasted resources per waste category; resources without a known price count as zero.",
+	}
+	skippedChecks := model.MetricFamily{
+		Name: "aws_doctor_waste_check_skipped",
+		Help: "1 when the check of a waste category was skipped because it was denied or is not available in the region, 0 otherwise.",
+	}
+
+	for _, category := range categories {
+		labels := []model.MetricLabel{
+			{Name: "account_id", Value: accountID},
+			{Name: "region", Value: region},
+			{Name: "category", Value: category},
+		}
+
+		if skipped[category] {
+			skippedChecks.Samples = append(skippedChecks.Samples, model.MetricSample{Labels: labels, Value: 1})
+
+			continue
+		}
+
+		resources.Samples = append(resources.Samples, model.MetricSample{Labels: labels, Value: float64(counts[category])})
+		savings.Samples = append(savings.Samples, model.MetricSample{Labels: labels, Value: costs[category]})
+		skippedChecks.Samples = append(skippedChecks.Samples, model.MetricSample{Labels: labels, Value: 0})
+	}
+
+	return []model.MetricFamily{resources, savings, skippedChecks}
+}
+
+// OutputCostComparisonOpenMetrics outputs cost comparison data as OpenMetrics text, for example
+// for the node_exporter textfile collector
+func OutputCostComparisonOpenMetrics(w io.Writer, accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) error {
+	return WriteMetrics(w, BuildCostMetrics(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth, nil), true)
+}
+
+// OutputWasteOpenMetrics outputs the per-category waste summary as OpenMetrics text
+func OutputWasteOpenMetrics(w io.Writer, accountID, region string, report model.WasteReport) error {
+	return WriteMetrics(w, BuildWasteMetrics(accountID, region, report), true)
+}
+
+// WriteMetrics writes gauges in the Prometheus text exposition format, or in the OpenMetrics
+// format, which differs only by its terminating "# EOF" line for gauges
+func WriteMetrics(w io.Writer, families []model.MetricFamily, openMetrics bool) error {
+	var b strings.Builder
+
+	for _, family := range families {
+		fmt.Fprintf(&b, "# HELP %s %s\n", family.Name, escapeMetricHelp(family.Help))
+		fmt.Fprintf(&b, "# TYPE %s gauge\n", family.Name)
+
+		for _, sample := range family.Samples {
+			b.WriteString(family.Name)
+
+			if len(sample.Labels) > 0 {
+				labels := make([]string, len(sample.Labels))
+				for i, label := range sample.Labels {
+					labels[i] = fmt.Sprintf("%s=\"%s\"", label.Name, escapeMetricLabel(label.Value))
+				}
+
+				fmt.Fprintf(&b, "{%s}", strings.Join(labels, ","))
+			}
+
+			fmt.Fprintf(&b, " %s\n", strconv.FormatFloat(sample.Value, 'f', -1, 64))
+		}
+	}
+
+	if openMetrics {
+		b.WriteString("# EOF\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func escapeMetricHelp(help string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+}
+
+func escapeMetricLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,162 @@
+package utils //nolint:revive
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	families := []model.MetricFamily{
+		{
+			Name: "aws_doctor_example",
+			Help: "Example gauge.\nSecond line with a \\.",
+			Samples: []model.MetricSample{
+				{Labels: []model.MetricLabel{{Name: "service", Value: `Amazon "S3"`}}, Value: 1.5},
+				{Value: 0},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteMetrics(&buf, families, false); err != nil {
+		t.Fatalf("WriteMetrics() error = %v", err)
+	}
+
+	want := `# HELP aws_doctor_example Example gauge.\nSecond line with a \\.
+# TYPE aws_doctor_example gauge
+aws_doctor_example{service="Amazon \"S3\""} 1.5
+aws_doctor_example 0
+`
+	if buf.String() != want {
+		t.Errorf("WriteMetrics() =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+
+	if err := WriteMetrics(&buf, families, true); err != nil {
+		t.Fatalf("WriteMetrics() error = %v", err)
+	}
+
+	if !strings.HasSuffix(buf.String(), "aws_doctor_example 0\n# EOF\n") {
+		t.Errorf("OpenMetrics output should end with # EOF, got:\n%s", buf.String())
+	}
+}
+
+func TestOutputCostComparisonOpenMetrics(t *testing.T) {
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon S3": {Amount: 45, Unit: "USD"}}}
+
+	var buf bytes.Buffer
+	if err := OutputCostComparisonOpenMetrics(&buf, "123456789012", 0, 45, &model.CostInfo{}, currentMonth); err != nil {
+		t.Fatalf("OutputCostComparisonOpenMetrics() error = %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, `aws_doctor_service_cost_month_to_date_usd{account_id="123456789012",service="Amazon S3"} 45`) {
+		t.Errorf("missing per-service sample:\n%s", output)
+	}
+
+	if strings.Contains(output, "aws_doctor_cost_forecast_usd") || !strings.HasSuffix(output, "# EOF\n") {
+		t.Errorf("expected no forecast and a terminating # EOF:\n%s", output)
+	}
+}
+
+func TestBuildCostMetrics(t *testing.T) {
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 100, Unit: "USD"}}}
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{
+		"Amazon EC2": {Amount: 120, Unit: "USD"},
+		"Amazon S3":  {Amount: 45, Unit: "USD"},
+	}}
+	currentMonth.Start = aws.String("2024-02-01")
+
+	families := BuildCostMetrics("123456789012", 100, 165, lastMonth, currentMonth, nil)
+
+	names := make([]string, len(families))
+	for i, family := range families {
+		names[i] = family.Name
+	}
+
+	want := "aws_doctor_cost_month_to_date_usd,aws_doctor_cost_last_month_to_date_usd," +
+		"aws_doctor_service_cost_month_to_date_usd,aws_doctor_service_cost_last_month_to_date_usd"
+	if got := strings.Join(names, ","); got != want {
+		t.Errorf("families without forecast = %s, want %s", got, want)
+	}
+
+	services := families[2].Samples
+	if len(services) != 2 || services[0].Labels[1].Value != "Amazon EC2" || services[1].Value != 45 {
+		t.Errorf("per-service samples = %+v", services)
+	}
+
+	withForecast := BuildCostMetrics("123456789012", 100, 165, lastMonth, currentMonth, &model.CostForecast{Amount: 35, Unit: "USD"})
+	if withForecast[2].Name != "aws_doctor_cost_forecast_usd" || withForecast[2].Samples[0].Value != 200 {
+		t.Errorf("forecast family = %+v, want month-end forecast of 200", withForecast[2])
+	}
+}
+
+func TestBuildWasteMetrics(t *testing.T) {
+	report := model.WasteReport{
+		UnusedVolumes: []types.Volume{{VolumeId: aws.String("vol-1")}, {VolumeId: aws.String("vol-2")}},
+		KMSKeys:       []model.KMSKeyWasteInfo{{KeyID: "key-1", MonthlyCost: 1}},
+	}
+
+	families := BuildWasteMetrics("123456789012", "eu-west-1", report)
+	if len(families) != 3 {
+		t.Fatalf("got %d families, want 3", len(families))
+	}
+
+	resources, savings := families[0], families[1]
+	if len(resources.Samples) != len(wasteCategoryTitles) || len(savings.Samples) != len(wasteCategoryTitles) {
+		t.Errorf("every waste category should have a sample, got %d and %d", len(resources.Samples), len(savings.Samples))
+	}
+
+	values := make(map[string]float64)
+	for _, sample := range resources.Samples {
+		if sample.Labels[0].Value != "123456789012" || sample.Labels[1].Value != "eu-west-1" {
+			t.Errorf("unexpected labels %+v", sample.Labels)
+		}
+
+		values[sample.Labels[2].Value] = sample.Value
+	}
+
+	if values["unused_ebs_volumes"] != 2 || values["unused_kms_keys"] != 1 || values["idle_eks_clusters"] != 0 {
+		t.Errorf("resource counts = %v", values)
+	}
+
+	for _, sample := range savings.Samples {
+		if sample.Labels[2].Value == "unused_kms_keys" && sample.Value != 1 {
+			t.Errorf("unused_kms_keys savings = %v, want 1", sample.Value)
+		}
+	}
+}
+
+func TestBuildWasteMetrics_SkippedChecks(t *testing.T) {
+	report := model.WasteReport{
+		SkippedChecks: []model.SkippedCheck{{Name: "EKS clusters", Reason: "access denied", Categories: []string{"idle_eks_clusters"}}},
+	}
+
+	families := BuildWasteMetrics("123456789012", "eu-west-1", report)
+	resources, skipped := families[0], families[2]
+
+	if len(resources.Samples) != len(wasteCategoryTitles)-1 {
+		t.Errorf("skipped categories should have no resource count, got %d samples", len(resources.Samples))
+	}
+
+	for _, sample := range resources.Samples {
+		if sample.Labels[2].Value == "idle_eks_clusters" {
+			t.Error("idle_eks_clusters was skipped and should have no resource count")
+		}
+	}
+
+	values := make(map[string]float64)
+	for _, sample := range skipped.Samples {
+		values[sample.Labels[2].Value] = sample.Value
+	}
+
+	if len(values) != len(wasteCategoryTitles) || values["idle_eks_clusters"] != 1 || values["unused_kms_keys"] != 0 {
+		t.Errorf("aws_doctor_waste_check_skipped = %v", values)
+	}
+}
@@ -0,0 +1,435 @@
+package utils //nolint:revive
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/go-pdf/fpdf"
+)
+
+// PDF page layout in millimetres (A4 landscape)
+const (
+	pdfMargin       = 10.0
+	pdfRowHeight    = 6.0
+	pdfChartHeight  = 100.0
+	pdfContentWidth = 297.0 - 2*pdfMargin
+)
+
+// wasteCategoryTitles holds the section title of each waste category in the PDF and HTML reports,
+// keyed by its JSON field name
+var wasteCategoryTitles = map[string]string{
+	"unused_elastic_ips":               "Unused Elastic IPs",
+	"unused_ebs_volumes":               "Unused EBS Volumes",
+	"stopped_instance_volumes":         "EBS Volumes Attached to Stopped Instances",
+	"stopped_instances":                "Stopped Instances",
+	"reserved_instances":               "Expiring Reserved Instances",
+	"unused_capacity_reservations":     "Unused Capacity Reservations",
+	"idle_dedicated_hosts":             "Idle Dedicated Hosts",
+	"unused_load_balancers":            "Unused Load Balancers",
+	"unused_classic_load_balancers":    "Unused Classic Load Balancers",
+	"idle_eks_clusters":                "EKS Clusters",
+	"idle_ecs_services":                "Idle ECS Services",
+	"idle_sagemaker_endpoints":         "Idle SageMaker Endpoints",
+	"long_running_sagemaker_notebooks": "Long-running SageMaker Notebooks",
+	"idle_redshift_clusters":           "Idle Redshift Clusters",
+	"stale_redshift_snapshots":         "Stale Redshift Snapshots",
+	"efs_file_systems":                 "EFS File Systems",
+	"backup_recovery_points":           "AWS Backup Recovery Points",
+	"unused_secrets":                   "Unused Secrets",
+	"unused_kms_keys":                  "Unused KMS Keys",
+	"route53_hosted_zones":             "Route 53 Hosted Zones",
+	"unused_route53_health_checks":     "Unused Route 53 Health Checks",
+	"kinesis_streams":                  "Kinesis Streams",
+	"idle_msk_clusters":                "Idle MSK Clusters",
+	"cloudtrail_trails":                "CloudTrail Trails",
+	"unused_amis":                      "Unused AMIs",
+	"orphaned_snapshots":               "Orphaned Snapshots",
+	"stale_snapshots":                  "Stale Snapshots",
+}
+
+// pdfReport wraps a PDF document with the shared page header, footer and table helpers
+type pdfReport struct {
+	pdf       *fpdf.Fpdf
+	translate func(string) string
+}
+
+// OutputCostComparisonPDF writes the cost comparison as a PDF document
+func OutputCostComparisonPDF(w io.Writer, accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) error {
+	return buildCostComparisonPDF(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth).pdf.Output(w)
+}
+
+// OutputTrendPDF writes the monthly cost trend as a PDF document with a vector bar chart
+func OutputTrendPDF(w io.Writer, accountID string, costInfo []model.CostInfo) error {
+	return buildTrendPDF(accountID, costInfo).pdf.Output(w)
+}
+
+// OutputWastePDF writes the waste report as a PDF document, with a summary followed by one
+// section per waste category
+func OutputWastePDF(w io.Writer, accountID string, report model.WasteReport) error {
+	return buildWastePDF(accountID, report).pdf.Output(w)
+}
+
+func buildCostComparisonPDF(accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) *pdfReport {
+	output := BuildCostComparisonJSON(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth)
+
+	r := newPDFReport("Cost Comparison", accountID, output.GeneratedAt)
+
+	r.section(fmt.Sprintf("Last month (%s) vs current month to date (%s)", output.LastMonth.Start, output.CurrentMonth.Start))
+
+	widths := []float64{157, 40, 40, 40}
+	aligns := []string{"L", "R", "R", "R"}
+	rows := make([][]string, 0, len(output.ServiceBreakdown))
+
+	for _, service := range output.ServiceBreakdown {
+		rows = append(rows, []string{
+			service.Service,
+			formatAmount(service.LastCost, service.Unit),
+			formatAmount(service.CurrentCost, service.Unit),
+			formatAmount(service.Difference, service.Unit),
+		})
+	}
+
+	r.table([]string{"Service", "Last Month", "Current Month", "Difference"}, widths, aligns, rows, []string{
+		"Total",
+		formatAmount(lastTotalCost, output.LastMonth.Unit),
+		formatAmount(currentTotalCost, output.CurrentMonth.Unit),
+		formatAmount(currentTotalCost-lastTotalCost, output.CurrentMonth.Unit),
+	})
+
+	return r
+}
+
+func buildTrendPDF(accountID string, costInfo []model.CostInfo) *pdfReport {
+	output := BuildTrendJSON(accountID, costInfo)
+
+	r := newPDFReport("Cost Trend", accountID, output.GeneratedAt)
+
+	r.section("Monthly total cost")
+
+	if len(output.Months) == 0 {
+		r.note("No cost data available for the requested period.")
+
+		return r
+	}
+
+	r.barChart(output.Months, assignRankedColors(costInfoWithTotals(costInfo)))
+
+	rows := make([][]string, 0, len(output.Months))
+	for _, month := range output.Months {
+		rows = append(rows, []string{month.Start, month.End, formatAmount(month.Total, month.Unit)})
+	}
+
+	r.table([]string{"Start", "End", "Total"}, []float64{92, 92, 93}, []string{"L", "L", "R"}, rows, nil)
+
+	return r
+}
+
+func buildWastePDF(accountID string, report model.WasteReport) *pdfReport {
+	output := BuildWasteReportJSON(accountID, report)
+	rows := buildWasteRows(output)
+
+	r := newPDFReport("Waste Report", accountID, output.GeneratedAt)
+
+	if len(rows) == 0 {
+		r.section("Summary")
+
+		if len(output.SkippedChecks) > 0 {
+			r.note("No waste found by the checks that ran.")
+			r.note(pdfSkippedChecksNote(output.SkippedChecks))
+		} else {
+			r.note("No waste found. Your account is in good health!")
+		}
+
+		return r
+	}
+
+	sections := groupWasteRows(rows)
+
+	var (
+		summaryRows [][]string
+		grandTotal  float64
+	)
+
+	for _, section := range sections {
+		total := sumWasteCost(section)
+		grandTotal += total
+
+		summaryRows = append(summaryRows, []string{
+			wasteCategoryTitles[section[0].Category],
+			strconv.Itoa(len(section)),
+			formatMonthlyCost(total),
+		})
+	}
+
+	r.section("Summary")
+	r.table([]string{"Category", "Resources", "Est. Monthly Cost"}, []float64{197, 40, 40}, []string{"L", "R", "R"}, summaryRows,
+		[]string{"Total", strconv.Itoa(len(rows)), formatMonthlyCost(grandTotal)})
+	r.note("Costs are estimates; \"n/a\" marks resources without a known price.")
+
+	if len(output.SkippedChecks) > 0 {
+		r.note(pdfSkippedChecksNote(output.SkippedChecks))
+	}
+
+	widths := []float64{75, 55, 40, 72, 35}
+	aligns := []string{"L", "L", "L", "L", "R"}
+
+	for _, section := range sections {
+		tableRows := make([][]string, 0, len(section))
+		for _, row := range section {
+			tableRows = append(tableRows, []string{row.ResourceID, row.ResourceName, row.Status, row.Details, formatMonthlyCost(row.MonthlyCost)})
+		}
+
+		r.section(fmt.Sprintf("%s (%d)", wasteCategoryTitles[section[0].Category], len(section)))
+		r.table([]string{"Resource ID", "Name", "Status", "Details", "Est. Monthly Cost"}, widths, aligns, tableRows,
+			[]string{"Total", "", "", "", formatMonthlyCost(sumWasteCost(section))})
+	}
+
+	return r
+}
+
+// groupWasteRows splits rows into consecutive runs of the same category
+func groupWasteRows(rows []wasteRow) [][]wasteRow {
+	var groups [][]wasteRow
+
+	for i, row := range rows {
+		if i == 0 || row.Category != rows[i-1].Category {
+			groups = append(groups, nil)
+		}
+
+		groups[len(groups)-1] = append(groups[len(groups)-1], row)
+	}
+
+	return groups
+}
+
+func sumWasteCost(rows []wasteRow) float64 {
+	var total float64
+
+	for _, row := range rows {
+		total += row.MonthlyCost
+	}
+
+	return total
+}
+
+// costInfoWithTotals keeps the months that have a Total, matching the months of BuildTrendJSON
+func costInfoWithTotals(costInfo []model.CostInfo) []model.CostInfo {
+	var filtered []model.CostInfo
+
+	for _, info := range costInfo {
+		if _, ok := info.CostGroup["Total"]; ok {
+			filtered = append(filtered, info)
+		}
+	}
+
+	return filtered
+}
+
+func newPDFReport(title, accountID, generatedAt string) *pdfReport {
+	pdf := fpdf.New("L", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin+5)
+	pdf.SetTitle("AWS Doctor "+title, true)
+	pdf.SetCreator("aws-doctor", true)
+	pdf.AliasNbPages("")
+
+	r := &pdfReport{pdf: pdf, translate: pdf.UnicodeTranslatorFromDescriptor("")}
+
+	pdf.SetHeaderFunc(func() {
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(110, 110, 110)
+		pdf.CellFormat(pdfContentWidth/2, 5, r.translate("AWS Doctor - "+title), "", 0, "L", false, 0, "")
+		pdf.CellFormat(pdfContentWidth/2, 5, "Account "+accountID, "", 1, "R", false, 0, "")
+		pdf.SetDrawColor(200, 200, 200)
+		pdf.Line(pdfMargin, pdf.GetY(), pdfMargin+pdfContentWidth, pdf.GetY())
+		pdf.Ln(3)
+		pdf.SetTextColor(0, 0, 0)
+	})
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-(pdfMargin + 3))
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(110, 110, 110)
+		pdf.CellFormat(pdfContentWidth/2, 5, "Generated "+generatedAt, "", 0, "L", false, 0, "")
+		pdf.CellFormat(pdfContentWidth/2, 5, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	})
+
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(pdfContentWidth, 10, r.translate("AWS Doctor "+title), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(pdfContentWidth, 6, "Account ID: "+accountID, "", 1, "L", false, 0, "")
+	pdf.CellFormat(pdfContentWidth, 6, "Generated at: "+generatedAt, "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	return r
+}
+
+// section writes a section title, starting a new page when there is no room for the title and
+// the first rows below it
+func (r *pdfReport) section(title string) {
+	r.ensureSpace(4 * pdfRowHeight)
+
+	r.pdf.Ln(2)
+	r.pdf.SetFont("Helvetica", "B", 13)
+	r.pdf.CellFormat(pdfContentWidth, 8, r.translate(title), "", 1, "L", false, 0, "")
+}
+
+func (r *pdfReport) note(text string) {
+	r.pdf.SetFont("Helvetica", "I", 9)
+	r.pdf.CellFormat(pdfContentWidth, pdfRowHeight, r.translate(text), "", 1, "L", false, 0, "")
+}
+
+// table draws a table whose header row is repeated on every page it spans. A non-nil total is
+// drawn as a bold last row.
+func (r *pdfReport) table(headers []string, widths []float64, aligns []string, rows [][]string, total []string) {
+	drawHeader := func() {
+		r.pdf.SetFont("Helvetica", "B", 9)
+		r.pdf.SetFillColor(230, 230, 230)
+
+		for i, header := range headers {
+			r.pdf.CellFormat(widths[i], pdfRowHeight, r.fit(header, widths[i]), "1", 0, aligns[i], true, 0, "")
+		}
+
+		r.pdf.Ln(-1)
+	}
+
+	drawRow := func(row []string) {
+		if r.ensureSpace(pdfRowHeight) {
+			drawHeader()
+		}
+
+		for i, cell := range row {
+			r.pdf.CellFormat(widths[i], pdfRowHeight, r.fit(cell, widths[i]), "1", 0, aligns[i], false, 0, "")
+		}
+
+		r.pdf.Ln(-1)
+	}
+
+	drawHeader()
+
+	r.pdf.SetFont("Helvetica", "", 9)
+
+	for _, row := range rows {
+		drawRow(row)
+	}
+
+	if total != nil {
+		r.pdf.SetFont("Helvetica", "B", 9)
+		drawRow(total)
+	}
+
+	r.pdf.Ln(2)
+}
+
+// barChart draws one vector bar per month, scaled to the highest total, with the amount above
+// each bar and the month below it
+func (r *pdfReport) barChart(months []model.MonthCostJSON, colors []string) {
+	r.ensureSpace(pdfChartHeight + 15)
+
+	var maxTotal float64
+	for _, month := range months {
+		maxTotal = max(maxTotal, month.Total)
+	}
+
+	left := pdfMargin + 5
+	top := r.pdf.GetY() + 8
+	bottom := top + pdfChartHeight
+	slot := (pdfContentWidth - 10) / float64(len(months))
+	barWidth := slot * 0.6
+
+	r.pdf.SetDrawColor(120, 120, 120)
+	r.pdf.Line(left, bottom, left+slot*float64(len(months)), bottom)
+
+	r.pdf.SetFont("Helvetica", "", 9)
+
+	for i, month := range months {
+		height := 0.0
+		if maxTotal > 0 {
+			height = month.Total / maxTotal * pdfChartHeight
+		}
+
+		x := left + slot*float64(i) + (slot-barWidth)/2
+
+		red, green, blue := hexToRGB(colors[i])
+		r.pdf.SetFillColor(red, green, blue)
+		r.pdf.Rect(x, bottom-height, barWidth, height, "F")
+
+		r.pdf.SetXY(x-(slot-barWidth)/2, bottom-height-6)
+		r.pdf.CellFormat(slot, 5, formatAmount(month.Total, month.Unit), "", 0, "C", false, 0, "")
+
+		r.pdf.SetXY(x-(slot-barWidth)/2, bottom+1)
+		r.pdf.CellFormat(slot, 5, monthLabel(month.Start), "", 0, "C", false, 0, "")
+	}
+
+	r.pdf.SetXY(pdfMargin, bottom+10)
+}
+
+// ensureSpace starts a new page when fewer than height millimetres are left on the current one,
+// and reports whether it did
+func (r *pdfReport) ensureSpace(height float64) bool {
+	_, pageHeight := r.pdf.GetPageSize()
+	_, bottomMargin := r.pdf.GetAutoPageBreak()
+
+	if r.pdf.GetY()+height <= pageHeight-bottomMargin {
+		return false
+	}
+
+	r.pdf.AddPage()
+
+	return true
+}
+
+// fit translates text to the PDF code page and truncates it with an ellipsis to fit in a cell of the given width
+func (r *pdfReport) fit(text string, width float64) string {
+	text = r.translate(text)
+	available := width - 2
+
+	if r.pdf.GetStringWidth(text) <= available {
+		return text
+	}
+
+	for len(text) > 0 && r.pdf.GetStringWidth(text+"...") > available {
+		text = text[:len(text)-1]
+	}
+
+	return text + "..."
+}
+
+// monthLabel formats a YYYY-MM-DD date as "Jan 2024", returning other values unchanged
+func monthLabel(date string) string {
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+
+	return parsed.Format("Jan 2006")
+}
+
+// hexToRGB converts a "#rrggbb" color into its components, returning gray for malformed values
+func hexToRGB(color string) (int, int, int) {
+	var red, green, blue int
+
+	if _, err := fmt.Sscanf(color, "#%02x%02x%02x", &red, &green, &blue); err != nil {
+		return 128, 128, 128
+	}
+
+	return red, green, blue
+}
+
+// pdfSkippedChecksNote lists the checks that could not run, so their empty categories are not
+// mistaken for a clean account
+func pdfSkippedChecksNote(checks []model.SkippedCheckJSON) string {
+	names := make([]string, len(checks))
+	for i, check := range checks {
+		names[i] = check.Name + " (" + check.Reason + ")"
+	}
+
+	return "Skipped checks, whose findings are missing: " + strings.Join(names, ", ") + "."
+}
@@ -0,0 +1,131 @@
+package utils //nolint:revive
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestOutputCostComparisonPDF(t *testing.T) {
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 100.0, Unit: "USD"}}}
+	lastMonth.Start = aws.String("2024-01-01")
+	lastMonth.End = aws.String("2024-01-31")
+
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{"Amazon EC2": {Amount: 120.0, Unit: "USD"}}}
+	currentMonth.Start = aws.String("2024-02-01")
+	currentMonth.End = aws.String("2024-02-29")
+
+	var buf bytes.Buffer
+
+	if err := OutputCostComparisonPDF(&buf, "123456789012", 100.0, 120.0, lastMonth, currentMonth); err != nil {
+		t.Fatalf("OutputCostComparisonPDF() error = %v", err)
+	}
+
+	assertPDF(t, buf.Bytes())
+}
+
+func TestOutputTrendPDF(t *testing.T) {
+	costInfo := []model.CostInfo{
+		{CostGroup: model.CostGroup{"Total": {Amount: 100.0, Unit: "USD"}}},
+		{CostGroup: model.CostGroup{"Total": {Amount: 0, Unit: "USD"}}},
+	}
+	costInfo[0].Start = aws.String("2024-01-01")
+	costInfo[1].Start = aws.String("2024-02-01")
+
+	var buf bytes.Buffer
+
+	if err := OutputTrendPDF(&buf, "123456789012", costInfo); err != nil {
+		t.Fatalf("OutputTrendPDF() error = %v", err)
+	}
+
+	assertPDF(t, buf.Bytes())
+}
+
+func TestBuildWastePDF_Paginates(t *testing.T) {
+	volumes := make([]types.Volume, 80)
+	for i := range volumes {
+		volumes[i] = types.Volume{VolumeId: aws.String("vol-123"), Size: aws.Int32(10)}
+	}
+
+	report := buildWastePDF("123456789012", model.WasteReport{
+		UnusedVolumes: volumes,
+		KMSKeys:       []model.KMSKeyWasteInfo{{KeyID: "key-1", Description: "clé", Status: model.KMSKeyDisabled, MonthlyCost: 1}},
+	})
+
+	if report.pdf.Err() {
+		t.Fatalf("buildWastePDF() error = %v", report.pdf.Error())
+	}
+
+	if pages := report.pdf.PageNo(); pages < 3 {
+		t.Errorf("PageNo() = %d, want at least 3 pages for 81 resources", pages)
+	}
+}
+
+func TestOutputWastePDF_NoWaste(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := OutputWastePDF(&buf, "123456789012", model.WasteReport{}); err != nil {
+		t.Fatalf("OutputWastePDF() error = %v", err)
+	}
+
+	assertPDF(t, buf.Bytes())
+}
+
+func TestGroupWasteRows(t *testing.T) {
+	rows := []wasteRow{
+		{Category: "unused_ebs_volumes", MonthlyCost: 1},
+		{Category: "unused_ebs_volumes", MonthlyCost: 2},
+		{Category: "unused_kms_keys", MonthlyCost: 1},
+	}
+
+	groups := groupWasteRows(rows)
+
+	if len(groups) != 2 || len(groups[0]) != 2 || len(groups[1]) != 1 {
+		t.Fatalf("groupWasteRows() = %v, want groups of 2 and 1", groups)
+	}
+
+	if total := sumWasteCost(groups[0]); total != 3 {
+		t.Errorf("sumWasteCost() = %v, want 3", total)
+	}
+}
+
+func TestWasteCategoryTitles(t *testing.T) {
+	rows := buildWasteRows(BuildWasteReportJSON("123456789012", model.WasteReport{}))
+	if len(rows) != 0 {
+		t.Fatalf("buildWasteRows() = %v, want no rows", rows)
+	}
+
+	for _, category := range []string{"unused_elastic_ips", "cloudtrail_trails", "stale_snapshots"} {
+		if wasteCategoryTitles[category] == "" {
+			t.Errorf("missing title for %q", category)
+		}
+	}
+}
+
+func TestHexToRGB(t *testing.T) {
+	tests := []struct {
+		color            string
+		red, green, blue int
+	}{
+		{color: ColorRank1, red: 0xd7, green: 0x30, blue: 0x27},
+		{color: "", red: 128, green: 128, blue: 128},
+	}
+
+	for _, tt := range tests {
+		red, green, blue := hexToRGB(tt.color)
+		if red != tt.red || green != tt.green || blue != tt.blue {
+			t.Errorf("hexToRGB(%q) = %d, %d, %d, want %d, %d, %d", tt.color, red, green, blue, tt.red, tt.green, tt.blue)
+		}
+	}
+}
+
+func assertPDF(t *testing.T, data []byte) {
+	t.Helper()
+
+	if !bytes.HasPrefix(data, []byte("%PDF-")) {
+		t.Error("output does not start with a PDF header")
+	}
+}
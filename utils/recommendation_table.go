@@ -0,0 +1,84 @@
+package utils //nolint:revive
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// DrawRecommendationsTable renders Savings Plans and Reserved Instance purchase recommendations.
+func DrawRecommendationsTable(w io.Writer, accountID string, report model.RecommendationReport) {
+	fmt.Fprintf(w, "\n%s\n", text.FgHiWhite.Sprint(" 💡 AWS DOCTOR RECOMMENDATIONS"))
+	fmt.Fprintf(w, " Account ID: %s\n", text.FgBlue.Sprint(accountID))
+	fmt.Fprintf(w, " Term: %d year(s), %s, based on the last %d days\n", report.Options.TermInYears, report.Options.PaymentOption, report.Options.LookbackDays)
+	fmt.Fprintln(w, text.FgHiBlue.Sprint(" ------------------------------------------------"))
+
+	if len(report.SavingsPlans) == 0 && len(report.ReservedInstances) == 0 {
+		fmt.Fprintln(w, "\n"+text.FgHiGreen.Sprint(" ✅  No Savings Plans or Reserved Instance purchases recommended."))
+		return
+	}
+
+	if len(report.SavingsPlans) > 0 {
+		drawRecommendationTable(w, "Savings Plans Purchase Recommendations", "Hourly Commitment", report.SavingsPlans)
+	}
+
+	if len(report.ReservedInstances) > 0 {
+		drawRecommendationTable(w, "Reserved Instance Purchase Recommendations", "Instances", report.ReservedInstances)
+	}
+
+	fmt.Fprintf(w, " Total estimated monthly savings: %s\n", text.FgHiGreen.Sprintf("$%.2f", report.TotalMonthlySavings()))
+	fmt.Fprintln(w, text.FgHiBlack.Sprint(" Savings Plans and Reserved Instance recommendations may cover the same usage."))
+	fmt.Fprintln(w)
+}
+
+func drawRecommendationTable(w io.Writer, title, quantityHeader string, recommendations []model.PurchaseRecommendationInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle(title)
+
+	t.AppendHeader(table.Row{"Service", "Description", quantityHeader, "Upfront Cost", "Monthly Savings", "Break-even"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 3, Align: text.AlignRight},
+		{Number: 4, Align: text.AlignRight},
+		{Number: 5, Align: text.AlignRight},
+		{Number: 6, Align: text.AlignRight},
+	})
+
+	t.AppendRows(populateRecommendationRows(recommendations))
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateRecommendationRows(recommendations []model.PurchaseRecommendationInfo) []table.Row {
+	var rows []table.Row
+
+	for _, r := range recommendations {
+		quantity := fmt.Sprintf("%d", r.InstanceCount)
+		if r.CommitmentType == model.CommitmentTypeSavingsPlan {
+			quantity = fmt.Sprintf("$%.3f/h", r.HourlyCommitment)
+		}
+
+		breakEven := "Immediate"
+		if r.BreakEvenMonths == model.BreakEvenNever {
+			breakEven = "Never"
+		} else if r.BreakEvenMonths > 0 {
+			breakEven = fmt.Sprintf("%.1f months", r.BreakEvenMonths)
+		}
+
+		rows = append(rows, table.Row{
+			r.Service,
+			r.Description,
+			quantity,
+			fmt.Sprintf("$%.2f", r.UpfrontCost),
+			text.FgHiGreen.Sprintf("$%.2f (%.0f%%)", r.EstimatedMonthlySavings, r.EstimatedSavingsPercent),
+			breakEven,
+		})
+	}
+
+	return rows
+}
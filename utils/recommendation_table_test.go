@@ -0,0 +1,102 @@
+package utils //nolint:revive
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestPopulateRecommendationRows(t *testing.T) {
+	recommendations := []model.PurchaseRecommendationInfo{
+		{
+			CommitmentType:          model.CommitmentTypeSavingsPlan,
+			Service:                 "Compute Savings Plan",
+			HourlyCommitment:        0.25,
+			UpfrontCost:             0,
+			EstimatedMonthlySavings: 40,
+			EstimatedSavingsPercent: 20,
+		},
+		{
+			CommitmentType:          model.CommitmentTypeReservedInstance,
+			Service:                 "Amazon ElastiCache",
+			Description:             "cache.r6g.large / redis / us-east-1",
+			InstanceCount:           3,
+			UpfrontCost:             1200,
+			EstimatedMonthlySavings: 100,
+			BreakEvenMonths:         12,
+		},
+		{
+			CommitmentType:  model.CommitmentTypeReservedInstance,
+			Service:         "Amazon RDS",
+			InstanceCount:   1,
+			UpfrontCost:     500,
+			BreakEvenMonths: model.BreakEvenNever,
+		},
+	}
+
+	rows := populateRecommendationRows(recommendations)
+
+	if len(rows) != 3 {
+		t.Fatalf("populateRecommendationRows() returned %d rows, want 3", len(rows))
+	}
+
+	if rows[0][2] != "$0.250/h" {
+		t.Errorf("Row 0 quantity = %v, want '$0.250/h'", rows[0][2])
+	}
+
+	if rows[0][5] != "Immediate" {
+		t.Errorf("Row 0 break-even = %v, want 'Immediate'", rows[0][5])
+	}
+
+	if rows[1][2] != "3" {
+		t.Errorf("Row 1 quantity = %v, want '3'", rows[1][2])
+	}
+
+	if rows[1][3] != "$1200.00" {
+		t.Errorf("Row 1 upfront = %v, want '$1200.00'", rows[1][3])
+	}
+
+	if rows[1][5] != "12.0 months" {
+		t.Errorf("Row 1 break-even = %v, want '12.0 months'", rows[1][5])
+	}
+
+	if rows[2][5] != "Never" {
+		t.Errorf("Row 2 break-even = %v, want 'Never'", rows[2][5])
+	}
+}
+
+func TestDrawRecommendationsTable(t *testing.T) {
+	t.Run("no_recommendations", func(t *testing.T) {
+		output := captureTableOutput(func() {
+			DrawRecommendationsTable(os.Stdout, "123456789012", model.RecommendationReport{
+				Options: model.RecommendationOptions{TermInYears: 1, PaymentOption: model.PaymentOptionNoUpfront, LookbackDays: 30},
+			})
+		})
+
+		if !strings.Contains(output, "No Savings Plans or Reserved Instance purchases recommended") {
+			t.Errorf("Expected healthy message, got: %s", output)
+		}
+	})
+
+	t.Run("with_recommendations", func(t *testing.T) {
+		output := captureTableOutput(func() {
+			DrawRecommendationsTable(os.Stdout, "123456789012", model.RecommendationReport{
+				Options: model.RecommendationOptions{TermInYears: 3, PaymentOption: model.PaymentOptionAllUpfront, LookbackDays: 7},
+				SavingsPlans: []model.PurchaseRecommendationInfo{
+					{CommitmentType: model.CommitmentTypeSavingsPlan, Service: "Compute Savings Plan", EstimatedMonthlySavings: 10},
+				},
+				ReservedInstances: []model.PurchaseRecommendationInfo{
+					{CommitmentType: model.CommitmentTypeReservedInstance, Service: "Amazon Relational Database Service", EstimatedMonthlySavings: 5},
+				},
+			})
+		})
+
+		for _, want := range []string{"Savings Plans Purchase Recommendations", "Reserved Instance Purchase Recommendations", "all-upfront", "$15.00"} {
+			if !strings.Contains(output, want) {
+				t.Errorf("Expected output to contain %q", want)
+			}
+		}
+	})
+}
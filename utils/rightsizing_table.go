@@ -0,0 +1,101 @@
+package utils //nolint:revive
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/elC0mpa/aws-doctor/model"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// DrawRightsizingTable renders EC2, EBS and Lambda rightsizing recommendations grouped by resource type.
+func DrawRightsizingTable(w io.Writer, accountID string, report model.RightsizingReport) {
+	fmt.Fprintf(w, "\n%s\n", text.FgHiWhite.Sprint(" 📐 AWS DOCTOR RIGHTSIZING"))
+	fmt.Fprintf(w, " Account ID: %s\n", text.FgBlue.Sprint(accountID))
+	fmt.Fprintln(w, text.FgHiBlue.Sprint(" ------------------------------------------------"))
+
+	if !report.ComputeOptimizerEnabled {
+		fmt.Fprintln(w, text.FgHiYellow.Sprint(" ⚠️  Compute Optimizer is not enabled for this account: showing Cost Explorer EC2 recommendations only."))
+		fmt.Fprintln(w, text.FgHiYellow.Sprint("    Opt in to Compute Optimizer to include EBS volume and Lambda function recommendations."))
+	}
+
+	if len(report.Recommendations) == 0 {
+		fmt.Fprintln(w, "\n"+text.FgHiGreen.Sprint(" ✅  No rightsizing opportunities found."))
+		return
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Rightsizing Recommendations")
+
+	t.AppendHeader(table.Row{"Resource", "Resource ID", "Finding", "Current", "Recommended", "Projected CPU", "Projected Memory", "Monthly Savings"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 6, Align: text.AlignRight},
+		{Number: 7, Align: text.AlignRight},
+		{Number: 8, Align: text.AlignRight},
+	})
+
+	var hasPreviousRows bool
+
+	for _, resourceType := range []string{model.RightsizingResourceEC2Instance, model.RightsizingResourceEBSVolume, model.RightsizingResourceLambdaFunction} {
+		rows := populateRightsizingRows(report.Recommendations, resourceType)
+		if len(rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiYellow.Sprint(resourceType)
+
+		t.AppendRows(rows)
+
+		hasPreviousRows = true
+	}
+
+	t.Render()
+
+	fmt.Fprintf(w, " Total estimated monthly savings: %s\n", text.FgHiGreen.Sprintf("$%.2f", report.TotalMonthlySavings()))
+	fmt.Fprintln(w)
+}
+
+func populateRightsizingRows(recommendations []model.RightsizingRecommendationInfo, resourceType string) []table.Row {
+	var rows []table.Row
+
+	for _, r := range recommendations {
+		if r.ResourceType != resourceType {
+			continue
+		}
+
+		recommended := r.RecommendedConfiguration
+		if recommended == "" {
+			recommended = "-"
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			r.ResourceID,
+			r.Finding,
+			r.CurrentConfiguration,
+			recommended,
+			formatProjectedUtilization(r.ProjectedCPUUtilization),
+			formatProjectedUtilization(r.ProjectedMemoryUtilization),
+			fmt.Sprintf("$%.2f", r.EstimatedMonthlySavings),
+		})
+	}
+
+	return rows
+}
+
+func formatProjectedUtilization(percent float64) string {
+	if percent <= 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.1f%%", percent)
+}
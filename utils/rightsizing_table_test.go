@@ -0,0 +1,77 @@
+package utils //nolint:revive
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestPopulateRightsizingRows(t *testing.T) {
+	recommendations := []model.RightsizingRecommendationInfo{
+		{ResourceType: model.RightsizingResourceEC2Instance, ResourceID: "i-1", Finding: "Terminate", CurrentConfiguration: "m5.large", EstimatedMonthlySavings: 70},
+		{ResourceType: model.RightsizingResourceEBSVolume, ResourceID: "vol-1", CurrentConfiguration: "io1 100 GiB", RecommendedConfiguration: "gp3 100 GiB"},
+		{ResourceType: model.RightsizingResourceEC2Instance, ResourceID: "i-2", Finding: "Modify", RecommendedConfiguration: "t3.small", ProjectedCPUUtilization: 45.5},
+	}
+
+	rows := populateRightsizingRows(recommendations, model.RightsizingResourceEC2Instance)
+
+	if len(rows) != 2 {
+		t.Fatalf("populateRightsizingRows() returned %d rows, want 2", len(rows))
+	}
+
+	if rows[0][4] != "-" {
+		t.Errorf("Row 0 recommended = %v, want '-' for terminate", rows[0][4])
+	}
+
+	if rows[0][7] != "$70.00" {
+		t.Errorf("Row 0 savings = %v, want '$70.00'", rows[0][7])
+	}
+
+	if rows[1][5] != "45.5%" {
+		t.Errorf("Row 1 projected CPU = %v, want '45.5%%'", rows[1][5])
+	}
+
+	if rows[1][6] != "-" {
+		t.Errorf("Row 1 projected memory = %v, want '-'", rows[1][6])
+	}
+}
+
+func TestDrawRightsizingTable(t *testing.T) {
+	t.Run("compute_optimizer_not_enabled", func(t *testing.T) {
+		output := captureTableOutput(func() {
+			DrawRightsizingTable(os.Stdout, "123456789012", model.RightsizingReport{})
+		})
+
+		if !strings.Contains(output, "Compute Optimizer is not enabled") {
+			t.Errorf("Expected opt-in message, got: %s", output)
+		}
+
+		if !strings.Contains(output, "No rightsizing opportunities found") {
+			t.Errorf("Expected healthy message, got: %s", output)
+		}
+	})
+
+	t.Run("with_recommendations", func(t *testing.T) {
+		output := captureTableOutput(func() {
+			DrawRightsizingTable(os.Stdout, "123456789012", model.RightsizingReport{
+				ComputeOptimizerEnabled: true,
+				Recommendations: []model.RightsizingRecommendationInfo{
+					{ResourceType: model.RightsizingResourceEC2Instance, ResourceID: "i-1", EstimatedMonthlySavings: 10},
+					{ResourceType: model.RightsizingResourceLambdaFunction, ResourceID: "worker", EstimatedMonthlySavings: 2.5},
+				},
+			})
+		})
+
+		if strings.Contains(output, "Compute Optimizer is not enabled") {
+			t.Error("Did not expect opt-in message when Compute Optimizer is enabled")
+		}
+
+		for _, want := range []string{"Rightsizing Recommendations", "i-1", "worker", "$12.50"} {
+			if !strings.Contains(output, want) {
+				t.Errorf("Expected output to contain %q", want)
+			}
+		}
+	})
+}
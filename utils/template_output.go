@@ -0,0 +1,170 @@
+package utils //nolint:revive
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// templateFuncs are the helper functions available to user-defined output templates
+var templateFuncs = template.FuncMap{
+	"currency":   formatCurrency,
+	"sortBy":     func(field string, items any) (any, error) { return sortByField(field, items, false) },
+	"sortByDesc": func(field string, items any) (any, error) { return sortByField(field, items, true) },
+	"sum":        sumField,
+	"join":       strings.Join,
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+}
+
+// OutputCostComparisonTemplate executes the template at templatePath against the
+// model.CostComparisonJSON form of the cost comparison and writes the result
+func OutputCostComparisonTemplate(w io.Writer, templatePath, accountID string, lastTotalCost, currentTotalCost float64, lastMonth, currentMonth *model.CostInfo) error {
+	return executeTemplate(w, templatePath, BuildCostComparisonJSON(accountID, lastTotalCost, currentTotalCost, lastMonth, currentMonth))
+}
+
+// OutputTrendTemplate executes the template at templatePath against the model.TrendJSON form of
+// the trend report and writes the result
+func OutputTrendTemplate(w io.Writer, templatePath, accountID string, costInfo []model.CostInfo) error {
+	return executeTemplate(w, templatePath, BuildTrendJSON(accountID, costInfo))
+}
+
+// OutputWasteTemplate executes the template at templatePath against the model.WasteReportJSON
+// form of the waste report and writes the result
+func OutputWasteTemplate(w io.Writer, templatePath, accountID string, report model.WasteReport) error {
+	return executeTemplate(w, templatePath, BuildWasteReportJSON(accountID, report))
+}
+
+func executeTemplate(w io.Writer, templatePath string, data any) error {
+	content, err := os.ReadFile(templatePath)
+	if err != nil {
+		return fmt.Errorf("failed to read template: %w", err)
+	}
+
+	tmpl, err := template.New(filepath.Base(templatePath)).Funcs(templateFuncs).Parse(string(content))
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	return tmpl.Execute(w, data)
+}
+
+// formatCurrency formats an amount with two decimals, prefixed with "$" for USD (the default
+// unit) and followed by the unit otherwise
+func formatCurrency(amount float64, unit ...string) string {
+	if len(unit) == 0 || unit[0] == "" || unit[0] == "USD" {
+		if amount < 0 {
+			return fmt.Sprintf("-$%.2f", -amount)
+		}
+
+		return fmt.Sprintf("$%.2f", amount)
+	}
+
+	return fmt.Sprintf("%.2f %s", amount, unit[0])
+}
+
+// sortByField returns a sorted copy of a slice of structs, ordered by the named exported field.
+// Numbers, strings and booleans are supported.
+func sortByField(field string, items any, descending bool) (any, error) {
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("sortBy: expected a slice, got %T", items)
+	}
+
+	sorted := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+	reflect.Copy(sorted, value)
+
+	if sorted.Len() == 0 {
+		return sorted.Interface(), nil
+	}
+
+	first, err := structField(sorted.Index(0), field)
+	if err != nil {
+		return nil, fmt.Errorf("sortBy: %w", err)
+	}
+
+	if _, err := lessValue(first, first); err != nil {
+		return nil, fmt.Errorf("sortBy: %w", err)
+	}
+
+	sort.SliceStable(sorted.Interface(), func(i, j int) bool {
+		left, _ := structField(sorted.Index(i), field)
+		right, _ := structField(sorted.Index(j), field)
+
+		if descending {
+			left, right = right, left
+		}
+
+		less, _ := lessValue(left, right)
+
+		return less
+	})
+
+	return sorted.Interface(), nil
+}
+
+// sumField adds up the named numeric field of every struct in a slice
+func sumField(field string, items any) (float64, error) {
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice {
+		return 0, fmt.Errorf("sum: expected a slice, got %T", items)
+	}
+
+	var total float64
+
+	for i := range value.Len() {
+		fieldValue, err := structField(value.Index(i), field)
+		if err != nil {
+			return 0, fmt.Errorf("sum: %w", err)
+		}
+
+		switch {
+		case fieldValue.CanFloat():
+			total += fieldValue.Float()
+		case fieldValue.CanInt():
+			total += float64(fieldValue.Int())
+		default:
+			return 0, fmt.Errorf("sum: field %s is not numeric", field)
+		}
+	}
+
+	return total, nil
+}
+
+func structField(item reflect.Value, field string) (reflect.Value, error) {
+	item = reflect.Indirect(item)
+	if item.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("expected a slice of structs, got %s", item.Type())
+	}
+
+	fieldValue := item.FieldByName(field)
+	if !fieldValue.IsValid() {
+		return reflect.Value{}, fmt.Errorf("%s has no field %s", item.Type(), field)
+	}
+
+	return fieldValue, nil
+}
+
+func lessValue(left, right reflect.Value) (bool, error) {
+	switch {
+	case left.CanFloat():
+		return left.Float() < right.Float(), nil
+	case left.CanInt():
+		return left.Int() < right.Int(), nil
+	case left.CanUint():
+		return left.Uint() < right.Uint(), nil
+	case left.Kind() == reflect.String:
+		return left.String() < right.String(), nil
+	case left.Kind() == reflect.Bool:
+		return !left.Bool() && right.Bool(), nil
+	default:
+		return false, fmt.Errorf("cannot sort by a field of type %s", left.Type())
+	}
+}
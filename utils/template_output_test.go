@@ -0,0 +1,183 @@
+package utils //nolint:revive
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func writeTemplateFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "report.tmpl")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+
+	return path
+}
+
+func TestOutputCostComparisonTemplate(t *testing.T) {
+	lastMonth := &model.CostInfo{CostGroup: model.CostGroup{
+		"Amazon EC2": {Amount: 100.0, Unit: "USD"},
+		"Amazon S3":  {Amount: 50.0, Unit: "USD"},
+	}}
+	currentMonth := &model.CostInfo{CostGroup: model.CostGroup{
+		"Amazon EC2": {Amount: 120.0, Unit: "USD"},
+		"Amazon S3":  {Amount: 45.0, Unit: "USD"},
+	}}
+
+	path := writeTemplateFile(t, `{{.AccountID}}:{{range sortByDesc "CurrentCost" .ServiceBreakdown}} {{.Service}}={{currency .CurrentCost .Unit}}{{end}}`)
+
+	var err error
+
+	output := captureStdout(func() {
+		err = OutputCostComparisonTemplate(os.Stdout, path, "123456789012", 150.0, 165.0, lastMonth, currentMonth)
+	})
+
+	if err != nil {
+		t.Fatalf("OutputCostComparisonTemplate() error = %v", err)
+	}
+
+	if want := "123456789012: Amazon EC2=$120.00 Amazon S3=$45.00"; output != want {
+		t.Errorf("output = %q, want %q", output, want)
+	}
+}
+
+func TestOutputTrendTemplate(t *testing.T) {
+	costInfo := []model.CostInfo{
+		{CostGroup: model.CostGroup{"Total": {Amount: 100.0, Unit: "USD"}}},
+		{CostGroup: model.CostGroup{"Total": {Amount: 50.0, Unit: "USD"}}},
+	}
+
+	path := writeTemplateFile(t, `{{currency (sum "Total" .Months)}}`)
+
+	output := captureStdout(func() {
+		_ = OutputTrendTemplate(os.Stdout, path, "123456789012", costInfo)
+	})
+
+	if output != "$150.00" {
+		t.Errorf("output = %q, want $150.00", output)
+	}
+}
+
+func TestOutputWasteTemplate(t *testing.T) {
+	report := model.WasteReport{
+		UnusedVolumes: []types.Volume{
+			{VolumeId: aws.String("vol-b"), Size: aws.Int32(10)},
+			{VolumeId: aws.String("vol-a"), Size: aws.Int32(20)},
+		},
+	}
+
+	path := writeTemplateFile(t, `{{range .UnusedEBSVolumes | sortBy "VolumeID"}}{{.VolumeID}} {{end}}{{if .HasWaste}}waste{{end}}`)
+
+	output := captureStdout(func() {
+		_ = OutputWasteTemplate(os.Stdout, path, "123456789012", report)
+	})
+
+	if output != "vol-a vol-b waste" {
+		t.Errorf("output = %q, want %q", output, "vol-a vol-b waste")
+	}
+}
+
+func TestOutputWasteTemplate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  string
+	}{
+		{name: "parse_error", template: "{{.AccountID", wantErr: "failed to parse template"},
+		{name: "unknown_field", template: `{{sortBy "Missing" .UnusedEBSVolumes}}`, wantErr: ""},
+		{name: "not_a_slice", template: `{{sortBy "VolumeID" .AccountID}}`, wantErr: "expected a slice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTemplateFile(t, tt.template)
+
+			var err error
+
+			captureStdout(func() {
+				err = OutputWasteTemplate(os.Stdout, path, "123456789012", model.WasteReport{})
+			})
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error for an empty slice: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := OutputWasteTemplate(os.Stdout, filepath.Join(t.TempDir(), "missing.tmpl"), "123456789012", model.WasteReport{}); err == nil {
+		t.Error("expected an error for a missing template file")
+	}
+}
+
+func TestFormatCurrency(t *testing.T) {
+	tests := []struct {
+		amount float64
+		unit   []string
+		want   string
+	}{
+		{amount: 12.345, want: "$12.35"},
+		{amount: -5, unit: []string{"USD"}, want: "-$5.00"},
+		{amount: 7, unit: []string{"EUR"}, want: "7.00 EUR"},
+	}
+
+	for _, tt := range tests {
+		if got := formatCurrency(tt.amount, tt.unit...); got != tt.want {
+			t.Errorf("formatCurrency(%v, %v) = %q, want %q", tt.amount, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func TestSortByField(t *testing.T) {
+	items := []model.EBSVolumeJSON{{VolumeID: "b", Size: 2}, {VolumeID: "a", Size: 3}, {VolumeID: "c", Size: 1}}
+
+	sorted, err := sortByField("Size", items, true)
+	if err != nil {
+		t.Fatalf("sortByField() error = %v", err)
+	}
+
+	got := sorted.([]model.EBSVolumeJSON)
+	if got[0].VolumeID != "a" || got[1].VolumeID != "b" || got[2].VolumeID != "c" {
+		t.Errorf("sortByField() = %v, want a, b, c", got)
+	}
+
+	if items[0].VolumeID != "b" {
+		t.Error("sortByField() must not modify its input")
+	}
+
+	if _, err := sortByField("Missing", items, false); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+
+	if _, err := sortByField("SnapshotIDs", []model.AMIJSON{{}}, false); err == nil {
+		t.Error("expected an error for an unsortable field")
+	}
+}
+
+func TestSumField(t *testing.T) {
+	items := []model.EBSVolumeJSON{{Size: 2}, {Size: 3}}
+
+	total, err := sumField("Size", items)
+	if err != nil || total != 5 {
+		t.Errorf("sumField() = %v, %v, want 5", total, err)
+	}
+
+	if _, err := sumField("VolumeID", items); err == nil {
+		t.Error("expected an error for a non-numeric field")
+	}
+}
@@ -0,0 +1,117 @@
+package utils //nolint:revive
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+// wasteResourceMetadata holds the details of a resource that the flattened waste rows leave out
+type wasteResourceMetadata struct {
+	createdAt time.Time
+	tags      map[string]string
+}
+
+// BuildWasteFindings flattens a waste report into one finding per resource, in the order of the
+// JSON report, adding the creation date and tags where the AWS APIs return them
+func BuildWasteFindings(report model.WasteReport) []model.WasteFinding {
+	metadata := collectWasteMetadata(report)
+	rows := buildWasteRows(BuildWasteReportJSON("", report))
+	findings := make([]model.WasteFinding, 0, len(rows))
+
+	for _, row := range rows {
+		meta := metadata[row.ResourceID]
+
+		findings = append(findings, model.WasteFinding{
+			Category:      row.Category,
+			CategoryTitle: wasteCategoryTitles[row.Category],
+			ResourceID:    row.ResourceID,
+			ResourceName:  row.ResourceName,
+			Status:        row.Status,
+			Details:       row.Details,
+			MonthlyCost:   row.MonthlyCost,
+			CreatedAt:     meta.createdAt,
+			Tags:          meta.tags,
+		})
+	}
+
+	return findings
+}
+
+// collectWasteMetadata indexes creation dates and tags by the resource ID used in the waste rows
+func collectWasteMetadata(report model.WasteReport) map[string]wasteResourceMetadata {
+	metadata := make(map[string]wasteResourceMetadata)
+
+	add := func(id *string, createdAt *time.Time, tags []types.Tag) {
+		if id == nil {
+			return
+		}
+
+		meta := wasteResourceMetadata{tags: ec2TagMap(tags)}
+		if createdAt != nil {
+			meta.createdAt = *createdAt
+		}
+
+		metadata[*id] = meta
+	}
+
+	for _, ip := range report.ElasticIPs {
+		add(ip.AllocationId, nil, ip.Tags)
+	}
+
+	for _, volume := range append(append([]types.Volume{}, report.UnusedVolumes...), report.StoppedVolumes...) {
+		add(volume.VolumeId, volume.CreateTime, volume.Tags)
+	}
+
+	for _, instance := range report.StoppedInstances {
+		add(instance.InstanceId, instance.LaunchTime, instance.Tags)
+	}
+
+	for _, lb := range report.LoadBalancers {
+		if lb.LoadBalancerArn != nil && lb.CreatedTime != nil {
+			metadata[*lb.LoadBalancerArn] = wasteResourceMetadata{createdAt: *lb.CreatedTime}
+		}
+	}
+
+	for _, host := range report.DedicatedHosts {
+		metadata[host.HostID] = wasteResourceMetadata{createdAt: host.AllocationTime}
+	}
+
+	for _, ami := range report.UnusedAMIs {
+		metadata[ami.ImageID] = wasteResourceMetadata{createdAt: ami.CreationDate}
+	}
+
+	for _, snapshot := range report.Snapshots {
+		metadata[snapshot.SnapshotID] = wasteResourceMetadata{createdAt: snapshot.StartTime}
+	}
+
+	for _, snapshot := range report.RedshiftSnapshots {
+		metadata[snapshot.SnapshotIdentifier] = wasteResourceMetadata{createdAt: snapshot.CreateTime}
+	}
+
+	for _, recoveryPoint := range report.BackupRecoveryPoints {
+		metadata[recoveryPoint.RecoveryPointArn] = wasteResourceMetadata{createdAt: recoveryPoint.CreationDate}
+	}
+
+	for _, key := range report.KMSKeys {
+		metadata[key.KeyID] = wasteResourceMetadata{createdAt: key.CreationDate}
+	}
+
+	return metadata
+}
+
+func ec2TagMap(tags []types.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			result[*tag.Key] = *tag.Value
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,58 @@
+package utils //nolint:revive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elC0mpa/aws-doctor/model"
+)
+
+func TestBuildWasteFindings(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	keyCreated := time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	report := model.WasteReport{
+		UnusedVolumes: []types.Volume{{
+			VolumeId:   aws.String("vol-123"),
+			Size:       aws.Int32(100),
+			CreateTime: aws.Time(created),
+			Tags:       []types.Tag{{Key: aws.String("team"), Value: aws.String("data")}},
+		}},
+		KMSKeys: []model.KMSKeyWasteInfo{{KeyID: "key-1", Status: model.KMSKeyDisabled, CreationDate: keyCreated, MonthlyCost: 1}},
+		ECSServices: []model.ECSServiceWasteInfo{
+			{ClusterName: "prod", ServiceName: "api", EstimatedMonthlyCost: 16.2},
+		},
+	}
+
+	findings := BuildWasteFindings(report)
+	if len(findings) != 3 {
+		t.Fatalf("got %d findings, want 3", len(findings))
+	}
+
+	volume := findings[0]
+	if volume.Category != "unused_ebs_volumes" || volume.CategoryTitle != "Unused EBS Volumes" || volume.Details != "100 GiB" {
+		t.Errorf("volume finding = %+v", volume)
+	}
+
+	if !volume.CreatedAt.Equal(created) || volume.Tags["team"] != "data" {
+		t.Errorf("volume metadata = %v, %v", volume.CreatedAt, volume.Tags)
+	}
+
+	service := findings[1]
+	if service.ResourceID != "prod/api" || service.MonthlyCost != 16.2 || !service.CreatedAt.IsZero() || service.Tags != nil {
+		t.Errorf("ECS service finding = %+v", service)
+	}
+
+	key := findings[2]
+	if key.ResourceID != "key-1" || !key.CreatedAt.Equal(keyCreated) || key.MonthlyCost != 1 {
+		t.Errorf("KMS key finding = %+v", key)
+	}
+}
+
+func TestBuildWasteFindings_NoWaste(t *testing.T) {
+	if findings := BuildWasteFindings(model.WasteReport{}); len(findings) != 0 {
+		t.Errorf("got %d findings, want none", len(findings))
+	}
+}
@@ -2,7 +2,8 @@ package utils //nolint:revive
 
 import (
 	"fmt"
-	"os"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,53 +15,97 @@ import (
 )
 
 // DrawWasteTable renders a table containing detected AWS waste.
-func DrawWasteTable(accountID string, elasticIPInfo []types.Address, unusedEBSVolumeInfo []types.Volume, attachedToStoppedInstancesEBSVolumeInfo []types.Volume, expireReservedInstancesInfo []model.RiExpirationInfo, instancesStoppedMoreThan30Days []types.Instance, unusedLoadBalancers []elbtypes.LoadBalancer, unusedAMIs []model.AMIWasteInfo, orphanedSnapshots []model.SnapshotWasteInfo) {
-	fmt.Printf("\n%s\n", text.FgHiWhite.Sprint(" 🏥 AWS DOCTOR CHECKUP"))
-	fmt.Printf(" Account ID: %s\n", text.FgBlue.Sprint(accountID))
-	fmt.Println(text.FgHiBlue.Sprint(" ------------------------------------------------"))
-
-	hasWaste := len(elasticIPInfo) > 0 ||
-		len(unusedEBSVolumeInfo) > 0 ||
-		len(attachedToStoppedInstancesEBSVolumeInfo) > 0 ||
-		len(instancesStoppedMoreThan30Days) > 0 ||
-		len(expireReservedInstancesInfo) > 0 ||
-		len(unusedLoadBalancers) > 0 ||
-		len(unusedAMIs) > 0 ||
-		len(orphanedSnapshots) > 0
-
-	if !hasWaste {
-		fmt.Println("\n" + text.FgHiGreen.Sprint(" ✅  Your account is healthy! No waste found."))
+func DrawWasteTable(w io.Writer, accountID string, report model.WasteReport) {
+	fmt.Fprintf(w, "\n%s\n", text.FgHiWhite.Sprint(" 🏥 AWS DOCTOR CHECKUP"))
+	fmt.Fprintf(w, " Account ID: %s\n", text.FgBlue.Sprint(accountID))
+	fmt.Fprintln(w, text.FgHiBlue.Sprint(" ------------------------------------------------"))
+
+	if !report.HasWaste() {
+		fmt.Fprintln(w, "\n"+text.FgHiGreen.Sprint(" ✅  Your account is healthy! No waste found."))
 		return
 	}
 
-	if len(unusedEBSVolumeInfo) > 0 || len(attachedToStoppedInstancesEBSVolumeInfo) > 0 {
-		drawEBSTable(unusedEBSVolumeInfo, attachedToStoppedInstancesEBSVolumeInfo)
+	drawComputeWaste(w, report)
+	drawManagedServiceWaste(w, report)
+	drawImageWaste(w, report)
+}
+
+// drawComputeWaste renders the EC2, load balancer and container tables of the report.
+func drawComputeWaste(w io.Writer, report model.WasteReport) {
+	if len(report.UnusedVolumes) > 0 || len(report.StoppedVolumes) > 0 {
+		drawEBSTable(w, report.UnusedVolumes, report.StoppedVolumes)
+	}
+
+	if len(report.ElasticIPs) > 0 {
+		drawElasticIPTable(w, report.ElasticIPs)
+	}
+
+	if len(report.StoppedInstances) > 0 || len(report.ReservedInstances) > 0 {
+		drawEC2Table(w, report.StoppedInstances, report.ReservedInstances)
+	}
+
+	if len(report.CapacityReservations) > 0 || len(report.DedicatedHosts) > 0 {
+		drawEC2CapacityTable(w, report.CapacityReservations, report.DedicatedHosts)
+	}
+
+	if len(report.LoadBalancers) > 0 || len(report.ClassicLoadBalancers) > 0 {
+		drawLoadBalancerTable(w, report.LoadBalancers, report.ClassicLoadBalancers)
+	}
+
+	if len(report.EKSClusters) > 0 || len(report.ECSServices) > 0 {
+		drawContainersTable(w, report.EKSClusters, report.ECSServices)
+	}
+}
+
+// drawManagedServiceWaste renders the tables of managed services such as SageMaker, Redshift and Route 53.
+func drawManagedServiceWaste(w io.Writer, report model.WasteReport) {
+	if len(report.SageMakerEndpoints) > 0 || len(report.SageMakerNotebooks) > 0 {
+		drawSageMakerTable(w, report.SageMakerEndpoints, report.SageMakerNotebooks)
+	}
+
+	if len(report.RedshiftClusters) > 0 || len(report.RedshiftSnapshots) > 0 {
+		drawRedshiftTable(w, report.RedshiftClusters, report.RedshiftSnapshots)
+	}
+
+	if len(report.EFSFileSystems) > 0 {
+		drawEFSTable(w, report.EFSFileSystems)
+	}
+
+	if len(report.BackupRecoveryPoints) > 0 {
+		drawBackupTable(w, report.BackupRecoveryPoints)
+	}
+
+	if len(report.Secrets) > 0 || len(report.KMSKeys) > 0 {
+		drawSecretsTable(w, report.Secrets, report.KMSKeys)
 	}
 
-	if len(elasticIPInfo) > 0 {
-		drawElasticIPTable(elasticIPInfo)
+	if len(report.Route53HostedZones) > 0 || len(report.Route53HealthChecks) > 0 {
+		drawRoute53Table(w, report.Route53HostedZones, report.Route53HealthChecks)
 	}
 
-	if len(instancesStoppedMoreThan30Days) > 0 || len(expireReservedInstancesInfo) > 0 {
-		drawEC2Table(instancesStoppedMoreThan30Days, expireReservedInstancesInfo)
+	if len(report.KinesisStreams) > 0 || len(report.MSKClusters) > 0 {
+		drawStreamingTable(w, report.KinesisStreams, report.MSKClusters)
 	}
 
-	if len(unusedLoadBalancers) > 0 {
-		drawLoadBalancerTable(unusedLoadBalancers)
+	if len(report.CloudTrailTrails) > 0 {
+		drawCloudTrailTable(w, report.CloudTrailTrails)
 	}
+}
 
-	if len(unusedAMIs) > 0 {
-		drawAMITable(unusedAMIs)
+// drawImageWaste renders the AMI and EBS snapshot tables of the report.
+func drawImageWaste(w io.Writer, report model.WasteReport) {
+	if len(report.UnusedAMIs) > 0 {
+		drawAMITable(w, report.UnusedAMIs)
 	}
 
-	if len(orphanedSnapshots) > 0 {
-		drawSnapshotTable(orphanedSnapshots)
+	if len(report.Snapshots) > 0 {
+		drawSnapshotTable(w, report.Snapshots)
 	}
 }
 
-func drawEBSTable(unusedEBSVolumeInfo []types.Volume, attachedToStoppedInstancesEBSVolumeInfo []types.Volume) {
+func drawEBSTable(w io.Writer, unusedEBSVolumeInfo []types.Volume, attachedToStoppedInstancesEBSVolumeInfo []types.Volume) {
 	t := table.NewWriter()
-	t.SetOutputMirror(os.Stdout)
+	t.SetOutputMirror(w)
 	t.SetStyle(table.StyleRounded)
 	t.SetTitle("EBS Volume Waste")
 
@@ -99,13 +144,13 @@ func drawEBSTable(unusedEBSVolumeInfo []types.Volume, attachedToStoppedInstances
 
 	if t.Length() > 0 {
 		t.Render()
-		fmt.Println()
+		fmt.Fprintln(w)
 	}
 }
 
-func drawEC2Table(instances []types.Instance, ris []model.RiExpirationInfo) {
+func drawEC2Table(w io.Writer, instances []types.Instance, ris []model.RiExpirationInfo) {
 	t := table.NewWriter()
-	t.SetOutputMirror(os.Stdout)
+	t.SetOutputMirror(w)
 	t.SetStyle(table.StyleRounded)
 	t.SetTitle("EC2 & Reserved Instance Waste")
 
@@ -172,12 +217,82 @@ func drawEC2Table(instances []types.Instance, ris []model.RiExpirationInfo) {
 	}
 
 	t.Render()
-	fmt.Println()
+	fmt.Fprintln(w)
+}
+
+func drawEC2CapacityTable(w io.Writer, reservations []model.CapacityReservationWasteInfo, hosts []model.DedicatedHostWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("EC2 Reserved Capacity Waste")
+
+	t.AppendHeader(table.Row{"Status", "Resource ID", "Instance Type", "AZ", "Usage"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 5, Align: text.AlignRight},
+	})
+
+	var unused, underutilized []model.CapacityReservationWasteInfo
+
+	for _, cr := range reservations {
+		if cr.Status == "UNUSED" {
+			unused = append(unused, cr)
+		} else {
+			underutilized = append(underutilized, cr)
+		}
+	}
+
+	var hasPreviousRows bool
+
+	if len(unused) > 0 {
+		statusLabel := "Capacity Reservation\n(Unused)"
+		rows := populateCapacityReservationRows(unused)
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiRed.Sprint(statusLabel)
+
+		t.AppendRows(rows)
+
+		hasPreviousRows = true
+	}
+
+	if len(underutilized) > 0 {
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		statusLabel := "Capacity Reservation\n(Underutilized)"
+		rows := populateCapacityReservationRows(underutilized)
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiYellow.Sprint(statusLabel)
+
+		t.AppendRows(rows)
+
+		hasPreviousRows = true
+	}
+
+	if len(hosts) > 0 {
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		statusLabel := "Dedicated Host\n(No Instances)"
+		rows := populateDedicatedHostRows(hosts)
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiRed.Sprint(statusLabel)
+
+		t.AppendRows(rows)
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
 }
 
-func drawElasticIPTable(elasticIPInfo []types.Address) {
+func drawElasticIPTable(w io.Writer, elasticIPInfo []types.Address) {
 	t := table.NewWriter()
-	t.SetOutputMirror(os.Stdout)
+	t.SetOutputMirror(w)
 	t.SetStyle(table.StyleRounded)
 	t.SetTitle("Elastic IP Waste")
 
@@ -193,7 +308,7 @@ func drawElasticIPTable(elasticIPInfo []types.Address) {
 
 	t.AppendRows(rows)
 	t.Render()
-	fmt.Println()
+	fmt.Fprintln(w)
 }
 
 func populateEBSRows(volumes []types.Volume) []table.Row {
@@ -290,25 +405,100 @@ func populateRiRows(ris []model.RiExpirationInfo) []table.Row {
 	return rows
 }
 
-func drawLoadBalancerTable(loadBalancers []elbtypes.LoadBalancer) {
+func populateCapacityReservationRows(reservations []model.CapacityReservationWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, cr := range reservations {
+		used := cr.TotalInstanceCount - cr.AvailableInstanceCount
+
+		rows = append(rows, table.Row{
+			"",
+			cr.CapacityReservationID,
+			cr.InstanceType,
+			cr.AvailabilityZone,
+			fmt.Sprintf("%d/%d used (%.0f%%)", used, cr.TotalInstanceCount, cr.UtilizationPercent),
+		})
+	}
+
+	return rows
+}
+
+func populateDedicatedHostRows(hosts []model.DedicatedHostWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, host := range hosts {
+		instanceType := host.InstanceType
+		if instanceType == "" {
+			instanceType = host.InstanceFamily
+		}
+
+		usage := "0 instances"
+		if !host.AllocationTime.IsZero() {
+			usage = fmt.Sprintf("0 instances, %d days", host.DaysSinceAllocation)
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			host.HostID,
+			instanceType,
+			host.AvailabilityZone,
+			usage,
+		})
+	}
+
+	return rows
+}
+
+func drawLoadBalancerTable(w io.Writer, loadBalancers []elbtypes.LoadBalancer, classicLoadBalancers []model.ClassicLoadBalancerWasteInfo) {
 	t := table.NewWriter()
-	t.SetOutputMirror(os.Stdout)
+	t.SetOutputMirror(w)
 	t.SetStyle(table.StyleRounded)
 	t.SetTitle("Load Balancer Waste")
 
 	t.AppendHeader(table.Row{"Status", "Name", "Type"})
 
+	var hasPreviousRows bool
+
 	statusUnused := "No Target Groups"
 	rows := populateLoadBalancerRows(loadBalancers)
 
 	if len(rows) > 0 {
 		halfRow := len(rows) / 2
 		rows[halfRow][0] = text.FgHiRed.Sprint(statusUnused)
+
+		t.AppendRows(rows)
+
+		hasPreviousRows = true
+	}
+
+	classicGroups := []struct {
+		status string
+		label  string
+	}{
+		{status: model.ClassicLoadBalancerNoInstances, label: "No Instances"},
+		{status: model.ClassicLoadBalancerAllOutOfService, label: "All Instances\nOutOfService"},
+	}
+
+	for _, group := range classicGroups {
+		rows := populateClassicLoadBalancerRows(classicLoadBalancers, group.status)
+		if len(rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(rows)
+
+		hasPreviousRows = true
 	}
 
-	t.AppendRows(rows)
 	t.Render()
-	fmt.Println()
+	fmt.Fprintln(w)
 }
 
 func populateLoadBalancerRows(loadBalancers []elbtypes.LoadBalancer) []table.Row {
@@ -328,117 +518,944 @@ func populateLoadBalancerRows(loadBalancers []elbtypes.LoadBalancer) []table.Row
 	return rows
 }
 
-func drawAMITable(amis []model.AMIWasteInfo) {
+func populateClassicLoadBalancerRows(loadBalancers []model.ClassicLoadBalancerWasteInfo, status string) []table.Row {
+	var rows []table.Row
+
+	for _, lb := range loadBalancers {
+		if lb.Status != status {
+			continue
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			lb.Name,
+			"classic",
+		})
+	}
+
+	return rows
+}
+
+func drawContainersTable(w io.Writer, clusters []model.EKSClusterWasteInfo, services []model.ECSServiceWasteInfo) {
 	t := table.NewWriter()
-	t.SetOutputMirror(os.Stdout)
+	t.SetOutputMirror(w)
 	t.SetStyle(table.StyleRounded)
-	t.SetTitle("Unused AMI Waste (Verify before delete - may be used by ASGs/Launch Templates)")
+	t.SetTitle("Containers Waste")
 
-	t.AppendHeader(table.Row{"Status", "AMI ID", "Name", "Age (Days)", "Max Savings/Mo"})
+	t.AppendHeader(table.Row{"Status", "Resource", "Details", "Est. Cost/Mo"})
 
 	t.SetColumnConfigs([]table.ColumnConfig{
 		{Number: 4, Align: text.AlignRight},
-		{Number: 5, Align: text.AlignRight},
 	})
 
-	rows := populateAMIRows(amis)
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "EKS Cluster\n(No Node Groups)", rows: populateEKSClusterRows(clusters, model.EKSClusterNoNodeGroups)},
+		{label: "EKS Cluster\n(Zero Nodes)", rows: populateEKSClusterRows(clusters, model.EKSClusterZeroNodes)},
+		{label: "EKS Cluster\n(Extended Support)", rows: populateEKSClusterRows(clusters, "")},
+		{label: "ECS Service\n(0 Tasks)", rows: populateECSServiceRows(services)},
+	}
 
-	if len(rows) > 0 {
-		halfRow := len(rows) / 2
-		rows[halfRow][0] = text.FgHiYellow.Sprint("Unused*")
+	var hasPreviousRows bool
+
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(group.rows)
+
+		hasPreviousRows = true
 	}
 
-	t.AppendRows(rows)
 	t.Render()
-	fmt.Println(text.FgHiYellow.Sprint(" * Warning: AMIs may be referenced by Auto Scaling Groups or Launch Templates"))
-	fmt.Println()
+	fmt.Fprintln(w)
 }
 
-func populateAMIRows(amis []model.AMIWasteInfo) []table.Row {
+// populateEKSClusterRows returns rows for clusters with the given idle status; an empty
+// status selects clusters that are only reported for running an extended-support version.
+func populateEKSClusterRows(clusters []model.EKSClusterWasteInfo, status string) []table.Row {
 	var rows []table.Row
 
-	for _, ami := range amis {
-		name := ami.Name
-		if len(name) > 30 {
-			name = name[:27] + "..."
+	for _, cluster := range clusters {
+		if cluster.Status != status {
+			continue
+		}
+
+		version := fmt.Sprintf("Kubernetes %s", cluster.KubernetesVersion)
+		if cluster.ExtendedSupport {
+			version += " (extended support)"
 		}
 
 		rows = append(rows, table.Row{
 			"",
-			ami.ImageID,
-			name,
-			fmt.Sprintf("%d days", ami.DaysSinceCreate),
-			fmt.Sprintf("$%.2f", ami.MaxPotentialSaving),
+			cluster.ClusterName,
+			fmt.Sprintf("%s, %d node groups", version, cluster.NodeGroupCount),
+			fmt.Sprintf("$%.2f", cluster.EstimatedMonthlyCost),
+		})
+	}
+
+	return rows
+}
+
+func populateECSServiceRows(services []model.ECSServiceWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, svc := range services {
+		rows = append(rows, table.Row{
+			"",
+			fmt.Sprintf("%s/%s", svc.ClusterName, svc.ServiceName),
+			fmt.Sprintf("%d target groups, %d load balancers", len(svc.TargetGroupArns), len(svc.LoadBalancerArns)),
+			fmt.Sprintf("$%.2f", svc.EstimatedMonthlyCost),
 		})
 	}
 
 	return rows
 }
 
-func drawSnapshotTable(snapshots []model.SnapshotWasteInfo) {
+func drawSageMakerTable(w io.Writer, endpoints []model.SageMakerEndpointWasteInfo, notebooks []model.SageMakerNotebookWasteInfo) {
 	t := table.NewWriter()
-	t.SetOutputMirror(os.Stdout)
+	t.SetOutputMirror(w)
 	t.SetStyle(table.StyleRounded)
-	t.SetTitle("EBS Snapshot Waste")
+	t.SetTitle("SageMaker Waste")
 
-	t.AppendHeader(table.Row{"Status", "Snapshot ID", "Reason", "Size (GB)", "Max Savings/MO"})
+	t.AppendHeader(table.Row{"Status", "Resource", "Instance Type", "Count", "Details", "Est. Cost/Hr"})
 
 	t.SetColumnConfigs([]table.ColumnConfig{
 		{Number: 4, Align: text.AlignRight},
-		{Number: 5, Align: text.AlignRight},
+		{Number: 6, Align: text.AlignRight},
 	})
 
-	// Separate orphaned and stale snapshots
-	var orphaned, stale []model.SnapshotWasteInfo
-
-	for _, snap := range snapshots {
-		if snap.Category == model.SnapshotCategoryOrphaned {
-			orphaned = append(orphaned, snap)
-		} else {
-			stale = append(stale, snap)
-		}
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "Endpoint\n(No Invocations)", rows: populateSageMakerEndpointRows(endpoints)},
+		{label: "Notebook\n(Long Running)", rows: populateSageMakerNotebookRows(notebooks)},
 	}
 
 	var hasPreviousRows bool
 
-	if len(orphaned) > 0 {
-		statusLabel := "Orphaned(Volume Deleted)"
-		rows := populateSnapshotRows(orphaned)
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
 
-		halfRow := len(rows) / 2
-		rows[halfRow][0] = text.FgHiRed.Sprint(statusLabel)
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
 
-		t.AppendRows(rows)
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(group.rows)
 
 		hasPreviousRows = true
 	}
 
-	if len(stale) > 0 {
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateSageMakerEndpointRows(endpoints []model.SageMakerEndpointWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, endpoint := range endpoints {
+		rows = append(rows, table.Row{
+			"",
+			fmt.Sprintf("%s/%s", endpoint.EndpointName, endpoint.VariantName),
+			endpoint.InstanceType,
+			endpoint.InstanceCount,
+			fmt.Sprintf("0 invocations in %d days", endpoint.IdleDays),
+			formatHourlyCost(endpoint.HourlyCost),
+		})
+	}
+
+	return rows
+}
+
+func populateSageMakerNotebookRows(notebooks []model.SageMakerNotebookWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, notebook := range notebooks {
+		rows = append(rows, table.Row{
+			"",
+			notebook.NotebookInstanceName,
+			notebook.InstanceType,
+			1,
+			fmt.Sprintf("Running for %d days without restart", notebook.DaysSinceModified),
+			formatHourlyCost(notebook.HourlyCost),
+		})
+	}
+
+	return rows
+}
+
+// formatHourlyCost formats an estimated hourly cost, using "n/a" for instance types without a known price.
+func formatHourlyCost(cost float64) string {
+	if cost == 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("$%.4f", cost)
+}
+
+func drawRedshiftTable(w io.Writer, clusters []model.RedshiftClusterWasteInfo, snapshots []model.RedshiftSnapshotWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Redshift Waste")
+
+	t.AppendHeader(table.Row{"Status", "Resource", "Node Type", "Nodes", "Details", "Est. Cost/Mo"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+		{Number: 6, Align: text.AlignRight},
+	})
+
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "Cluster\n(No Connections)", rows: populateRedshiftClusterRows(clusters, model.RedshiftClusterNoConnections)},
+		{label: "Cluster\n(Low CPU)", rows: populateRedshiftClusterRows(clusters, model.RedshiftClusterLowCPU)},
+		{label: "Manual Snapshot\n(Stale)", rows: populateRedshiftSnapshotRows(snapshots)},
+	}
+
+	var hasPreviousRows bool
+
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
+
 		if hasPreviousRows {
 			t.AppendSeparator()
 		}
 
-		statusLabel := "Stale(Old Backup > 90 days)"
-		rows := populateSnapshotRows(stale)
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
 
-		halfRow := len(rows) / 2
-		rows[halfRow][0] = text.FgHiYellow.Sprint(statusLabel)
+		t.AppendRows(group.rows)
 
-		t.AppendRows(rows)
+		hasPreviousRows = true
 	}
 
 	t.Render()
-	fmt.Println()
+	fmt.Fprintln(w)
 }
 
-func populateSnapshotRows(snapshots []model.SnapshotWasteInfo) []table.Row {
+func populateRedshiftClusterRows(clusters []model.RedshiftClusterWasteInfo, status string) []table.Row {
 	var rows []table.Row
 
-	for _, snap := range snapshots {
+	for _, cluster := range clusters {
+		if cluster.Status != status {
+			continue
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			cluster.ClusterIdentifier,
+			cluster.NodeType,
+			cluster.NodeCount,
+			fmt.Sprintf("%.0f max connections, %.1f%% avg CPU in %d days\n%s", cluster.MaxConnections, cluster.AverageCPUPercent, cluster.ObservationDays, cluster.Recommendation),
+			formatMonthlyCost(cluster.EstimatedMonthlyCost),
+		})
+	}
+
+	return rows
+}
+
+func populateRedshiftSnapshotRows(snapshots []model.RedshiftSnapshotWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, snapshot := range snapshots {
+		rows = append(rows, table.Row{
+			"",
+			fmt.Sprintf("%s (%s)", snapshot.SnapshotIdentifier, snapshot.ClusterIdentifier),
+			snapshot.NodeType,
+			snapshot.NodeCount,
+			fmt.Sprintf("%.1f GB, created %d days ago", snapshot.SizeGB, snapshot.DaysSinceCreate),
+			fmt.Sprintf("$%.2f", snapshot.EstimatedMonthlyCost),
+		})
+	}
+
+	return rows
+}
+
+// formatMonthlyCost formats an estimated monthly cost, using "n/a" for resources without a known price.
+func formatMonthlyCost(cost float64) string {
+	if cost == 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("$%.2f", cost)
+}
+
+func drawEFSTable(w io.Writer, fileSystems []model.EFSFileSystemWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("EFS Waste")
+
+	t.AppendHeader(table.Row{"Status", "File System", "Mount Targets", "Standard / Total", "Est. Cost/Mo", "IA Savings/Mo"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 3, Align: text.AlignRight},
+		{Number: 4, Align: text.AlignRight},
+		{Number: 5, Align: text.AlignRight},
+		{Number: 6, Align: text.AlignRight},
+	})
+
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "No Mount Targets", rows: populateEFSFileSystemRows(fileSystems, model.EFSFileSystemNoMountTargets)},
+		{label: "No Connections", rows: populateEFSFileSystemRows(fileSystems, model.EFSFileSystemNoConnections)},
+		{label: "No IA Lifecycle", rows: populateEFSFileSystemRows(fileSystems, model.EFSFileSystemNoIALifecycle)},
+	}
+
+	var hasPreviousRows bool
+
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(group.rows)
+
+		hasPreviousRows = true
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateEFSFileSystemRows(fileSystems []model.EFSFileSystemWasteInfo, status string) []table.Row {
+	var rows []table.Row
+
+	for _, fileSystem := range fileSystems {
+		if fileSystem.Status != status {
+			continue
+		}
+
+		name := fileSystem.FileSystemID
+		if fileSystem.Name != "" {
+			name = fmt.Sprintf("%s (%s)", fileSystem.Name, fileSystem.FileSystemID)
+		}
+
+		savings := "-"
+		if !fileSystem.HasIALifecycle {
+			savings = fmt.Sprintf("$%.2f", fileSystem.PotentialIASavings)
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			name,
+			fileSystem.MountTargetCount,
+			fmt.Sprintf("%.1f / %.1f GB", fileSystem.StandardSizeGB, fileSystem.TotalSizeGB),
+			fmt.Sprintf("$%.2f", fileSystem.EstimatedMonthlyCost),
+			savings,
+		})
+	}
+
+	return rows
+}
+
+func drawBackupTable(w io.Writer, recoveryPoints []model.BackupRecoveryPointWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("AWS Backup Waste")
+
+	t.AppendHeader(table.Row{"Status", "Resource", "Vault", "Details", "Size (GB)", "Est. Cost/Mo"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 5, Align: text.AlignRight},
+		{Number: 6, Align: text.AlignRight},
+	})
+
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "Source Deleted", rows: populateBackupRecoveryPointRows(recoveryPoints, model.BackupRecoveryPointSourceDeleted)},
+		{label: "Source Unknown", rows: populateBackupRecoveryPointRows(recoveryPoints, model.BackupRecoveryPointSourceUnknown)},
+		{label: "No Lifecycle", rows: populateBackupRecoveryPointRows(recoveryPoints, model.BackupRecoveryPointNoLifecycle)},
+	}
+
+	var hasPreviousRows bool
+
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(group.rows)
+
+		hasPreviousRows = true
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateBackupRecoveryPointRows(recoveryPoints []model.BackupRecoveryPointWasteInfo, status string) []table.Row {
+	var rows []table.Row
+
+	for _, recoveryPoint := range recoveryPoints {
+		if recoveryPoint.Status != status {
+			continue
+		}
+
+		resource := recoveryPoint.ResourceName
+		if resource == "" {
+			resource = recoveryPoint.ResourceArn[strings.LastIndex(recoveryPoint.ResourceArn, "/")+1:]
+		}
+
+		details := fmt.Sprintf("%s, created %d days ago", recoveryPoint.ResourceType, recoveryPoint.DaysSinceCreate)
+		if !recoveryPoint.HasDeleteLifecycle {
+			details += ", never expires"
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			resource,
+			recoveryPoint.BackupVaultName,
+			details,
+			fmt.Sprintf("%.1f GB", recoveryPoint.SizeGB),
+			fmt.Sprintf("$%.2f", recoveryPoint.EstimatedMonthlyCost),
+		})
+	}
+
+	return rows
+}
+
+func drawSecretsTable(w io.Writer, secrets []model.SecretWasteInfo, keys []model.KMSKeyWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Secrets & Keys Waste")
+
+	t.AppendHeader(table.Row{"Status", "Resource", "Details", "Cost/Mo"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+	})
+
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "Secret\n(Not Accessed)", rows: populateSecretRows(secrets)},
+		{label: "KMS Key\n(Disabled)", rows: populateKMSKeyRows(keys, model.KMSKeyDisabled)},
+		{label: "KMS Key\n(Unused)", rows: populateKMSKeyRows(keys, model.KMSKeyUnused)},
+		{label: "KMS Key\n(Usage Unknown)", rows: populateKMSKeyRows(keys, model.KMSKeyUsageUnknown)},
+	}
+
+	var hasPreviousRows bool
+
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(group.rows)
+
+		hasPreviousRows = true
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateSecretRows(secrets []model.SecretWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, secret := range secrets {
+		details := fmt.Sprintf("Never accessed, created %d days ago", secret.DaysSinceAccess)
+		if !secret.LastAccessedDate.IsZero() {
+			details = fmt.Sprintf("Last accessed %d days ago", secret.DaysSinceAccess)
+		}
+
+		if secret.OwningService != "" {
+			details += fmt.Sprintf(" (managed by %s)", secret.OwningService)
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			secret.Name,
+			details,
+			fmt.Sprintf("$%.2f", secret.MonthlyCost),
+		})
+	}
+
+	return rows
+}
+
+func populateKMSKeyRows(keys []model.KMSKeyWasteInfo, status string) []table.Row {
+	var rows []table.Row
+
+	for _, key := range keys {
+		if key.Status != status {
+			continue
+		}
+
+		details := key.Description
+		if details == "" {
+			details = fmt.Sprintf("Created %s", key.CreationDate.Format("2006-01-02"))
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			key.KeyID,
+			details,
+			formatMonthlyCost(key.MonthlyCost),
+		})
+	}
+
+	return rows
+}
+
+func drawRoute53Table(w io.Writer, zones []model.Route53HostedZoneWasteInfo, healthChecks []model.Route53HealthCheckWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Route 53 Waste")
+
+	t.AppendHeader(table.Row{"Status", "Resource", "Details", "Cost/Mo"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+	})
+
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "Hosted Zone\n(Empty)", rows: populateRoute53ZoneRows(zones, model.Route53ZoneEmpty)},
+		{label: "Hosted Zone\n(Dangling Records)", rows: populateRoute53ZoneRows(zones, model.Route53ZoneDanglingRecords)},
+		{label: "Hosted Zone\n(Unverifiable Records)", rows: populateRoute53ZoneRows(zones, model.Route53ZoneUnverifiableRecords)},
+		{label: "Health Check\n(Unreferenced)", rows: populateRoute53HealthCheckRows(healthChecks)},
+	}
+
+	var hasPreviousRows bool
+
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(group.rows)
+
+		hasPreviousRows = true
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateRoute53ZoneRows(zones []model.Route53HostedZoneWasteInfo, status string) []table.Row {
+	var rows []table.Row
+
+	for _, zone := range zones {
+		if zone.Status != status {
+			continue
+		}
+
+		resource := zone.Name
+		if zone.PrivateZone {
+			resource += " (private)"
+		}
+
+		details := fmt.Sprintf("%s, %d records", zone.ZoneID, zone.RecordCount)
+		cost := "-"
+
+		if status == model.Route53ZoneEmpty {
+			cost = fmt.Sprintf("$%.2f", zone.MonthlyCost)
+		}
+
+		for _, record := range zone.DanglingRecords {
+			details += fmt.Sprintf("\n%s %s -> %s (%s)", record.Name, record.Type, record.Target, strings.ToLower(record.Status))
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			resource,
+			details,
+			cost,
+		})
+	}
+
+	return rows
+}
+
+func populateRoute53HealthCheckRows(healthChecks []model.Route53HealthCheckWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, healthCheck := range healthChecks {
+		details := healthCheck.Type
+		if healthCheck.Endpoint != "" {
+			details += " " + healthCheck.Endpoint
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			healthCheck.HealthCheckID,
+			details,
+			fmt.Sprintf("$%.2f", healthCheck.MonthlyCost),
+		})
+	}
+
+	return rows
+}
+
+func drawStreamingTable(w io.Writer, streams []model.KinesisStreamWasteInfo, clusters []model.MSKClusterWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Streaming Waste")
+
+	t.AppendHeader(table.Row{"Status", "Resource", "Capacity", "Details", "Est. Cost/Hr"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 5, Align: text.AlignRight},
+	})
+
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "Kinesis Stream\n(No Consumers)", rows: populateKinesisStreamRows(streams, model.KinesisStreamNoConsumers)},
+		{label: "Kinesis Stream\n(Over-Provisioned)", rows: populateKinesisStreamRows(streams, model.KinesisStreamOverProvisioned)},
+		{label: "MSK Cluster\n(Idle)", rows: populateMSKClusterRows(clusters)},
+	}
+
+	var hasPreviousRows bool
+
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(group.rows)
+
+		hasPreviousRows = true
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateKinesisStreamRows(streams []model.KinesisStreamWasteInfo, status string) []table.Row {
+	var rows []table.Row
+
+	for _, stream := range streams {
+		if stream.Status != status {
+			continue
+		}
+
+		capacity := "On-demand"
+		if stream.StreamMode != "ON_DEMAND" {
+			capacity = fmt.Sprintf("%d shards", stream.ShardCount)
+		}
+
+		details := fmt.Sprintf("No consumers in %d days", stream.ObservationDays)
+		if status == model.KinesisStreamOverProvisioned {
+			details = fmt.Sprintf("Peak %.1f KB/s, %.0f records/s", stream.PeakIncomingBytesPerSec/1024, stream.PeakIncomingRecordsPerSec)
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			stream.StreamName,
+			capacity,
+			details + "\n" + stream.Recommendation,
+			formatHourlyCost(stream.HourlyCost),
+		})
+	}
+
+	return rows
+}
+
+func populateMSKClusterRows(clusters []model.MSKClusterWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, cluster := range clusters {
+		rows = append(rows, table.Row{
+			"",
+			cluster.ClusterName,
+			fmt.Sprintf("%d x %s", cluster.BrokerCount, cluster.InstanceType),
+			fmt.Sprintf("Avg %.0f B/s in over %d days", cluster.AverageBytesInPerSec, cluster.ObservationDays),
+			formatHourlyCost(cluster.HourlyCost),
+		})
+	}
+
+	return rows
+}
+
+func drawCloudTrailTable(w io.Writer, trails []model.CloudTrailWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("CloudTrail Waste")
+
+	t.AppendHeader(table.Row{"Status", "Trail", "Details", "Est. Events/Mo", "Est. Cost/Mo"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+		{Number: 5, Align: text.AlignRight},
+	})
+
+	groups := []struct {
+		label string
+		rows  []table.Row
+	}{
+		{label: "Duplicate\nManagement Events", rows: populateCloudTrailRows(trails, model.CloudTrailDuplicateManagementEvents)},
+		{label: "S3 Data Events\n(All Buckets)", rows: populateCloudTrailRows(trails, model.CloudTrailAllS3DataEvents)},
+		{label: "Lambda Data Events\n(All Functions)", rows: populateCloudTrailRows(trails, model.CloudTrailAllLambdaDataEvents)},
+	}
+
+	var hasPreviousRows bool
+
+	for _, group := range groups {
+		if len(group.rows) == 0 {
+			continue
+		}
+
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		halfRow := len(group.rows) / 2
+		group.rows[halfRow][0] = text.FgHiRed.Sprint(group.label)
+
+		t.AppendRows(group.rows)
+
+		hasPreviousRows = true
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateCloudTrailRows(trails []model.CloudTrailWasteInfo, status string) []table.Row {
+	var rows []table.Row
+
+	for _, trail := range trails {
+		if trail.Status != status {
+			continue
+		}
+
+		scope := "Single-region trail"
+
+		switch {
+		case trail.IsOrganizationTrail:
+			scope = "Organization trail"
+		case trail.IsMultiRegionTrail:
+			scope = "Multi-region trail"
+		}
+
+		details := fmt.Sprintf("%s (home %s)", scope, trail.HomeRegion)
+		if trail.DuplicateOf != "" {
+			details += fmt.Sprintf("\nAlso recorded by %s", trail.DuplicateOf)
+		}
+
+		events := "n/a"
+		if trail.EstimatedMonthlyEvents > 0 {
+			events = fmt.Sprintf("%.0f", trail.EstimatedMonthlyEvents)
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			trail.TrailName,
+			details,
+			events,
+			formatMonthlyCost(trail.EstimatedMonthlyCost),
+		})
+	}
+
+	return rows
+}
+
+func drawAMITable(w io.Writer, amis []model.AMIWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("Unused AMI Waste")
+
+	t.AppendHeader(table.Row{"Status", "AMI ID", "Name", "Age (Days)", "Max Savings/Mo"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+		{Number: 5, Align: text.AlignRight},
+	})
+
+	rows := populateAMIRows(amis)
+
+	if len(rows) > 0 {
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiYellow.Sprint("Unused")
+	}
+
+	t.AppendRows(rows)
+	t.Render()
+
+	var warnings []string
+
+	for _, ami := range amis {
+		if ami.SafetyWarning != "" {
+			warnings = append(warnings, fmt.Sprintf("   %s: %s", ami.ImageID, ami.SafetyWarning))
+		}
+	}
+
+	if len(warnings) > 0 {
+		fmt.Fprintln(w, text.FgHiYellow.Sprint(" * Warning: these AMIs may still be referenced by launch template versions or Auto Scaling groups"))
+		fmt.Fprintln(w, text.FgHiYellow.Sprint(strings.Join(warnings, "\n")))
+	}
+
+	fmt.Fprintln(w)
+}
+
+func populateAMIRows(amis []model.AMIWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, ami := range amis {
+		name := ami.Name
+		if len(name) > 30 {
+			name = name[:27] + "..."
+		}
+
+		imageID := ami.ImageID
+		if ami.SafetyWarning != "" {
+			imageID += "*"
+		}
+
+		rows = append(rows, table.Row{
+			"",
+			imageID,
+			name,
+			fmt.Sprintf("%d days", ami.DaysSinceCreate),
+			fmt.Sprintf("$%.2f", ami.MaxPotentialSaving),
+		})
+	}
+
+	return rows
+}
+
+func drawSnapshotTable(w io.Writer, snapshots []model.SnapshotWasteInfo) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.SetTitle("EBS Snapshot Waste")
+
+	t.AppendHeader(table.Row{"Status", "Snapshot ID", "Reason", "Size (GB)", "Max Savings/MO"})
+
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+		{Number: 5, Align: text.AlignRight},
+	})
+
+	// Separate orphaned and stale snapshots
+	var orphaned, stale []model.SnapshotWasteInfo
+
+	for _, snap := range snapshots {
+		if snap.Category == model.SnapshotCategoryOrphaned {
+			orphaned = append(orphaned, snap)
+		} else {
+			stale = append(stale, snap)
+		}
+	}
+
+	var hasPreviousRows bool
+
+	if len(orphaned) > 0 {
+		statusLabel := "Orphaned(Volume Deleted)"
+		rows := populateSnapshotRows(orphaned)
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiRed.Sprint(statusLabel)
+
+		t.AppendRows(rows)
+
+		hasPreviousRows = true
+	}
+
+	if len(stale) > 0 {
+		if hasPreviousRows {
+			t.AppendSeparator()
+		}
+
+		statusLabel := "Stale(Old Backup > 90 days)"
+		rows := populateSnapshotRows(stale)
+
+		halfRow := len(rows) / 2
+		rows[halfRow][0] = text.FgHiYellow.Sprint(statusLabel)
+
+		t.AppendRows(rows)
+	}
+
+	t.Render()
+	fmt.Fprintln(w)
+}
+
+func populateSnapshotRows(snapshots []model.SnapshotWasteInfo) []table.Row {
+	var rows []table.Row
+
+	for _, snap := range snapshots {
+		reason := snap.Reason
+		if snap.ManagedBy != "" {
+			reason = fmt.Sprintf("%s\nManaged by %s", reason, snap.ManagedBy)
+		}
+
 		rows = append(rows, table.Row{
 			"",
 			snap.SnapshotID,
-			snap.Reason,
+			reason,
 			fmt.Sprintf("%d GB", snap.SizeGB),
 			fmt.Sprintf("$%.2f/mo", snap.MaxPotentialSavings),
 		})
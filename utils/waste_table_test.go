@@ -497,7 +497,7 @@ func captureWasteOutput(f func()) string {
 
 func TestDrawWasteTable_NoWaste(t *testing.T) {
 	output := captureWasteOutput(func() {
-		DrawWasteTable("123456789012", nil, nil, nil, nil, nil, nil, nil, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{})
 	})
 
 	if !strings.Contains(output, "AWS DOCTOR CHECKUP") {
@@ -519,7 +519,7 @@ func TestDrawWasteTable_WithElasticIPs(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		DrawWasteTable("123456789012", elasticIPs, nil, nil, nil, nil, nil, nil, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{ElasticIPs: elasticIPs})
 	})
 
 	if !strings.Contains(output, "Elastic IP") {
@@ -533,7 +533,7 @@ func TestDrawWasteTable_WithEBSVolumes(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		DrawWasteTable("123456789012", nil, unusedVolumes, nil, nil, nil, nil, nil, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{UnusedVolumes: unusedVolumes})
 	})
 
 	if !strings.Contains(output, "EBS") {
@@ -550,7 +550,7 @@ func TestDrawWasteTable_WithStoppedInstances(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		DrawWasteTable("123456789012", nil, nil, nil, nil, stoppedInstances, nil, nil, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{StoppedInstances: stoppedInstances})
 	})
 
 	if !strings.Contains(output, "EC2") || !strings.Contains(output, "Reserved Instance") {
@@ -568,7 +568,7 @@ func TestDrawWasteTable_WithReservedInstances(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		DrawWasteTable("123456789012", nil, nil, nil, ris, nil, nil, nil, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{ReservedInstances: ris})
 	})
 
 	if !strings.Contains(output, "Reserved Instance") {
@@ -585,7 +585,7 @@ func TestDrawWasteTable_WithLoadBalancers(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		DrawWasteTable("123456789012", nil, nil, nil, nil, nil, loadBalancers, nil, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{LoadBalancers: loadBalancers})
 	})
 
 	if !strings.Contains(output, "Load Balancer") {
@@ -614,7 +614,14 @@ func TestDrawWasteTable_AllWasteTypes(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		DrawWasteTable("123456789012", elasticIPs, unusedVolumes, stoppedVolumes, ris, stoppedInstances, loadBalancers, nil, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{
+			ElasticIPs:        elasticIPs,
+			UnusedVolumes:     unusedVolumes,
+			StoppedVolumes:    stoppedVolumes,
+			ReservedInstances: ris,
+			StoppedInstances:  stoppedInstances,
+			LoadBalancers:     loadBalancers,
+		})
 	})
 
 	// Should have all sections
@@ -645,7 +652,7 @@ func TestDrawEBSTable(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawEBSTable(unusedVolumes, stoppedVolumes)
+		drawEBSTable(os.Stdout, unusedVolumes, stoppedVolumes)
 	})
 
 	if !strings.Contains(output, "EBS Volume Waste") {
@@ -667,7 +674,7 @@ func TestDrawEBSTable_OnlyUnused(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawEBSTable(unusedVolumes, nil)
+		drawEBSTable(os.Stdout, unusedVolumes, nil)
 	})
 
 	if !strings.Contains(output, "Available") {
@@ -681,7 +688,7 @@ func TestDrawEBSTable_OnlyStopped(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawEBSTable(nil, stoppedVolumes)
+		drawEBSTable(os.Stdout, nil, stoppedVolumes)
 	})
 
 	if !strings.Contains(output, "Stopped Instance") {
@@ -702,7 +709,7 @@ func TestDrawEC2Table(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawEC2Table(instances, ris)
+		drawEC2Table(os.Stdout, instances, ris)
 	})
 
 	if !strings.Contains(output, "EC2 & Reserved Instance Waste") {
@@ -724,7 +731,7 @@ func TestDrawEC2Table_OnlyInstances(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawEC2Table(instances, nil)
+		drawEC2Table(os.Stdout, instances, nil)
 	})
 
 	if !strings.Contains(output, "Stopped Instance") {
@@ -738,7 +745,7 @@ func TestDrawEC2Table_OnlyRIs(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawEC2Table(nil, ris)
+		drawEC2Table(os.Stdout, nil, ris)
 	})
 
 	if !strings.Contains(output, "Expiring Soon") {
@@ -746,6 +753,131 @@ func TestDrawEC2Table_OnlyRIs(t *testing.T) {
 	}
 }
 
+func TestPopulateCapacityReservationRows(t *testing.T) {
+	reservations := []model.CapacityReservationWasteInfo{
+		{
+			CapacityReservationID:  "cr-111",
+			InstanceType:           "m5.large",
+			AvailabilityZone:       "us-east-1a",
+			TotalInstanceCount:     4,
+			AvailableInstanceCount: 4,
+			UtilizationPercent:     0,
+			Status:                 "UNUSED",
+		},
+		{
+			CapacityReservationID:  "cr-222",
+			InstanceType:           "c5.xlarge",
+			AvailabilityZone:       "us-east-1b",
+			TotalInstanceCount:     4,
+			AvailableInstanceCount: 3,
+			UtilizationPercent:     25,
+			Status:                 "UNDERUTILIZED",
+		},
+	}
+
+	rows := populateCapacityReservationRows(reservations)
+
+	if len(rows) != 2 {
+		t.Fatalf("populateCapacityReservationRows() returned %d rows, want 2", len(rows))
+	}
+
+	for i, row := range rows {
+		if len(row) != 5 {
+			t.Errorf("Row %d has %d columns, want 5", i, len(row))
+		}
+	}
+
+	if rows[0][4] != "0/4 used (0%)" {
+		t.Errorf("Row 0 usage = %v, want '0/4 used (0%%)'", rows[0][4])
+	}
+
+	if rows[1][4] != "1/4 used (25%)" {
+		t.Errorf("Row 1 usage = %v, want '1/4 used (25%%)'", rows[1][4])
+	}
+}
+
+func TestPopulateDedicatedHostRows(t *testing.T) {
+	hosts := []model.DedicatedHostWasteInfo{
+		{
+			HostID:              "h-111",
+			InstanceType:        "m5.large",
+			AvailabilityZone:    "us-east-1a",
+			AllocationTime:      time.Now().AddDate(0, 0, -10),
+			DaysSinceAllocation: 10,
+		},
+		{
+			HostID:           "h-222",
+			InstanceFamily:   "c5",
+			AvailabilityZone: "us-east-1b",
+		},
+	}
+
+	rows := populateDedicatedHostRows(hosts)
+
+	if len(rows) != 2 {
+		t.Fatalf("populateDedicatedHostRows() returned %d rows, want 2", len(rows))
+	}
+
+	if rows[0][2] != "m5.large" {
+		t.Errorf("Row 0 instance type = %v, want 'm5.large'", rows[0][2])
+	}
+
+	if rows[0][4] != "0 instances, 10 days" {
+		t.Errorf("Row 0 usage = %v, want '0 instances, 10 days'", rows[0][4])
+	}
+
+	// Hosts supporting multiple instance types fall back to the instance family
+	if rows[1][2] != "c5" {
+		t.Errorf("Row 1 instance type = %v, want 'c5'", rows[1][2])
+	}
+
+	if rows[1][4] != "0 instances" {
+		t.Errorf("Row 1 usage = %v, want '0 instances'", rows[1][4])
+	}
+}
+
+func TestDrawEC2CapacityTable(t *testing.T) {
+	reservations := []model.CapacityReservationWasteInfo{
+		{CapacityReservationID: "cr-111", TotalInstanceCount: 2, AvailableInstanceCount: 2, Status: "UNUSED"},
+		{CapacityReservationID: "cr-222", TotalInstanceCount: 4, AvailableInstanceCount: 3, UtilizationPercent: 25, Status: "UNDERUTILIZED"},
+	}
+	hosts := []model.DedicatedHostWasteInfo{
+		{HostID: "h-111", InstanceFamily: "m5"},
+	}
+
+	output := captureWasteOutput(func() {
+		drawEC2CapacityTable(os.Stdout, reservations, hosts)
+	})
+
+	if !strings.Contains(output, "EC2 Reserved Capacity Waste") {
+		t.Error("drawEC2CapacityTable() missing title")
+	}
+
+	for _, want := range []string{"cr-111", "cr-222", "h-111", "Unused", "Underutilized", "No Instances"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawEC2CapacityTable() missing %q", want)
+		}
+	}
+}
+
+func TestDrawWasteTable_WithCapacityWaste(t *testing.T) {
+	hosts := []model.DedicatedHostWasteInfo{
+		{HostID: "h-111", InstanceFamily: "m5"},
+	}
+
+	output := captureWasteOutput(func() {
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{DedicatedHosts: hosts})
+	})
+
+	if strings.Contains(output, "Your account is healthy") {
+		t.Error("DrawWasteTable() with dedicated hosts should not report a healthy account")
+	}
+
+	if !strings.Contains(output, "EC2 Reserved Capacity Waste") {
+		t.Error("DrawWasteTable() with dedicated hosts missing capacity section")
+	}
+}
+
 func TestDrawElasticIPTable(t *testing.T) {
 	elasticIPs := []types.Address{
 		{PublicIp: aws.String("1.2.3.4"), AllocationId: aws.String("eipalloc-123")},
@@ -753,7 +885,7 @@ func TestDrawElasticIPTable(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawElasticIPTable(elasticIPs)
+		drawElasticIPTable(os.Stdout, elasticIPs)
 	})
 
 	if !strings.Contains(output, "Elastic IP Waste") {
@@ -776,7 +908,7 @@ func TestDrawLoadBalancerTable(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawLoadBalancerTable(loadBalancers)
+		drawLoadBalancerTable(os.Stdout, loadBalancers, nil)
 	})
 
 	if !strings.Contains(output, "Load Balancer Waste") {
@@ -792,6 +924,41 @@ func TestDrawLoadBalancerTable(t *testing.T) {
 	}
 }
 
+func TestDrawLoadBalancerTable_WithClassicLoadBalancers(t *testing.T) {
+	classicLoadBalancers := []model.ClassicLoadBalancerWasteInfo{
+		{Name: "legacy-empty", Status: model.ClassicLoadBalancerNoInstances},
+		{Name: "legacy-down", InstanceCount: 2, Status: model.ClassicLoadBalancerAllOutOfService},
+	}
+
+	output := captureWasteOutput(func() {
+		drawLoadBalancerTable(os.Stdout, nil, classicLoadBalancers)
+	})
+
+	for _, want := range []string{"legacy-empty", "legacy-down", "classic", "No Instances", "OutOfService"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawLoadBalancerTable() missing %q", want)
+		}
+	}
+}
+
+func TestPopulateClassicLoadBalancerRows(t *testing.T) {
+	classicLoadBalancers := []model.ClassicLoadBalancerWasteInfo{
+		{Name: "lb-1", Status: model.ClassicLoadBalancerNoInstances},
+		{Name: "lb-2", Status: model.ClassicLoadBalancerAllOutOfService},
+		{Name: "lb-3", Status: model.ClassicLoadBalancerNoInstances},
+	}
+
+	rows := populateClassicLoadBalancerRows(classicLoadBalancers, model.ClassicLoadBalancerNoInstances)
+
+	if len(rows) != 2 {
+		t.Fatalf("populateClassicLoadBalancerRows() returned %d rows, want 2", len(rows))
+	}
+
+	if rows[1][1] != "lb-3" || rows[1][2] != "classic" {
+		t.Errorf("Row 1 = %v, want lb-3 classic", rows[1])
+	}
+}
+
 func TestPopulateAMIRows(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -940,7 +1107,7 @@ func TestDrawAMITable(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		drawAMITable(amis)
+		drawAMITable(os.Stdout, amis)
 	})
 
 	// Check for table title
@@ -963,6 +1130,33 @@ func TestDrawAMITable(t *testing.T) {
 	}
 }
 
+func TestDrawAMITable_TemplateReferences(t *testing.T) {
+	amis := []model.AMIWasteInfo{
+		{ImageID: "ami-unreferenced", Name: "plain", DaysSinceCreate: 100},
+		{ImageID: "ami-referenced", Name: "web", DaysSinceCreate: 200, SafetyWarning: "Referenced by launch template web (lt-123) version 1"},
+	}
+
+	output := captureWasteOutput(func() {
+		drawAMITable(os.Stdout, amis)
+	})
+
+	if !strings.Contains(output, "ami-referenced*") || strings.Contains(output, "ami-unreferenced*") {
+		t.Error("drawAMITable() should only mark AMIs referenced by launch templates")
+	}
+
+	if !strings.Contains(output, "ami-referenced: Referenced by launch template web (lt-123) version 1") {
+		t.Error("drawAMITable() missing launch template reference")
+	}
+
+	output = captureWasteOutput(func() {
+		drawAMITable(os.Stdout, amis[:1])
+	})
+
+	if strings.Contains(output, "Warning") {
+		t.Error("drawAMITable() should not print a warning when no launch template references the AMIs")
+	}
+}
+
 func TestDrawWasteTable_WithUnusedAMIs(t *testing.T) {
 	unusedAMIs := []model.AMIWasteInfo{
 		{
@@ -975,7 +1169,7 @@ func TestDrawWasteTable_WithUnusedAMIs(t *testing.T) {
 	}
 
 	output := captureWasteOutput(func() {
-		DrawWasteTable("123456789012", nil, nil, nil, nil, nil, nil, unusedAMIs, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{UnusedAMIs: unusedAMIs})
 	})
 
 	if !strings.Contains(output, "Unused AMI") {
@@ -1022,6 +1216,308 @@ func BenchmarkDrawWasteTable(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		DrawWasteTable("123456789012", elasticIPs, unusedVolumes, nil, nil, nil, nil, nil, nil)
+		DrawWasteTable(os.Stdout, "123456789012", model.WasteReport{ElasticIPs: elasticIPs, UnusedVolumes: unusedVolumes})
+	}
+}
+
+func TestPopulateEKSClusterRows(t *testing.T) {
+	clusters := []model.EKSClusterWasteInfo{
+		{ClusterName: "idle", KubernetesVersion: "1.29", Status: model.EKSClusterNoNodeGroups, EstimatedMonthlyCost: 73},
+		{ClusterName: "old", KubernetesVersion: "1.24", NodeGroupCount: 2, ExtendedSupport: true, EstimatedMonthlyCost: 365},
+	}
+
+	idleRows := populateEKSClusterRows(clusters, model.EKSClusterNoNodeGroups)
+	if len(idleRows) != 1 || idleRows[0][1] != "idle" || idleRows[0][3] != "$73.00" {
+		t.Errorf("populateEKSClusterRows(NO_NODE_GROUPS) = %v, want one 'idle' row costing $73.00", idleRows)
+	}
+
+	extendedRows := populateEKSClusterRows(clusters, "")
+	if len(extendedRows) != 1 || extendedRows[0][2] != "Kubernetes 1.24 (extended support), 2 node groups" {
+		t.Errorf("populateEKSClusterRows(extended) = %v, want one extended-support row", extendedRows)
+	}
+}
+
+func TestDrawContainersTable(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawContainersTable(os.Stdout,
+			[]model.EKSClusterWasteInfo{{ClusterName: "staging", KubernetesVersion: "1.30", Status: model.EKSClusterZeroNodes, NodeGroupCount: 1, EstimatedMonthlyCost: 73}},
+			[]model.ECSServiceWasteInfo{{ClusterName: "prod", ServiceName: "worker", TargetGroupArns: []string{"tg"}, LoadBalancerArns: []string{"lb"}, EstimatedMonthlyCost: 16.43}},
+		)
+	})
+
+	for _, want := range []string{"Containers Waste", "staging", "Zero Nodes", "prod/worker", "1 target groups, 1 load balancers", "$16.43"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawContainersTable() missing %q", want)
+		}
+	}
+}
+
+func TestPopulateSageMakerEndpointRows(t *testing.T) {
+	rows := populateSageMakerEndpointRows([]model.SageMakerEndpointWasteInfo{
+		{EndpointName: "churn", VariantName: "AllTraffic", InstanceType: "ml.m5.xlarge", InstanceCount: 2, HourlyCost: 0.46, IdleDays: 14},
+		{EndpointName: "legacy", VariantName: "AllTraffic", InstanceType: "ml.x99.unknown", InstanceCount: 1, IdleDays: 14},
+	})
+
+	if len(rows) != 2 {
+		t.Fatalf("populateSageMakerEndpointRows() returned %d rows, want 2", len(rows))
+	}
+
+	if rows[0][1] != "churn/AllTraffic" || rows[0][3] != int32(2) || rows[0][4] != "0 invocations in 14 days" || rows[0][5] != "$0.4600" {
+		t.Errorf("populateSageMakerEndpointRows()[0] = %v", rows[0])
+	}
+
+	if rows[1][5] != "n/a" {
+		t.Errorf("populateSageMakerEndpointRows()[1] cost = %v, want n/a", rows[1][5])
+	}
+}
+
+func TestDrawSageMakerTable(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawSageMakerTable(os.Stdout,
+			[]model.SageMakerEndpointWasteInfo{{EndpointName: "churn", VariantName: "AllTraffic", InstanceType: "ml.m5.xlarge", InstanceCount: 1, HourlyCost: 0.23, IdleDays: 14}},
+			[]model.SageMakerNotebookWasteInfo{{NotebookInstanceName: "research", InstanceType: "ml.t3.medium", DaysSinceModified: 40, HourlyCost: 0.05}},
+		)
+	})
+
+	for _, want := range []string{"SageMaker Waste", "churn/AllTraffic", "No Invocations", "research", "Running for 40 days without restart", "$0.0500"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawSageMakerTable() missing %q", want)
+		}
+	}
+}
+
+func TestPopulateRedshiftClusterRows(t *testing.T) {
+	clusters := []model.RedshiftClusterWasteInfo{
+		{ClusterIdentifier: "analytics", NodeType: "dc2.large", NodeCount: 2, Status: model.RedshiftClusterNoConnections, ObservationDays: 14, Recommendation: "Pause", EstimatedMonthlyCost: 365},
+		{ClusterIdentifier: "reporting", NodeType: "ra3.next", NodeCount: 1, Status: model.RedshiftClusterLowCPU, MaxConnections: 3, AverageCPUPercent: 2.5, ObservationDays: 14},
+	}
+
+	idleRows := populateRedshiftClusterRows(clusters, model.RedshiftClusterNoConnections)
+	if len(idleRows) != 1 || idleRows[0][1] != "analytics" || idleRows[0][3] != int32(2) || idleRows[0][5] != "$365.00" {
+		t.Errorf("populateRedshiftClusterRows(NO_CONNECTIONS) = %v, want one 'analytics' row costing $365.00", idleRows)
+	}
+
+	lowCPURows := populateRedshiftClusterRows(clusters, model.RedshiftClusterLowCPU)
+	if len(lowCPURows) != 1 || lowCPURows[0][5] != "n/a" {
+		t.Fatalf("populateRedshiftClusterRows(LOW_CPU) = %v, want one unpriced row", lowCPURows)
+	}
+
+	if details := lowCPURows[0][4].(string); !strings.HasPrefix(details, "3 max connections, 2.5% avg CPU in 14 days") {
+		t.Errorf("populateRedshiftClusterRows(LOW_CPU) details = %q", details)
+	}
+}
+
+func TestDrawRedshiftTable(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawRedshiftTable(os.Stdout,
+			[]model.RedshiftClusterWasteInfo{{ClusterIdentifier: "analytics", NodeType: "dc2.large", NodeCount: 2, Status: model.RedshiftClusterLowCPU, Recommendation: "Schedule pause/resume", EstimatedMonthlyCost: 365}},
+			[]model.RedshiftSnapshotWasteInfo{{SnapshotIdentifier: "before-migration", ClusterIdentifier: "analytics", NodeType: "ra3.xlplus", NodeCount: 2, SizeGB: 500, DaysSinceCreate: 120, EstimatedMonthlyCost: 12}},
+		)
+	})
+
+	for _, want := range []string{"Redshift Waste", "Low CPU", "Schedule pause/resume", "before-migration (analytics)", "500.0 GB, created 120 days ago", "$12.00"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawRedshiftTable() missing %q", want)
+		}
+	}
+}
+
+func TestPopulateEFSFileSystemRows(t *testing.T) {
+	fileSystems := []model.EFSFileSystemWasteInfo{
+		{FileSystemID: "fs-1", Name: "shared", Status: model.EFSFileSystemNoMountTargets, StandardSizeGB: 10, TotalSizeGB: 10, HasIALifecycle: true, EstimatedMonthlyCost: 3},
+		{FileSystemID: "fs-2", MountTargetCount: 2, Status: model.EFSFileSystemNoIALifecycle, StandardSizeGB: 500, TotalSizeGB: 500, EstimatedMonthlyCost: 150, PotentialIASavings: 142},
+	}
+
+	unmountedRows := populateEFSFileSystemRows(fileSystems, model.EFSFileSystemNoMountTargets)
+	if len(unmountedRows) != 1 || unmountedRows[0][1] != "shared (fs-1)" || unmountedRows[0][5] != "-" {
+		t.Errorf("populateEFSFileSystemRows(NO_MOUNT_TARGETS) = %v, want one 'shared (fs-1)' row without IA savings", unmountedRows)
+	}
+
+	lifecycleRows := populateEFSFileSystemRows(fileSystems, model.EFSFileSystemNoIALifecycle)
+	if len(lifecycleRows) != 1 || lifecycleRows[0][1] != "fs-2" || lifecycleRows[0][3] != "500.0 / 500.0 GB" || lifecycleRows[0][5] != "$142.00" {
+		t.Errorf("populateEFSFileSystemRows(NO_IA_LIFECYCLE) = %v, want one 'fs-2' row saving $142.00", lifecycleRows)
+	}
+}
+
+func TestDrawEFSTable(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawEFSTable(os.Stdout, []model.EFSFileSystemWasteInfo{
+			{FileSystemID: "fs-1", MountTargetCount: 1, Status: model.EFSFileSystemNoConnections, StandardSizeGB: 20, TotalSizeGB: 20, EstimatedMonthlyCost: 6, PotentialIASavings: 5.68},
+		})
+	})
+
+	for _, want := range []string{"EFS Waste", "No Connections", "fs-1", "$6.00", "$5.68"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawEFSTable() missing %q", want)
+		}
+	}
+}
+
+func TestPopulateSnapshotRows_ManagedBy(t *testing.T) {
+	rows := populateSnapshotRows([]model.SnapshotWasteInfo{
+		{SnapshotID: "snap-1", Reason: "Volume Deleted", SizeGB: 8, ManagedBy: model.SnapshotManagedByBackup},
+		{SnapshotID: "snap-2", Reason: "Old Backup", SizeGB: 8},
+	})
+
+	if rows[0][2] != "Volume Deleted\nManaged by AWS Backup" {
+		t.Errorf("populateSnapshotRows()[0] reason = %q, want the managing tool appended", rows[0][2])
+	}
+
+	if rows[1][2] != "Old Backup" {
+		t.Errorf("populateSnapshotRows()[1] reason = %q, want %q", rows[1][2], "Old Backup")
+	}
+}
+
+func TestPopulateBackupRecoveryPointRows(t *testing.T) {
+	recoveryPoints := []model.BackupRecoveryPointWasteInfo{
+		{ResourceArn: "arn:aws:ec2:us-east-1:123456789012:volume/vol-123", ResourceType: "EBS", BackupVaultName: "Default", Status: model.BackupRecoveryPointSourceDeleted, HasDeleteLifecycle: true, DaysSinceCreate: 45, SizeGB: 200, EstimatedMonthlyCost: 10},
+		{ResourceArn: "arn:aws:dynamodb:us-east-1:123456789012:table/orders", ResourceName: "orders", ResourceType: "DynamoDB", BackupVaultName: "Default", Status: model.BackupRecoveryPointNoLifecycle, DaysSinceCreate: 400, SizeGB: 5, EstimatedMonthlyCost: 0.25},
+	}
+
+	deletedRows := populateBackupRecoveryPointRows(recoveryPoints, model.BackupRecoveryPointSourceDeleted)
+	if len(deletedRows) != 1 || deletedRows[0][1] != "vol-123" || deletedRows[0][3] != "EBS, created 45 days ago" || deletedRows[0][5] != "$10.00" {
+		t.Errorf("populateBackupRecoveryPointRows(SOURCE_DELETED) = %v, want one 'vol-123' row costing $10.00", deletedRows)
+	}
+
+	noLifecycleRows := populateBackupRecoveryPointRows(recoveryPoints, model.BackupRecoveryPointNoLifecycle)
+	if len(noLifecycleRows) != 1 || noLifecycleRows[0][1] != "orders" || noLifecycleRows[0][3] != "DynamoDB, created 400 days ago, never expires" {
+		t.Errorf("populateBackupRecoveryPointRows(NO_LIFECYCLE) = %v, want one 'orders' row that never expires", noLifecycleRows)
+	}
+}
+
+func TestDrawBackupTable(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawBackupTable(os.Stdout, []model.BackupRecoveryPointWasteInfo{
+			{ResourceArn: "arn:aws:ec2:us-east-1:123456789012:instance/i-123", ResourceType: "EC2", BackupVaultName: "Default", Status: model.BackupRecoveryPointSourceDeleted, SizeGB: 30, EstimatedMonthlyCost: 1.5},
+		})
+	})
+
+	for _, want := range []string{"AWS Backup Waste", "Source Deleted", "i-123", "Default", "30.0 GB", "$1.50"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawBackupTable() missing %q", want)
+		}
+	}
+}
+
+func TestPopulateSecretRows(t *testing.T) {
+	rows := populateSecretRows([]model.SecretWasteInfo{
+		{Name: "legacy/api-key", LastAccessedDate: time.Now().AddDate(0, 0, -120), DaysSinceAccess: 120, MonthlyCost: 0.40},
+		{Name: "rds!db-123", DaysSinceAccess: 95, OwningService: "rds", MonthlyCost: 0.40},
+	})
+
+	if len(rows) != 2 {
+		t.Fatalf("populateSecretRows() returned %d rows, want 2", len(rows))
+	}
+
+	if rows[0][2] != "Last accessed 120 days ago" || rows[0][3] != "$0.40" {
+		t.Errorf("populateSecretRows()[0] = %v", rows[0])
+	}
+
+	if rows[1][2] != "Never accessed, created 95 days ago (managed by rds)" {
+		t.Errorf("populateSecretRows()[1] details = %q", rows[1][2])
+	}
+}
+
+func TestDrawSecretsTable(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawSecretsTable(os.Stdout,
+			[]model.SecretWasteInfo{{Name: "legacy/api-key", DaysSinceAccess: 100, MonthlyCost: 0.40}},
+			[]model.KMSKeyWasteInfo{
+				{KeyID: "1234abcd-disabled", Status: model.KMSKeyDisabled, Description: "old app key", MonthlyCost: 1},
+				{KeyID: "5678efgh-unused", Status: model.KMSKeyUnused, CreationDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), MonthlyCost: 1},
+				{KeyID: "9999zzzz-throttled", Status: model.KMSKeyUsageUnknown, Description: "batch key"},
+			},
+		)
+	})
+
+	for _, want := range []string{
+		"Secrets & Keys Waste", "legacy/api-key", "1234abcd-disabled", "old app key", "5678efgh-unused", "Created 2024-01-02", "$1.00",
+		"Usage Unknown", "9999zzzz-throttled", "n/a",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawSecretsTable() missing %q", want)
+		}
+	}
+}
+
+func TestPopulateRoute53ZoneRows(t *testing.T) {
+	zones := []model.Route53HostedZoneWasteInfo{
+		{ZoneID: "Z1", Name: "unused.example", RecordCount: 2, Status: model.Route53ZoneEmpty, MonthlyCost: 0.50},
+		{
+			ZoneID:      "Z2",
+			Name:        "internal.example",
+			PrivateZone: true,
+			RecordCount: 5,
+			Status:      model.Route53ZoneDanglingRecords,
+			DanglingRecords: []model.Route53DanglingRecord{
+				{Name: "api.internal.example", Type: "A", Target: "old-alb-1.us-east-1.elb.amazonaws.com"},
+			},
+		},
+	}
+
+	empty := populateRoute53ZoneRows(zones, model.Route53ZoneEmpty)
+	if len(empty) != 1 || empty[0][1] != "unused.example" || empty[0][3] != "$0.50" {
+		t.Errorf("populateRoute53ZoneRows(EMPTY) = %v", empty)
+	}
+
+	dangling := populateRoute53ZoneRows(zones, model.Route53ZoneDanglingRecords)
+	if len(dangling) != 1 {
+		t.Fatalf("populateRoute53ZoneRows(DANGLING_RECORDS) returned %d rows, want 1", len(dangling))
+	}
+
+	if dangling[0][1] != "internal.example (private)" || dangling[0][3] != "-" {
+		t.Errorf("populateRoute53ZoneRows(DANGLING_RECORDS)[0] = %v", dangling[0])
+	}
+
+	if details := dangling[0][2].(string); !strings.Contains(details, "api.internal.example A -> old-alb-1.us-east-1.elb.amazonaws.com") {
+		t.Errorf("dangling details = %q", details)
+	}
+}
+
+func TestDrawRoute53Table(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawRoute53Table(os.Stdout,
+			[]model.Route53HostedZoneWasteInfo{{ZoneID: "Z1", Name: "unused.example", RecordCount: 2, Status: model.Route53ZoneEmpty, MonthlyCost: 0.50}},
+			[]model.Route53HealthCheckWasteInfo{{HealthCheckID: "abcd-1234", Type: "HTTPS", Endpoint: "app.example.com", MonthlyCost: 0.50}},
+		)
+	})
+
+	for _, want := range []string{"Route 53 Waste", "unused.example", "abcd-1234", "HTTPS app.example.com", "$0.50"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawRoute53Table() missing %q", want)
+		}
+	}
+}
+
+func TestDrawStreamingTable(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawStreamingTable(os.Stdout,
+			[]model.KinesisStreamWasteInfo{
+				{StreamName: "audit-log", StreamMode: "ON_DEMAND", Status: model.KinesisStreamNoConsumers, ObservationDays: 14, Recommendation: "Delete the stream if no application reads from it", HourlyCost: 0.04},
+				{StreamName: "clickstream", StreamMode: "PROVISIONED", ShardCount: 10, Status: model.KinesisStreamOverProvisioned, PeakIncomingBytesPerSec: 2048, PeakIncomingRecordsPerSec: 20, SuggestedShardCount: 1, Recommendation: "Reduce the shard count to 1", HourlyCost: 0.15},
+			},
+			[]model.MSKClusterWasteInfo{{ClusterName: "orders", InstanceType: "kafka.m5.large", BrokerCount: 3, AverageBytesInPerSec: 12, ObservationDays: 14, HourlyCost: 0.63}},
+		)
+	})
+
+	for _, want := range []string{"Streaming Waste", "audit-log", "On-demand", "No consumers in 14 days", "clickstream", "10 shards", "Peak 2.0 KB/s, 20 records/s", "Reduce the shard count to 1", "orders", "3 x kafka.m5.large", "Avg 12 B/s in over 14 days", "$0.6300"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawStreamingTable() missing %q", want)
+		}
+	}
+}
+
+func TestDrawCloudTrailTable(t *testing.T) {
+	output := captureWasteOutput(func() {
+		drawCloudTrailTable(os.Stdout, []model.CloudTrailWasteInfo{
+			{TrailName: "legacy-trail", HomeRegion: "us-east-1", Status: model.CloudTrailDuplicateManagementEvents, DuplicateOf: "org-trail", EstimatedMonthlyEvents: 1000000, EstimatedMonthlyCost: 20},
+			{TrailName: "data-trail", HomeRegion: "us-east-1", IsMultiRegionTrail: true, Status: model.CloudTrailAllS3DataEvents},
+		})
+	})
+
+	for _, want := range []string{"CloudTrail Waste", "legacy-trail", "Also recorded by org-trail", "1000000", "$20.00", "data-trail", "Multi-region trail", "n/a"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("drawCloudTrailTable() missing %q", want)
+		}
 	}
 }